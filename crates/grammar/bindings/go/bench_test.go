@@ -0,0 +1,602 @@
+package tree_sitter_cdm_test
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/larner-dev/cdm/bindings/go/contexts"
+	"github.com/larner-dev/cdm/bindings/go/diff"
+	"github.com/larner-dev/cdm/bindings/go/format"
+	"github.com/larner-dev/cdm/bindings/go/schema"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// Size knobs for the synthetic projects. Override them on the command line,
+// e.g. `go test -run '^$' -bench . ./bindings/go -args -cdm.models=10000`.
+//
+// To check a change for regressions, benchmark the commit it is based on and
+// the change, ten runs each so benchstat can tell noise from a difference,
+// and compare the two. From crates/grammar, with the change checked out:
+//
+//	git worktree add /tmp/cdm-base main
+//	(cd /tmp/cdm-base/crates/grammar && go test -run '^$' -bench . -count 10 ./bindings/go) > old.txt
+//	go test -run '^$' -bench . -count 10 ./bindings/go > new.txt
+//	benchstat old.txt new.txt
+//	git worktree remove /tmp/cdm-base
+//
+// Pass -bench a pattern such as 'Check|Resolve' to compare fewer benchmarks,
+// and the same -args to both runs. benchstat is installed with
+// `go install golang.org/x/perf/cmd/benchstat@latest`.
+//
+// To fail on a regression instead of reading the comparison, give the base
+// results to TestPerformanceBudget. It reruns every benchmark in the file
+// and fails when ns/op or allocs/op exceed the base by more than the budget
+// ratio:
+//
+//	go test -run TestPerformanceBudget ./bindings/go -args -cdm.baseline=$PWD/old.txt -cdm.budget=1.2
+//
+// The base results need -benchmem or benchmarks that report allocations,
+// as these all do, for allocs/op to be checked.
+var (
+	benchModels       = flag.Int("cdm.models", 2000, "number of models in synthetic schemas")
+	benchFields       = flag.Int("cdm.fields", 8, "number of fields per synthetic model")
+	benchExtendsDepth = flag.Int("cdm.depth", 200, "length of the synthetic extends chain")
+	benchParents      = flag.Int("cdm.parents", 50, "number of parents for the wide multiple inheritance model")
+	benchPlugins      = flag.Int("cdm.plugins", 4, "number of plugin configs per model and field")
+	benchContexts     = flag.Int("cdm.contexts", 10, "length of the synthetic chain of context files")
+	benchBaseline     = flag.String("cdm.baseline", "", "`go test -bench` output that TestPerformanceBudget compares against")
+	benchBudget       = flag.Float64("cdm.budget", 1.1, "largest ratio of ns/op and allocs/op to the baseline that TestPerformanceBudget accepts")
+)
+
+// projectShape describes a synthetic CDM schema.
+type projectShape struct {
+	// Number of plain models
+	models int
+	// Fields per model
+	fields int
+	// Length of a single-inheritance chain: Level1 extends Level0, ...
+	extendsDepth int
+	// Number of parents of one multiple-inheritance model
+	parents int
+	// Plugin configs attached to each model and field
+	plugins int
+}
+
+func defaultShape() projectShape {
+	return projectShape{
+		models:       *benchModels,
+		fields:       *benchFields,
+		extendsDepth: *benchExtendsDepth,
+		parents:      *benchParents,
+		plugins:      *benchPlugins,
+	}
+}
+
+// generateProject renders a synthetic schema with the given shape. Entity IDs
+// are assigned to every definition so the output resembles a schema that has
+// been through `cdm assign-ids`.
+func generateProject(shape projectShape) []byte {
+	var b strings.Builder
+	nextID := 1
+	id := func() int {
+		nextID++
+		return nextID - 1
+	}
+
+	pluginNames := make([]string, shape.plugins)
+	for p := range pluginNames {
+		pluginNames[p] = fmt.Sprintf("plugin%d", p)
+		fmt.Fprintf(&b, "@%s { enabled: true, prefix: \"p%d_\" }\n", pluginNames[p], p)
+	}
+	b.WriteString("\n")
+
+	fmt.Fprintf(&b, "Email: string { @validation { format: \"email\" } } #%d\n", id())
+	fmt.Fprintf(&b, "Status: \"active\" | \"pending\" | \"deleted\" #%d\n\n", id())
+
+	writeFields := func(prefix string, count int) {
+		for f := 0; f < count; f++ {
+			fieldID := f + 1
+			switch f % 4 {
+			case 0:
+				fmt.Fprintf(&b, "  %s_%d: string", prefix, f)
+			case 1:
+				fmt.Fprintf(&b, "  %s_%d?: Email", prefix, f)
+			case 2:
+				fmt.Fprintf(&b, "  %s_%d: Status = \"active\"", prefix, f)
+			case 3:
+				fmt.Fprintf(&b, "  %s_%d: number[string]", prefix, f)
+			}
+			if len(pluginNames) > 0 {
+				b.WriteString(" {")
+				for _, name := range pluginNames {
+					fmt.Fprintf(&b, " @%s { column: \"%s_%d\" }", name, prefix, f)
+				}
+				b.WriteString(" }")
+			}
+			fmt.Fprintf(&b, " #%d\n", fieldID)
+		}
+	}
+	writeModelConfigs := func(name string) {
+		for _, plugin := range pluginNames {
+			fmt.Fprintf(&b, "  @%s { table: \"%s\", indexes: { primary: { fields: [\"id\"] } } }\n", plugin, strings.ToLower(name))
+		}
+	}
+
+	for m := 0; m < shape.models; m++ {
+		name := fmt.Sprintf("Model%d", m)
+		fmt.Fprintf(&b, "%s {\n", name)
+		b.WriteString("  id: string #100\n")
+		if m > 0 {
+			fmt.Fprintf(&b, "  previous: Model%d #101\n", m-1)
+		}
+		writeFields("field", shape.fields)
+		writeModelConfigs(name)
+		fmt.Fprintf(&b, "} #%d\n\n", id())
+	}
+
+	for d := 0; d < shape.extendsDepth; d++ {
+		fmt.Fprintf(&b, "Level%d", d)
+		if d > 0 {
+			fmt.Fprintf(&b, " extends Level%d", d-1)
+		}
+		b.WriteString(" {\n")
+		writeFields(fmt.Sprintf("level%d", d), 2)
+		fmt.Fprintf(&b, "} #%d\n\n", id())
+	}
+
+	if shape.parents > 0 {
+		for p := 0; p < shape.parents; p++ {
+			fmt.Fprintf(&b, "Mixin%d {\n", p)
+			writeFields(fmt.Sprintf("mixin%d", p), 1)
+			fmt.Fprintf(&b, "} #%d\n\n", id())
+		}
+		b.WriteString("Wide extends ")
+		for p := 0; p < shape.parents; p++ {
+			if p > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(&b, "Mixin%d", p)
+		}
+		b.WriteString(" {\n")
+		writeFields("wide", shape.fields)
+		fmt.Fprintf(&b, "} #%d\n", id())
+	}
+
+	return []byte(b.String())
+}
+
+// generateContexts renders a chain of context files over the synthetic
+// schema of shape: base.cdm, then context1.cdm extending it, context2.cdm
+// extending context1.cdm and so on. Each context adds a field to some of the
+// base models, removes one from another, and adds a model of its own.
+func generateContexts(shape projectShape, depth int) map[string][]byte {
+	files := map[string][]byte{"base.cdm": generateProject(shape)}
+	parent := "base.cdm"
+	for d := 1; d <= depth; d++ {
+		var b strings.Builder
+		fmt.Fprintf(&b, "extends \"./%s\"\n\n", parent)
+		for m := d; m < shape.models; m += depth + 1 {
+			fmt.Fprintf(&b, "Model%d {\n  context%d_note?: string\n}\n\n", m, d)
+		}
+		if shape.models > 0 && shape.fields > 0 {
+			fmt.Fprintf(&b, "Model%d {\n  -field_0\n}\n\n", (d*7)%shape.models)
+		}
+		fmt.Fprintf(&b, "Context%d {\n  id: string\n  owner: Model0\n}\n", d)
+
+		parent = fmt.Sprintf("context%d.cdm", d)
+		files[parent] = []byte(b.String())
+	}
+	return files
+}
+
+// mustParse parses generated source, failing if it does not parse cleanly.
+func mustParse(tb testing.TB, source []byte) *schema.Schema {
+	tb.Helper()
+
+	s, diagnostics := schema.Parse(source)
+	if schema.HasErrors(diagnostics) {
+		tb.Fatalf("synthetic schema has errors: %v", diagnostics)
+	}
+	return s
+}
+
+func benchmarkParse(b *testing.B, source []byte) {
+	parser := newParser(b)
+	defer parser.Close()
+
+	b.SetBytes(int64(len(source)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		tree := parser.Parse(source, nil)
+		if tree.RootNode().HasError() {
+			b.Fatal("synthetic schema failed to parse")
+		}
+		tree.Close()
+	}
+}
+
+func BenchmarkParseModels(b *testing.B) {
+	shape := defaultShape()
+	shape.extendsDepth, shape.parents = 0, 0
+	benchmarkParse(b, generateProject(shape))
+}
+
+func BenchmarkParseExtendsChain(b *testing.B) {
+	benchmarkParse(b, generateProject(projectShape{extendsDepth: *benchExtendsDepth, plugins: 1}))
+}
+
+func BenchmarkParseMultipleInheritance(b *testing.B) {
+	benchmarkParse(b, generateProject(projectShape{parents: *benchParents, fields: *benchFields, plugins: 1}))
+}
+
+func BenchmarkParsePluginConfigs(b *testing.B) {
+	shape := defaultShape()
+	shape.plugins *= 4
+	benchmarkParse(b, generateProject(shape))
+}
+
+func BenchmarkParseFullProject(b *testing.B) {
+	benchmarkParse(b, generateProject(defaultShape()))
+}
+
+// BenchmarkBuild measures building the symbol table of a parsed schema: its
+// definitions, fields, configs and references.
+func BenchmarkBuild(b *testing.B) {
+	source := generateProject(defaultShape())
+	parser := newParser(b)
+	defer parser.Close()
+	tree := parser.Parse(source, nil)
+	defer tree.Close()
+
+	b.SetBytes(int64(len(source)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		schema.Build(tree.RootNode(), source)
+	}
+}
+
+// BenchmarkCheck measures the file-level semantic checks. Check records what
+// it infers on the schema, so each iteration checks a freshly parsed one.
+func BenchmarkCheck(b *testing.B) {
+	source := generateProject(defaultShape())
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		s := mustParse(b, source)
+		b.StartTimer()
+		if diagnostics := s.Check(); schema.HasErrors(diagnostics) {
+			b.Fatalf("synthetic schema failed to check: %v", diagnostics)
+		}
+	}
+}
+
+// BenchmarkResolve measures resolving a chain of -cdm.contexts context files
+// over the synthetic schema. Files are parsed once, outside the timer.
+func BenchmarkResolve(b *testing.B) {
+	files := map[string]*schema.Schema{}
+	for path, source := range generateContexts(defaultShape(), *benchContexts) {
+		files[path] = mustParse(b, source)
+	}
+	load := func(path string) (*schema.Schema, error) {
+		if s, ok := files[path]; ok {
+			return s, nil
+		}
+		return nil, os.ErrNotExist
+	}
+	top := fmt.Sprintf("context%d.cdm", *benchContexts)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, diagnostics := contexts.Resolve(top, load); schema.HasErrors(diagnostics) {
+			b.Fatalf("synthetic contexts failed to resolve: %v", diagnostics)
+		}
+	}
+}
+
+// BenchmarkCompare measures diffing two checked versions of the synthetic
+// schema: the second makes the second field of every model required, down
+// the extends chain as well, and adds a model for every hundred. A change
+// to a chain level is reported again on every level below it.
+func BenchmarkCompare(b *testing.B) {
+	shape := defaultShape()
+	source := generateProject(shape)
+	before := mustParse(b, source)
+
+	afterSource := bytes.ReplaceAll(source, []byte("_1?: Email"), []byte("_1: Email"))
+	for m := 0; m < shape.models/100; m++ {
+		afterSource = fmt.Appendf(afterSource, "\nAdded%d {\n  id: string #1\n} #%d\n", m, 1000000+m)
+	}
+	after := mustParse(b, afterSource)
+	before.Check()
+	after.Check()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var deltas int
+	for i := 0; i < b.N; i++ {
+		deltas = len(diff.Compare(before, after))
+	}
+
+	b.ReportMetric(float64(deltas), "deltas")
+}
+
+// BenchmarkFormat measures formatting the synthetic schema.
+func BenchmarkFormat(b *testing.B) {
+	source := generateProject(defaultShape())
+
+	b.SetBytes(int64(len(source)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := format.Source(source); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkReparseAfterEdit measures the incremental reparse an editor
+// performs after a single-character edit in the middle of a large schema.
+func BenchmarkReparseAfterEdit(b *testing.B) {
+	source := generateProject(defaultShape())
+	offset := strings.Index(string(source), "Model1000 {")
+	if offset < 0 {
+		offset = len(source) / 2
+	}
+
+	// Rename "Model1000" to "Nodel1000" and back again on alternate iterations.
+	edited := append([]byte(nil), source...)
+	edited[offset] = 'N'
+	prefix := string(source[:offset])
+	start := tree_sitter.Point{
+		Row:    uint(strings.Count(prefix, "\n")),
+		Column: uint(offset - strings.LastIndex(prefix, "\n") - 1),
+	}
+	end := tree_sitter.Point{Row: start.Row, Column: start.Column + 1}
+	edit := &tree_sitter.InputEdit{
+		StartByte:      uint(offset),
+		OldEndByte:     uint(offset + 1),
+		NewEndByte:     uint(offset + 1),
+		StartPosition:  start,
+		OldEndPosition: end,
+		NewEndPosition: end,
+	}
+
+	parser := newParser(b)
+	defer parser.Close()
+	tree := parser.Parse(source, nil)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	texts := [][]byte{edited, source}
+	for i := 0; i < b.N; i++ {
+		tree.Edit(edit)
+		next := parser.Parse(texts[i%2], tree)
+		tree.Close()
+		tree = next
+	}
+
+	b.StopTimer()
+	tree.Close()
+}
+
+// BenchmarkWalkTree measures a full cursor traversal, the baseline cost of
+// any analysis pass built on the syntax tree.
+func BenchmarkWalkTree(b *testing.B) {
+	source := generateProject(defaultShape())
+	parser := newParser(b)
+	defer parser.Close()
+	tree := parser.Parse(source, nil)
+	defer tree.Close()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var nodes int
+	for i := 0; i < b.N; i++ {
+		cursor := tree.Walk()
+		nodes = countNodes(cursor)
+		cursor.Close()
+	}
+
+	b.ReportMetric(float64(nodes), "nodes")
+}
+
+// countNodes visits every node below the cursor in document order.
+func countNodes(cursor *tree_sitter.TreeCursor) int {
+	count := 0
+	for {
+		count++
+		if cursor.GotoFirstChild() {
+			continue
+		}
+		for !cursor.GotoNextSibling() {
+			if !cursor.GotoParent() {
+				return count
+			}
+		}
+	}
+}
+
+func TestSyntheticProjectParses(t *testing.T) {
+	parser := newParser(t)
+	defer parser.Close()
+
+	source := generateProject(projectShape{models: 20, fields: 8, extendsDepth: 5, parents: 5, plugins: 2})
+	tree := parseWithDeadline(t, parser, source)
+	defer tree.Close()
+
+	if root := tree.RootNode(); root.HasError() {
+		t.Fatalf("synthetic schema failed to parse: %s", root.ToSexp())
+	}
+	if diagnostics := mustParse(t, source).Check(); schema.HasErrors(diagnostics) {
+		t.Fatalf("synthetic schema failed to check: %v", diagnostics)
+	}
+}
+
+func TestSyntheticContextsResolve(t *testing.T) {
+	generated := generateContexts(projectShape{models: 20, fields: 8, plugins: 2}, 3)
+	load := func(path string) (*schema.Schema, error) {
+		source, ok := generated[path]
+		if !ok {
+			return nil, os.ErrNotExist
+		}
+		return mustParse(t, source), nil
+	}
+
+	c, diagnostics := contexts.Resolve("context3.cdm", load)
+	if schema.HasErrors(diagnostics) {
+		t.Fatalf("synthetic contexts failed to resolve: %v", diagnostics)
+	}
+	if c.Schema.Model("Context1") == nil || c.Schema.Model("Context3") == nil {
+		t.Errorf("resolved schema lacks the models the contexts add")
+	}
+}
+
+// benchmarks lists the benchmarks TestPerformanceBudget can rerun.
+var benchmarks = map[string]func(*testing.B){
+	"BenchmarkParseModels":              BenchmarkParseModels,
+	"BenchmarkParseExtendsChain":        BenchmarkParseExtendsChain,
+	"BenchmarkParseMultipleInheritance": BenchmarkParseMultipleInheritance,
+	"BenchmarkParsePluginConfigs":       BenchmarkParsePluginConfigs,
+	"BenchmarkParseFullProject":         BenchmarkParseFullProject,
+	"BenchmarkBuild":                    BenchmarkBuild,
+	"BenchmarkCheck":                    BenchmarkCheck,
+	"BenchmarkResolve":                  BenchmarkResolve,
+	"BenchmarkCompare":                  BenchmarkCompare,
+	"BenchmarkFormat":                   BenchmarkFormat,
+	"BenchmarkReparseAfterEdit":         BenchmarkReparseAfterEdit,
+	"BenchmarkWalkTree":                 BenchmarkWalkTree,
+}
+
+// benchResult is the median of a benchmark's runs in a baseline file.
+type benchResult struct {
+	nsPerOp, allocsPerOp float64
+}
+
+// readBaseline reads `go test -bench` output, keeping the median ns/op and
+// allocs/op of each benchmark. The -N GOMAXPROCS suffix is dropped from
+// names.
+func readBaseline(path string) (map[string]benchResult, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	samples := map[string][2][]float64{}
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 4 || !strings.HasPrefix(fields[0], "Benchmark") {
+			continue
+		}
+		name := fields[0]
+		if i := strings.LastIndex(name, "-"); i > 0 {
+			if _, err := strconv.Atoi(name[i+1:]); err == nil {
+				name = name[:i]
+			}
+		}
+		s := samples[name]
+		for i := 2; i+1 < len(fields); i += 2 {
+			v, err := strconv.ParseFloat(fields[i], 64)
+			if err != nil {
+				continue
+			}
+			switch fields[i+1] {
+			case "ns/op":
+				s[0] = append(s[0], v)
+			case "allocs/op":
+				s[1] = append(s[1], v)
+			}
+		}
+		samples[name] = s
+	}
+
+	results := map[string]benchResult{}
+	for name, s := range samples {
+		results[name] = benchResult{nsPerOp: median(s[0]), allocsPerOp: median(s[1])}
+	}
+	return results, nil
+}
+
+// median returns the median of values, or -1 if there are none.
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return -1
+	}
+	sort.Float64s(values)
+	return values[len(values)/2]
+}
+
+// budgetRuns is how many times TestPerformanceBudget runs each benchmark.
+// It compares the median run, as it does for the baseline, so that one
+// noisy run does not fail the budget.
+const budgetRuns = 3
+
+// TestPerformanceBudget reruns the benchmarks in the -cdm.baseline file and
+// fails when one is slower or allocates more than -cdm.budget times its
+// baseline. See the flags for the workflow.
+func TestPerformanceBudget(t *testing.T) {
+	if *benchBaseline == "" {
+		t.Skip("no -cdm.baseline given")
+	}
+	baseline, err := readBaseline(*benchBaseline)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(baseline) == 0 {
+		t.Fatalf("%s has no benchmark results", *benchBaseline)
+	}
+
+	names := make([]string, 0, len(baseline))
+	for name := range baseline {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		t.Run(strings.TrimPrefix(name, "Benchmark"), func(t *testing.T) {
+			benchmark, ok := benchmarks[name]
+			if !ok {
+				t.Skipf("%s is not a benchmark of this package", name)
+			}
+			want := baseline[name]
+			var ns, allocs []float64
+			for i := 0; i < budgetRuns; i++ {
+				result := testing.Benchmark(benchmark)
+				if result.N == 0 {
+					t.Fatalf("%s failed", name)
+				}
+				ns = append(ns, float64(result.NsPerOp()))
+				allocs = append(allocs, float64(result.AllocsPerOp()))
+			}
+
+			check := func(unit string, got, want float64) {
+				if want < 0 {
+					return
+				}
+				if got > want*(*benchBudget) {
+					t.Errorf("%s: %.0f %s, %.2fx the baseline's %.0f; the budget is %.2fx", name, got, unit, got/max(want, 1), want, *benchBudget)
+				} else {
+					t.Logf("%s: %.0f %s, baseline %.0f", name, got, unit, want)
+				}
+			}
+			check("ns/op", median(ns), want.nsPerOp)
+			check("allocs/op", median(allocs), want.allocsPerOp)
+		})
+	}
+}
@@ -0,0 +1,211 @@
+// Package classify implements `@classify` data classification tags, such as
+// `@classify { level: "pii", category: "email" }`.
+//
+// A classification on a type alias applies to every field typed with that
+// alias, so tagging `Email` once makes every Email field personal data.
+// Inventory lists the classified fields of a schema for compliance reports,
+// and Policy fails a build when classified fields are missing required
+// tags.
+package classify
+
+import (
+	_ "embed"
+
+	"github.com/larner-dev/cdm/bindings/go/schema"
+	"github.com/larner-dev/cdm/bindings/go/value"
+)
+
+// PluginName is the name classifications are configured under.
+const PluginName = "classify"
+
+//go:embed schema.cdm
+var settingsSource []byte
+
+// SettingsSchema returns the parsed schema.cdm that @classify configs must
+// conform to.
+var SettingsSchema = schema.SettingsLoader(PluginName, settingsSource)
+
+// Levels lists the sensitivity levels from least to most sensitive.
+var Levels = []string{"public", "internal", "confidential", "pii", "sensitive"}
+
+// rank returns the position of level in Levels, or -1 when it is unset or
+// unknown.
+func rank(level string) int {
+	for i, l := range Levels {
+		if l == level {
+			return i
+		}
+	}
+	return -1
+}
+
+// Classification is the effective @classify config of a field or alias.
+type Classification struct {
+	Level     string
+	Category  string
+	Retention string
+	// Source is the type alias the level was inherited from, or empty when
+	// the field is classified directly.
+	Source string
+}
+
+// IsZero reports whether no key is set.
+func (c Classification) IsZero() bool {
+	return c.Level == "" && c.Category == "" && c.Retention == ""
+}
+
+// Merge returns c with every key that override sets replacing c's.
+func (c Classification) Merge(override Classification) Classification {
+	if override.Level != "" {
+		c.Level = override.Level
+		c.Source = override.Source
+	}
+	if override.Category != "" {
+		c.Category = override.Category
+	}
+	if override.Retention != "" {
+		c.Retention = override.Retention
+	}
+	return c
+}
+
+func fromConfigs(configs []*schema.Config) Classification {
+	var c Classification
+	for _, config := range configs {
+		if config.Name != PluginName {
+			continue
+		}
+		for _, entry := range schema.ConfigValue(config).Entries {
+			if entry.Value.Kind != value.String {
+				continue
+			}
+			switch entry.Key {
+			case "level":
+				c.Level = entry.Value.Text
+			case "category":
+				c.Category = entry.Value.Text
+			case "retention":
+				c.Retention = entry.Value.Text
+			}
+		}
+	}
+	return c
+}
+
+// Resolver computes effective classifications in a schema.
+type Resolver struct {
+	schema *schema.Schema
+}
+
+// NewResolver returns a Resolver for the definitions in s.
+func NewResolver(s *schema.Schema) *Resolver {
+	return &Resolver{schema: s}
+}
+
+// Alias returns the classification of a type alias, including what it
+// inherits from the aliases it is defined in terms of.
+func (r *Resolver) Alias(alias *schema.TypeAlias) Classification {
+	return r.typeClassification(schema.Named(alias.Name), map[string]bool{})
+}
+
+// Field returns the classification of field, one of model's effective
+// fields: what the field's type inherits from its aliases, overridden by
+// the field's own @classify and then by field overrides in model and its
+// ancestors.
+func (r *Resolver) Field(model *schema.Model, field *schema.Field) Classification {
+	c := r.typeClassification(field.FieldType(), map[string]bool{})
+	c = c.Merge(fromConfigs(field.Configs))
+	for _, configs := range r.overrides(model, field.Name, map[string]bool{}) {
+		c = c.Merge(fromConfigs(configs))
+	}
+	return c
+}
+
+// overrides returns the configs of the field overrides for name in model's
+// ancestry, farthest ancestor first so that nearer ones win when merged.
+func (r *Resolver) overrides(model *schema.Model, name string, visiting map[string]bool) [][]*schema.Config {
+	if visiting[model.Name] || model.Field(name) != nil {
+		return nil
+	}
+	visiting[model.Name] = true
+
+	var out [][]*schema.Config
+	for _, parent := range model.Parents {
+		if parentModel := r.schema.Model(parent.Name); parentModel != nil {
+			out = append(out, r.overrides(parentModel, name, visiting)...)
+		}
+	}
+	for _, override := range model.Overrides {
+		if override.Name == name {
+			out = append(out, override.Configs)
+		}
+	}
+	return out
+}
+
+// typeClassification finds the classified aliases a type is built from.
+// Arrays, maps and unions carry the classification of their parts; when
+// parts differ, the most sensitive one wins.
+func (r *Resolver) typeClassification(t *schema.TypeExpr, visiting map[string]bool) Classification {
+	var out Classification
+	consider := func(c Classification) {
+		if out.IsZero() || rank(c.Level) > rank(out.Level) {
+			out = c
+		}
+	}
+
+	switch t.Kind {
+	case schema.Identifier:
+		alias := r.schema.TypeAlias(t.Name)
+		if alias == nil || visiting[alias.Name] {
+			return Classification{}
+		}
+		visiting[alias.Name] = true
+		defer delete(visiting, alias.Name)
+
+		own := fromConfigs(alias.Configs)
+		if own.Level != "" {
+			own.Source = alias.Name
+		}
+		return r.typeClassification(alias.Type, visiting).Merge(own)
+	case schema.Array:
+		return r.typeClassification(t.Element, visiting)
+	case schema.Map:
+		return r.typeClassification(t.Element, visiting)
+	case schema.Union:
+		for _, member := range t.Members {
+			if c := r.typeClassification(member, visiting); !c.IsZero() {
+				consider(c)
+			}
+		}
+	}
+	return out
+}
+
+// Check reports @classify configs on type aliases, fields and field
+// overrides that do not conform to schema.cdm (E402).
+func Check(s *schema.Schema) []schema.Diagnostic {
+	var diagnostics []schema.Diagnostic
+	check := func(configs []*schema.Config, settings string) {
+		for _, config := range configs {
+			if config.Name != PluginName {
+				continue
+			}
+			_, mismatch := schema.CheckConfig(SettingsSchema(), config, settings)
+			diagnostics = append(diagnostics, mismatch...)
+		}
+	}
+
+	for _, alias := range s.TypeAliases {
+		check(alias.Configs, "TypeAliasSettings")
+	}
+	for _, model := range s.Models {
+		for _, field := range model.Fields {
+			check(field.Configs, "FieldSettings")
+		}
+		for _, override := range model.Overrides {
+			check(override.Configs, "FieldSettings")
+		}
+	}
+	return diagnostics
+}
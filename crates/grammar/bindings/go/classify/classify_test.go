@@ -0,0 +1,265 @@
+package classify_test
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/larner-dev/cdm/bindings/go/classify"
+	"github.com/larner-dev/cdm/bindings/go/schema"
+)
+
+func mustParse(t *testing.T, source string) *schema.Schema {
+	t.Helper()
+
+	s, diagnostics := schema.Parse([]byte(source))
+	if len(diagnostics) > 0 {
+		t.Fatalf("Parse returned diagnostics: %v", diagnostics)
+	}
+	return s
+}
+
+const users = `@sql { build_output: "db" }
+@typescript { build_output: ["web/src/types", "api/src/types"] }
+@docs
+
+Email: string {
+  @classify { level: "pii", category: "email", retention: "P2Y" }
+}
+
+WorkEmail: Email {
+  @classify { category: "work_email" }
+}
+
+Phone: string {
+  @classify { level: pii, category: phone }
+}
+
+Base {
+  id: string
+  email: Email
+}
+
+User extends Base {
+  work_email?: WorkEmail
+  phones: Phone[]
+  contact: Email | Phone { @classify { retention: "P1Y" } }
+  notes: string { @classify { level: "confidential" } }
+  ssn: string { @classify { level: "sensitive", category: "national_id" } @sql { column_name: "ssn_hash" } }
+  @sql { table_name: "people" }
+}
+
+Admin extends User {
+  notes { @classify { level: "internal", retention: "P30D" } }
+}
+`
+
+func TestResolver(t *testing.T) {
+	s := mustParse(t, users)
+	r := classify.NewResolver(s)
+
+	if got := r.Alias(s.TypeAlias("WorkEmail")); got != (classify.Classification{Level: "pii", Category: "work_email", Retention: "P2Y", Source: "Email"}) {
+		t.Errorf("Alias(WorkEmail) = %+v", got)
+	}
+
+	cases := []struct {
+		model, field string
+		want         string
+	}{
+		{"User", "id", ""},
+		{"User", "email", "User.email: pii (email) via Email"},
+		{"User", "work_email", "User.work_email: pii (work_email) via Email"},
+		{"User", "phones", "User.phones: pii (phone) via Phone"},
+		{"User", "notes", "User.notes: confidential"},
+		{"Admin", "notes", "Admin.notes: internal"},
+		{"Admin", "ssn", "Admin.ssn: sensitive (national_id)"},
+	}
+	report := classify.Inventory(s, "schema.cdm")
+	entries := map[string]string{}
+	for _, e := range report.Entries {
+		entries[e.Model+"."+e.Field] = e.String()
+	}
+	for _, c := range cases {
+		if got := entries[c.model+"."+c.field]; got != c.want {
+			t.Errorf("%s.%s = %q, want %q", c.model, c.field, got, c.want)
+		}
+	}
+}
+
+func TestInventoryFormats(t *testing.T) {
+	s := mustParse(t, users)
+	report := classify.Inventory(s, "contexts/api.cdm")
+
+	var csv bytes.Buffer
+	if err := report.WriteCSV(&csv); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(csv.String(), "\n")
+	if lines[0] != "Model,Field,Level,Category,Retention,Source,Context,Outputs" {
+		t.Errorf("CSV header = %s", lines[0])
+	}
+	for _, want := range []string{
+		"Base,email,pii,email,P2Y,Email,contexts/api.cdm,sql:bases.email; typescript:Base.email",
+		"User,ssn,sensitive,national_id,,,contexts/api.cdm,sql:people.ssn_hash; typescript:User.ssn",
+		"User,contact,pii,email,P1Y,Email,contexts/api.cdm,sql:people.contact; typescript:User.contact",
+	} {
+		if !strings.Contains(csv.String(), want+"\n") {
+			t.Errorf("CSV is missing %s\n%s", want, csv.String())
+		}
+	}
+
+	var md bytes.Buffer
+	if err := report.WriteMarkdown(&md); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(md.String(), "| Admin | notes | internal |  | P30D |  | contexts/api.cdm | sql:admins.notes; typescript:Admin.notes |\n") {
+		t.Errorf("Markdown is missing the Admin.notes row\n%s", md.String())
+	}
+
+	var js bytes.Buffer
+	if err := report.WriteJSON(&js); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(js.String(), `"paths": [
+          "web/src/types",
+          "api/src/types"
+        ],
+        "object": "User.phones"`) {
+		t.Errorf("JSON is missing the typescript output of User.phones\n%s", js.String())
+	}
+}
+
+func TestInventoryObjectNames(t *testing.T) {
+	outputs := func(source string) map[string]string {
+		t.Helper()
+		out := map[string]string{}
+		for _, e := range classify.Inventory(mustParse(t, source), "schema.cdm").Entries {
+			var objects []string
+			for _, o := range e.Outputs {
+				objects = append(objects, o.String())
+			}
+			out[e.Model+"."+e.Field] = strings.Join(objects, "; ")
+		}
+		return out
+	}
+
+	// By default tables are snake_case and plural, and TypeScript keeps
+	// the CDM names.
+	got := outputs(`@sql { build_output: "db" }
+@typescript { build_output: "web" }
+
+Email: string { @classify { level: "pii" } }
+
+BlogPost { authorEmail: Email }
+Category { email: Email }
+Person { email: Email }
+Hero { email: Email }
+Photo { email: Email }
+`)
+	for key, want := range map[string]string{
+		"BlogPost.authorEmail": "sql:blog_posts.author_email; typescript:BlogPost.authorEmail",
+		"Category.email":       "sql:categories.email; typescript:Category.email",
+		"Person.email":         "sql:people.email; typescript:Person.email",
+		"Hero.email":           "sql:heroes.email; typescript:Hero.email",
+		"Photo.email":          "sql:photos.email; typescript:Photo.email",
+	} {
+		if got[key] != want {
+			t.Errorf("%s outputs = %q, want %q", key, got[key], want)
+		}
+	}
+
+	got = outputs(`@sql { build_output: "db", table_name_format: "preserve", column_name_format: "camel_case", pluralize_table_names: false }
+@typescript { build_output: "web", type_name_format: "snake", field_name_format: "camel" }
+
+Email: string { @classify { level: "pii" } }
+
+BlogPost {
+  author_email: Email
+  backup_email: Email { @sql { skip: true } @typescript { field_name: "backup" } }
+  @typescript { export_name: "Post" }
+}
+
+AuditLog {
+  actor_email: Email
+  @typescript { skip: true }
+}
+`)
+	for key, want := range map[string]string{
+		"BlogPost.author_email": "sql:BlogPost.authorEmail; typescript:Post.authorEmail",
+		"BlogPost.backup_email": "typescript:Post.backup",
+		"AuditLog.actor_email":  "sql:AuditLog.actorEmail",
+	} {
+		if got[key] != want {
+			t.Errorf("%s outputs = %q, want %q", key, got[key], want)
+		}
+	}
+}
+
+// The inventory names tables and columns with cdm-plugin-sql's defaults,
+// which its settings schema declares.
+func TestInventorySQLDefaults(t *testing.T) {
+	source, err := os.ReadFile("../../../../cdm-plugin-sql/schema.cdm")
+	if err != nil {
+		t.Skipf("cdm-plugin-sql settings schema not found: %v", err)
+	}
+	settings := mustParse(t, string(source)).Model("GlobalSettings")
+	for key, want := range map[string]string{
+		"table_name_format":     `"snake_case"`,
+		"column_name_format":    `"snake_case"`,
+		"pluralize_table_names": "true",
+	} {
+		field := settings.Field(key)
+		if field == nil || field.Default == nil || field.Default.String() != want {
+			t.Errorf("GlobalSettings.%s default = %v, want %s", key, field, want)
+		}
+	}
+}
+
+func TestPolicy(t *testing.T) {
+	s := mustParse(t, users)
+	diagnostics := classify.DefaultPolicy.Check(classify.Inventory(s, "schema.cdm"))
+
+	var got []string
+	for _, d := range diagnostics {
+		got = append(got, d.String())
+	}
+	want := []string{
+		`error[24:3]: E111: Field 'User.phones' is classified pii but has no retention; add @classify { retention: "..." }`,
+		`error[27:3]: E111: Field 'User.ssn' is classified sensitive but has no retention; add @classify { retention: "..." }`,
+		`error[24:3]: E111: Field 'Admin.phones' is classified pii but has no retention; add @classify { retention: "..." }`,
+		`error[27:3]: E111: Field 'Admin.ssn' is classified sensitive but has no retention; add @classify { retention: "..." }`,
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Check =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	if diagnostics := (classify.Policy{}).Check(classify.Inventory(s, "schema.cdm")); len(diagnostics) > 0 {
+		t.Errorf("an empty policy reported %v", diagnostics)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	cases := []struct {
+		source string
+		want   int
+	}{
+		{`Email: string { @classify { level: "pii", category: "email", retention: "P2Y" } }`, 0},
+		{`Email: string { @classify { level: pii } }`, 0},
+		{`Email: string { @classify { level: "secret" } }`, 1},
+		{`Email: string { @classify { owner: "team" } }`, 1},
+		{"User {\n  email: string { @classify { retention: 2 } }\n}", 1},
+		{"Base {\n  email: string\n}\nUser extends Base {\n  email { @classify { level: \"top\" } }\n}", 1},
+	}
+	for _, c := range cases {
+		diagnostics := classify.Check(mustParse(t, c.source))
+		if len(diagnostics) != c.want {
+			t.Errorf("Check(%q) = %v, want %d diagnostics", c.source, diagnostics, c.want)
+		}
+		for _, d := range diagnostics {
+			if d.Code != schema.CodeInvalidPluginConfig {
+				t.Errorf("Check(%q) code = %s, want %s", c.source, d.Code, schema.CodeInvalidPluginConfig)
+			}
+		}
+	}
+}
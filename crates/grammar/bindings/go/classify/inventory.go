@@ -0,0 +1,189 @@
+package classify
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/larner-dev/cdm/bindings/go/position"
+	"github.com/larner-dev/cdm/bindings/go/schema"
+	"github.com/larner-dev/cdm/bindings/go/value"
+)
+
+// Entry is one classified field in an inventory.
+type Entry struct {
+	Model     string `json:"model"`
+	Field     string `json:"field"`
+	Level     string `json:"level"`
+	Category  string `json:"category"`
+	Retention string `json:"retention"`
+	// Type alias the level was inherited from; empty when the field is
+	// classified directly
+	Source string `json:"source"`
+	// Context file the schema was read from
+	Context string `json:"context"`
+	// Generated outputs the field flows into
+	Outputs []Output `json:"outputs"`
+	// Span of the field's declaration, which may be in a parent model
+	Span position.Span `json:"-"`
+}
+
+// Output is a generated artifact a field flows into: the plugin that
+// generates it, the directories it is written to, and the name of the
+// object, such as a table column or a type property.
+type Output struct {
+	Plugin string   `json:"plugin"`
+	Paths  []string `json:"paths"`
+	Object string   `json:"object"`
+}
+
+func (o Output) String() string {
+	return o.Plugin + ":" + o.Object
+}
+
+// Report is the inventory of classified fields in one context.
+type Report struct {
+	Entries []Entry
+}
+
+// Inventory lists every classified field of every model in s, inherited
+// fields included, in declaration order. context is the path of the file
+// s was read from, recorded on each entry.
+func Inventory(s *schema.Schema, context string) Report {
+	r := NewResolver(s)
+	report := Report{Entries: []Entry{}}
+	for _, model := range s.Models {
+		for _, field := range s.Fields(model) {
+			c := r.Field(model, field)
+			if c.IsZero() {
+				continue
+			}
+			report.Entries = append(report.Entries, Entry{
+				Model:     model.Name,
+				Field:     field.Name,
+				Level:     c.Level,
+				Category:  c.Category,
+				Retention: c.Retention,
+				Source:    c.Source,
+				Context:   context,
+				Outputs:   outputs(s, model, field),
+				Span:      field.Span,
+			})
+		}
+	}
+	return report
+}
+
+// outputs lists the outputs of every imported plugin that is configured to
+// write files. Objects are named the way the plugin names them, such as
+// `users.email` for the email column of the SQL table generated for User;
+// plugins without an object namer keep the CDM names.
+func outputs(s *schema.Schema, model *schema.Model, field *schema.Field) []Output {
+	out := []Output{}
+	for _, plugin := range s.Plugins {
+		if plugin.Config == nil {
+			continue
+		}
+		var paths []string
+		for _, key := range []string{"build_output", "migrations_output"} {
+			paths = append(paths, stringValues(plugin.Config, key)...)
+		}
+		if len(paths) == 0 {
+			continue
+		}
+		object, ok := model.Name+"."+field.Name, true
+		if namer := objectNamers[plugin.Name]; namer != nil {
+			object, ok = namer(plugin.Config, model, field)
+		}
+		if ok {
+			out = append(out, Output{Plugin: plugin.Name, Paths: paths, Object: object})
+		}
+	}
+	return out
+}
+
+// stringValues returns the string or strings stored under key in an
+// object.
+func stringValues(config *value.Value, key string) []string {
+	v, ok := config.Get(key)
+	if !ok {
+		return nil
+	}
+	switch v.Kind {
+	case value.String:
+		return []string{v.Text}
+	case value.Array:
+		var out []string
+		for _, item := range v.Items {
+			if item.Kind == value.String {
+				out = append(out, item.Text)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+var columns = []string{"Model", "Field", "Level", "Category", "Retention", "Source", "Context", "Outputs"}
+
+func (e Entry) row() []string {
+	var outputs []string
+	for _, output := range e.Outputs {
+		outputs = append(outputs, output.String())
+	}
+	return []string{e.Model, e.Field, e.Level, e.Category, e.Retention, e.Source, e.Context, strings.Join(outputs, "; ")}
+}
+
+// WriteCSV writes the report as CSV with a header row. Outputs are joined
+// into one column, separated by "; ".
+func (r Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(columns); err != nil {
+		return err
+	}
+	for _, e := range r.Entries {
+		if err := cw.Write(e.row()); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes the report as an indented JSON array of entries.
+func (r Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r.Entries)
+}
+
+// WriteMarkdown writes the report as a Markdown table.
+func (r Report) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("| " + strings.Join(columns, " | ") + " |\n")
+	b.WriteString("|" + strings.Repeat(" --- |", len(columns)) + "\n")
+	for _, e := range r.Entries {
+		cells := e.row()
+		for i, cell := range cells {
+			cells[i] = strings.ReplaceAll(cell, "|", `\|`)
+		}
+		b.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// String summarizes an entry for terminal output, such as
+// `User.email: pii (email) via Email`.
+func (e Entry) String() string {
+	out := fmt.Sprintf("%s.%s: %s", e.Model, e.Field, e.Level)
+	if e.Category != "" {
+		out += " (" + e.Category + ")"
+	}
+	if e.Source != "" {
+		out += " via " + e.Source
+	}
+	return out
+}
@@ -0,0 +1,237 @@
+package classify
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/larner-dev/cdm/bindings/go/schema"
+	"github.com/larner-dev/cdm/bindings/go/value"
+)
+
+// objectNamers name the object a field becomes in the output of plugins
+// whose configs can rename it, following the plugins' own naming rules.
+// global is the plugin's import config. They report false when the model or
+// field produces no output.
+var objectNamers = map[string]func(global *value.Value, model *schema.Model, field *schema.Field) (string, bool){
+	"sql":        sqlObject,
+	"typescript": typescriptObject,
+}
+
+// sqlObject names the column a field becomes, as `table.column`. The
+// defaults are those of cdm-plugin-sql's schema.cdm: snake_case table and
+// column names, with table names pluralized.
+func sqlObject(global *value.Value, model *schema.Model, field *schema.Field) (string, bool) {
+	modelConfig, fieldConfig := configValue(model.Config("sql")), configValue(field.Config("sql"))
+	if boolSetting(modelConfig, "skip", false) || boolSetting(fieldConfig, "skip", false) {
+		return "", false
+	}
+	table, ok := stringSetting(modelConfig, "table_name")
+	if !ok {
+		format, _ := stringSetting(global, "table_name_format")
+		table = sqlCase(model.Name, format)
+		if boolSetting(global, "pluralize_table_names", true) {
+			table = pluralize(table)
+		}
+	}
+	column, ok := stringSetting(fieldConfig, "column_name")
+	if !ok {
+		format, _ := stringSetting(global, "column_name_format")
+		column = sqlCase(field.Name, format)
+	}
+	return table + "." + column, true
+}
+
+// typescriptObject names the property a field becomes, as `Type.property`.
+// cdm-plugin-typescript keeps names as written unless type_name_format or
+// field_name_format is set.
+func typescriptObject(global *value.Value, model *schema.Model, field *schema.Field) (string, bool) {
+	modelConfig, fieldConfig := configValue(model.Config("typescript")), configValue(field.Config("typescript"))
+	if boolSetting(modelConfig, "skip", false) || boolSetting(fieldConfig, "skip", false) {
+		return "", false
+	}
+	typeName, ok := stringSetting(modelConfig, "export_name")
+	if !ok {
+		format, _ := stringSetting(global, "type_name_format")
+		typeName = typescriptCase(model.Name, format)
+	}
+	property, ok := stringSetting(fieldConfig, "field_name")
+	if !ok {
+		format, _ := stringSetting(global, "field_name_format")
+		property = typescriptCase(field.Name, format)
+	}
+	return typeName + "." + property, true
+}
+
+func configValue(config *schema.Config) *value.Value {
+	if config == nil {
+		return nil
+	}
+	return &config.Value
+}
+
+func stringSetting(config *value.Value, key string) (string, bool) {
+	if config == nil {
+		return "", false
+	}
+	v, ok := config.Get(key)
+	if !ok || v.Kind != value.String {
+		return "", false
+	}
+	return v.Text, true
+}
+
+func boolSetting(config *value.Value, key string, fallback bool) bool {
+	if config == nil {
+		return fallback
+	}
+	v, ok := config.Get(key)
+	if !ok || v.Kind != value.Bool {
+		return fallback
+	}
+	return v.Bool
+}
+
+// sqlCase applies a table_name_format or column_name_format. Unset and
+// unknown formats are snake_case.
+func sqlCase(name, format string) string {
+	switch format {
+	case "preserve":
+		return name
+	case "camel_case":
+		return camelCase(name)
+	case "pascal_case":
+		return pascalCase(name)
+	}
+	return snakeCase(name)
+}
+
+// typescriptCase applies a type_name_format or field_name_format. Unset
+// and unknown formats preserve the name.
+func typescriptCase(name, format string) string {
+	switch format {
+	case "pascal":
+		return pascalCase(name)
+	case "camel":
+		return camelCase(name)
+	case "snake":
+		return snakeCase(name)
+	case "kebab":
+		return strings.ReplaceAll(snakeCase(name), "_", "-")
+	case "constant":
+		return strings.ToUpper(snakeCase(name))
+	}
+	return name
+}
+
+// The case conversions and pluralize match the ones cdm-plugin-interface
+// gives plugins, so that `HTTPRequest` becomes `httprequest` in snake case
+// just as it does in the generated output.
+
+func snakeCase(s string) string {
+	var b strings.Builder
+	prevUpper := false
+	for i, r := range []rune(s) {
+		if unicode.IsUpper(r) {
+			if i > 0 && !prevUpper {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+			prevUpper = true
+		} else {
+			b.WriteRune(r)
+			prevUpper = false
+		}
+	}
+	return b.String()
+}
+
+func camelCase(s string) string {
+	var b strings.Builder
+	capitalize := false
+	for i, r := range []rune(s) {
+		switch {
+		case r == '_' || r == '-' || r == ' ':
+			capitalize = true
+		case i == 0:
+			b.WriteRune(unicode.ToLower(r))
+		case capitalize:
+			b.WriteRune(unicode.ToUpper(r))
+			capitalize = false
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func pascalCase(s string) string {
+	var b strings.Builder
+	capitalize := true
+	for _, r := range s {
+		switch {
+		case r == '_' || r == '-' || r == ' ':
+			capitalize = true
+		case capitalize:
+			b.WriteRune(unicode.ToUpper(r))
+			capitalize = false
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+var irregularPlurals = map[string]string{
+	"person": "people", "child": "children", "man": "men", "woman": "women",
+	"tooth": "teeth", "foot": "feet", "mouse": "mice", "goose": "geese",
+}
+
+var unchangingPlurals = map[string]bool{"sheep": true, "fish": true, "deer": true, "species": true, "series": true}
+
+// pluralOnlyS lists words ending in -o that take -s rather than -es.
+var pluralOnlyS = map[string]bool{
+	"repo": true, "photo": true, "video": true, "memo": true, "logo": true, "demo": true, "auto": true,
+	"piano": true, "zero": true, "kilo": true, "euro": true, "pro": true, "disco": true, "casino": true,
+	"dynamo": true, "embryo": true, "fiasco": true, "ghetto": true, "inferno": true, "limo": true,
+	"manifesto": true, "motto": true, "portfolio": true, "radio": true, "ratio": true, "scenario": true,
+	"solo": true, "soprano": true, "studio": true, "taco": true, "tempo": true, "tobacco": true,
+	"torso": true, "trio": true, "typo": true, "veto": true, "zoo": true,
+}
+
+func pluralize(s string) string {
+	if s == "" {
+		return ""
+	}
+	lower := strings.ToLower(s)
+	if plural, ok := irregularPlurals[lower]; ok {
+		if unicode.IsUpper([]rune(s)[0]) {
+			return strings.ToUpper(plural[:1]) + plural[1:]
+		}
+		return plural
+	}
+	if unchangingPlurals[lower] {
+		return s
+	}
+	consonantBefore := func(suffix string) bool {
+		return len(lower) > len(suffix) && !strings.ContainsRune("aeiou", rune(lower[len(lower)-len(suffix)-1]))
+	}
+	switch {
+	case strings.HasSuffix(lower, "s"), strings.HasSuffix(lower, "x"), strings.HasSuffix(lower, "z"),
+		strings.HasSuffix(lower, "ch"), strings.HasSuffix(lower, "sh"):
+		return s + "es"
+	case strings.HasSuffix(lower, "y"):
+		if consonantBefore("y") {
+			return s[:len(s)-1] + "ies"
+		}
+		return s + "s"
+	case strings.HasSuffix(lower, "f"):
+		return s[:len(s)-1] + "ves"
+	case strings.HasSuffix(lower, "fe"):
+		return s[:len(s)-2] + "ves"
+	case strings.HasSuffix(lower, "o"):
+		if !pluralOnlyS[lower] && consonantBefore("o") {
+			return s + "es"
+		}
+	}
+	return s + "s"
+}
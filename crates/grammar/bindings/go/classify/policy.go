@@ -0,0 +1,37 @@
+package classify
+
+import (
+	"github.com/larner-dev/cdm/bindings/go/schema"
+)
+
+// Policy is a set of rules classified fields must follow. A build fails
+// when Check returns errors.
+type Policy struct {
+	// Levels whose fields must have a retention tag
+	RequireRetention []string
+}
+
+// DefaultPolicy requires a retention tag on personal and sensitive data.
+var DefaultPolicy = Policy{RequireRetention: []string{"pii", "sensitive"}}
+
+// Check reports every entry of report that breaks the policy (E111).
+func (p Policy) Check(report Report) []schema.Diagnostic {
+	var diagnostics []schema.Diagnostic
+	for _, e := range report.Entries {
+		if e.Retention == "" && p.requiresRetention(e.Level) {
+			diagnostics = append(diagnostics, schema.Errorf(schema.CodeMissingRetention, e.Span,
+				"Field '%s.%s' is classified %s but has no retention; add @%s { retention: \"...\" }",
+				e.Model, e.Field, e.Level, PluginName))
+		}
+	}
+	return diagnostics
+}
+
+func (p Policy) requiresRetention(level string) bool {
+	for _, l := range p.RequireRetention {
+		if l == level {
+			return true
+		}
+	}
+	return false
+}
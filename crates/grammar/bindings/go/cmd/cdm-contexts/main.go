@@ -0,0 +1,78 @@
+// Command cdm-contexts compares sibling context files. For every model and
+// field, it shows whether each context includes, removes, overrides or
+// reconfigures it relative to the base the context extends:
+//
+//	cdm-contexts -format markdown api.cdm admin.cdm warehouse.cdm
+//
+// The format is table (the default), markdown or html.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/larner-dev/cdm/bindings/go/contexts"
+	"github.com/larner-dev/cdm/bindings/go/schema"
+)
+
+func main() {
+	format := flag.String("format", "table", "output format: table, markdown or html")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: cdm-contexts [-format table|markdown|html] context.cdm...\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var resolved []*contexts.Context
+	failed := false
+	for _, path := range flag.Args() {
+		c, diagnostics := contexts.Resolve(path, load)
+		for _, d := range diagnostics {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", path, d)
+		}
+		if c == nil || schema.HasErrors(diagnostics) {
+			failed = true
+			continue
+		}
+		resolved = append(resolved, c)
+	}
+	if failed {
+		os.Exit(1)
+	}
+
+	report := contexts.Compare(resolved...)
+	var err error
+	switch *format {
+	case "table":
+		err = report.WriteTable(os.Stdout)
+	case "markdown":
+		err = report.WriteMarkdown(os.Stdout)
+	case "html":
+		err = report.WriteHTML(os.Stdout)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown format %q; expected table, markdown or html\n", *format)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// load reads and parses a file, failing on syntax errors.
+func load(path string) (*schema.Schema, error) {
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s, diagnostics := schema.Parse(source)
+	if schema.HasErrors(diagnostics) {
+		return nil, fmt.Errorf("%s", diagnostics[0])
+	}
+	return s, nil
+}
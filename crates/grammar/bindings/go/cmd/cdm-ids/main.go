@@ -0,0 +1,190 @@
+// Command cdm-ids maintains a project's entity ID ledger, .cdm/ids.json.
+// assign-ids gives every definition without an ID the next one the ledger
+// allows, rewriting the files in place and appending the allocations to the
+// ledger:
+//
+//	cdm-ids assign-ids -team payments api.cdm admin.cdm
+//
+// With -prune, the files given, with the files they extend, are taken to be
+// the whole project, and the IDs of definitions none of them defines are
+// recorded as deleted:
+//
+//	cdm-ids assign-ids -prune api.cdm admin.cdm
+//
+// verify checks that the files and the ledger agree, for CI:
+//
+//	cdm-ids verify api.cdm admin.cdm
+//
+// reserve sets aside a range of IDs for a team:
+//
+//	cdm-ids reserve -team payments 100-199
+//
+// restore takes back the deletion of an ID, such as field 3 of model #10:
+//
+//	cdm-ids restore -scope '#10' 3
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/larner-dev/cdm/bindings/go/ledger"
+	"github.com/larner-dev/cdm/bindings/go/schema"
+)
+
+const usage = `usage: cdm-ids assign-ids [-ledger path] [-team name] [-prune] context.cdm...
+       cdm-ids verify [-ledger path] context.cdm...
+       cdm-ids reserve [-ledger path] [-scope name] -team name from-to
+       cdm-ids restore [-ledger path] [-scope name] id
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	flags := flag.NewFlagSet("cdm-ids "+os.Args[1], flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), usage)
+		flags.PrintDefaults()
+	}
+	path := flags.String("ledger", ledger.DefaultPath, "ledger file")
+
+	var err error
+	switch os.Args[1] {
+	case "assign-ids":
+		team := flags.String("team", "", "allocate from the ranges reserved for this team")
+		prune := flags.Bool("prune", false, "record the IDs no file defines as deleted")
+		flags.Parse(os.Args[2:])
+		err = assign(*path, *team, *prune, flags.Args())
+	case "verify":
+		flags.Parse(os.Args[2:])
+		err = verify(*path, flags.Args())
+	case "reserve":
+		team := flags.String("team", "", "team to reserve the range for")
+		scope := flags.String("scope", ledger.GlobalScope, "ID scope of the range")
+		flags.Parse(os.Args[2:])
+		err = reserve(*path, *scope, *team, flags.Args())
+	case "restore":
+		scope := flags.String("scope", ledger.GlobalScope, "ID scope of the deleted ID")
+		flags.Parse(os.Args[2:])
+		err = restore(*path, *scope, flags.Args())
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func project(paths []string) (*ledger.Project, error) {
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no context files given")
+	}
+	return &ledger.Project{Paths: paths, ReadFile: os.ReadFile}, nil
+}
+
+func assign(path, team string, prune bool, paths []string) error {
+	p, err := project(paths)
+	if err != nil {
+		return err
+	}
+	p.Prune = prune
+	l, err := ledger.Load(path)
+	if err != nil {
+		return err
+	}
+	a, diagnostics := p.Assign(l, team)
+	report(diagnostics)
+	if a == nil {
+		return fmt.Errorf("no IDs assigned")
+	}
+	for name, text := range a.Files {
+		info, err := os.Stat(name)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(name, text, info.Mode()); err != nil {
+			return err
+		}
+		fmt.Printf("assigned IDs in %s\n", name)
+	}
+	if len(a.Entries) == 0 {
+		return nil
+	}
+	return ledger.Append(path, a.Entries)
+}
+
+func verify(path string, paths []string) error {
+	p, err := project(paths)
+	if err != nil {
+		return err
+	}
+	l, err := ledger.Load(path)
+	if err != nil {
+		return err
+	}
+	diagnostics := p.Verify(l)
+	report(diagnostics)
+	for _, d := range diagnostics {
+		if d.Severity == schema.Error {
+			return fmt.Errorf("the files and %s disagree", path)
+		}
+	}
+	return nil
+}
+
+func reserve(path, scope, team string, args []string) error {
+	if team == "" || len(args) != 1 {
+		return fmt.Errorf("reserve needs -team and one range, such as 100-199")
+	}
+	from, to, ok := strings.Cut(args[0], "-")
+	first, err1 := strconv.ParseUint(from, 10, 64)
+	last, err2 := strconv.ParseUint(to, 10, 64)
+	if !ok || err1 != nil || err2 != nil || first < 1 || last < first {
+		return fmt.Errorf("invalid range %q; expected from-to, such as 100-199", args[0])
+	}
+
+	l, err := ledger.Load(path)
+	if err != nil {
+		return err
+	}
+	e := &ledger.Entry{Op: ledger.Reserve, Scope: scope, Team: team, From: schema.EntityID(first), To: schema.EntityID(last)}
+	for _, r := range l.Scope(scope).Reserved {
+		if e.From <= r.To && r.From <= e.To {
+			return fmt.Errorf("range %d-%d overlaps %d-%d, reserved for team '%s'", e.From, e.To, r.From, r.To, r.Team)
+		}
+	}
+	return ledger.Append(path, []*ledger.Entry{e})
+}
+
+func restore(path, scope string, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("restore needs one ID, such as 3")
+	}
+	id, err := strconv.ParseUint(strings.TrimPrefix(args[0], "#"), 10, 64)
+	if err != nil || id < 1 {
+		return fmt.Errorf("invalid ID %q", args[0])
+	}
+
+	l, err := ledger.Load(path)
+	if err != nil {
+		return err
+	}
+	e, err := l.Restore(scope, schema.EntityID(id))
+	if err != nil {
+		return err
+	}
+	return ledger.Append(path, []*ledger.Entry{e})
+}
+
+func report(diagnostics []ledger.Diagnostic) {
+	for _, d := range diagnostics {
+		fmt.Fprintln(os.Stderr, d)
+	}
+}
@@ -0,0 +1,470 @@
+// Package contexts resolves context chains and compares sibling contexts.
+//
+// A context file extends one or more other files and adds, removes and
+// modifies their definitions (§7 of the specification). Resolve applies a
+// chain of contexts to build the schema a context stands for, and Compare
+// reports, model by model and field by field, what each of several
+// contexts does with the definitions of the base they extend.
+package contexts
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/larner-dev/cdm/bindings/go/classify"
+	"github.com/larner-dev/cdm/bindings/go/imports"
+	"github.com/larner-dev/cdm/bindings/go/position"
+	"github.com/larner-dev/cdm/bindings/go/schema"
+	"github.com/larner-dev/cdm/bindings/go/validation"
+	"github.com/larner-dev/cdm/bindings/go/value"
+)
+
+// Loader returns the parsed file at path. Paths of extended or imported local
+// files and templates are joined to the directory of the file that names
+// them; registry and git sources are passed as written, such as "cdm/auth".
+type Loader func(path string) (*schema.Schema, error)
+
+// Context is a context file together with the contexts it extends.
+type Context struct {
+	Path string
+	// The file as parsed
+	File *schema.Schema
+	// Contexts named by the file's extends directives, in order
+	Parents []*Context
+	// Source of the entity IDs the file assigns: the local source for the
+	// context being resolved and the files it extends, and the template's
+	// for an extended template
+	Source schema.EntityIDSource
+	// The resolved schema: the parents' definitions with the file's added,
+	// removed and modified ones applied. Models and type aliases the file
+	// modifies are new values; the rest are shared with the parents.
+	Schema *schema.Schema
+	// The definitions the file imports selectively, with those they depend
+	// on. They are not part of Schema, as namespaced imports are not.
+	Imports *imports.Scope
+}
+
+// Root returns the context at the top of the chain, found by following
+// first parents.
+func (c *Context) Root() *Context {
+	for len(c.Parents) > 0 {
+		c = c.Parents[0]
+	}
+	return c
+}
+
+// Resolve loads the context at path and the chain it extends, and resolves
+// each. It reports files that cannot be loaded (E304) and circular extends
+// chains (E301); the chain is resolved without the offending parent. It also
+// reports definitions that take an entity ID an inherited definition already
+// uses (E501, E502), and warns about references to inherited type aliases and
+// models that an extended file deprecates (W007). The definitions of an
+// extended template keep the template's ID scope, so only IDs of plain files
+// are shared. Selective imports of each file are resolved with
+// imports.Resolve and reported as it reports them. The @validation and
+// @classify configs of the resolved schema are checked with validation.Check
+// and classify.Check, once for the whole chain, and its classified fields
+// with classify.DefaultPolicy (E111).
+func Resolve(path string, load Loader) (*Context, []schema.Diagnostic) {
+	r := &resolver{load: load, loaded: map[string]*Context{}}
+	c := r.context(path, schema.EntityIDSource{}, position.Span{}, nil)
+	if c != nil {
+		r.diagnostics = append(r.diagnostics, validation.Check(c.Schema)...)
+		r.diagnostics = append(r.diagnostics, classify.Check(c.Schema)...)
+		r.diagnostics = append(r.diagnostics, classify.DefaultPolicy.Check(classify.Inventory(c.Schema, path))...)
+	}
+	return c, r.diagnostics
+}
+
+type resolver struct {
+	load        Loader
+	loaded      map[string]*Context
+	diagnostics []schema.Diagnostic
+}
+
+func (r *resolver) context(path string, source schema.EntityIDSource, span position.Span, chain []string) *Context {
+	for i, visited := range chain {
+		if visited == path {
+			r.diagnostics = append(r.diagnostics, schema.Errorf(schema.CodeCircularExtends, span,
+				"Circular extends chain: %s", strings.Join(append(chain[i:], path), " -> ")))
+			return nil
+		}
+	}
+	if c, ok := r.loaded[path]; ok {
+		return c
+	}
+	file, err := r.load(path)
+	if err != nil {
+		r.diagnostics = append(r.diagnostics, schema.Errorf(schema.CodeExtendsNotFound, span,
+			"Extends file not found: '%s': %v", path, err))
+		return nil
+	}
+
+	c := &Context{Path: path, File: file, Source: source}
+	chain = append(append([]string{}, chain...), path)
+	var spans []position.Span
+	for _, extends := range file.Extends {
+		source := schema.TemplateIDSource(extends.Source, extends.Config)
+		parentPath := sourcePath(path, extends.Source)
+		if source.Kind == schema.LocalTemplateSource {
+			source.Path = parentPath
+		}
+		if source.Kind == schema.LocalSource {
+			// A plain file shares the scope of the file extending it.
+			source = c.Source
+		}
+		parent := r.context(parentPath, source, extends.Span, chain)
+		if parent != nil {
+			c.Parents = append(c.Parents, parent)
+			spans = append(spans, extends.Span)
+		}
+	}
+	var parents []*schema.Schema
+	for _, parent := range c.Parents {
+		parents = append(parents, parent.Schema)
+	}
+	r.checkIDs(c, spans)
+	imported, diagnostics := imports.Resolve(file, func(source string) (*schema.Schema, error) {
+		return r.load(sourcePath(path, source))
+	})
+	c.Imports = imported
+	r.diagnostics = append(r.diagnostics, diagnostics...)
+	c.Schema = scope(apply(parents, file), c.Source)
+	if len(c.Parents) > 0 {
+		r.diagnostics = append(r.diagnostics, c.Schema.InheritedDeprecations(file)...)
+	}
+	r.loaded[path] = c
+	return c
+}
+
+// sourcePath returns the path load is given for source, as named by an
+// extends or import directive in the file at path.
+func sourcePath(path, source string) string {
+	switch schema.TemplateIDSource(source, nil).Kind {
+	case schema.LocalSource, schema.LocalTemplateSource:
+		return filepath.Join(filepath.Dir(path), source)
+	}
+	return source
+}
+
+// scope returns s with the local IDs of its definitions assigned to source,
+// copying the definitions it changes. IDs another template already scoped
+// are kept.
+func scope(s *schema.Schema, source schema.EntityIDSource) *schema.Schema {
+	if source.Kind == schema.LocalSource {
+		return s
+	}
+	out := *s
+	out.TypeAliases = nil
+	for _, alias := range s.TypeAliases {
+		if alias.IDSource.Kind == schema.LocalSource {
+			a := *alias
+			a.IDSource = source
+			alias = &a
+		}
+		out.TypeAliases = append(out.TypeAliases, alias)
+	}
+	scopeModel := func(model *schema.Model) *schema.Model {
+		m := *model
+		if m.IDSource.Kind == schema.LocalSource {
+			m.IDSource = source
+		}
+		m.Fields = nil
+		for _, field := range model.Fields {
+			if field.IDSource.Kind == schema.LocalSource {
+				f := *field
+				f.IDSource = source
+				field = &f
+			}
+			m.Fields = append(m.Fields, field)
+		}
+		return &m
+	}
+	out.Models, out.Generics = nil, nil
+	for _, model := range s.Models {
+		out.Models = append(out.Models, scopeModel(model))
+	}
+	for _, generic := range s.Generics {
+		out.Generics = append(out.Generics, scopeModel(generic))
+	}
+	return &out
+}
+
+// checkIDs reports definitions of c's file that take the scoped entity ID of
+// a different definition one of its parents has, and parents that bring in
+// different definitions with the same ID. Fields the file adds to an
+// inherited model are checked against the model's inherited fields. spans
+// holds the extends directive of each parent.
+func (r *resolver) checkIDs(c *Context, spans []position.Span) {
+	type owner struct{ name, path string }
+	owners := map[string]owner{}
+	define := func(name string, id schema.ScopedID, span position.Span, path string) {
+		if id.IsZero() {
+			return
+		}
+		existing, ok := owners[id.String()]
+		switch {
+		case !ok:
+			owners[id.String()] = owner{name, path}
+		case existing.name != name:
+			r.diagnostics = append(r.diagnostics, schema.Errorf(schema.CodeDuplicateEntityID, span,
+				"Duplicate entity ID %s: also used by '%s' in '%s'", id, existing.name, existing.path))
+		}
+	}
+	for i, parent := range c.Parents {
+		for _, alias := range parent.Schema.TypeAliases {
+			define(alias.Name, alias.ScopedID(), spans[i], parent.Path)
+		}
+		for _, model := range definitions(parent.Schema) {
+			define(model.Name, model.ScopedID(), spans[i], parent.Path)
+		}
+	}
+	for _, alias := range c.File.TypeAliases {
+		define(alias.Name, scopedIn(alias.ScopedID(), c.Source), alias.Span, c.Path)
+	}
+	for _, model := range definitions(c.File) {
+		define(model.Name, scopedIn(model.ScopedID(), c.Source), model.Span, c.Path)
+	}
+
+	for _, block := range c.File.Models {
+		for _, parent := range c.Parents {
+			inherited := parent.Schema.Model(block.Name)
+			if inherited == nil {
+				continue
+			}
+			for _, field := range block.Fields {
+				if field.ID == 0 {
+					continue
+				}
+				id := scopedIn(field.ScopedID(nil), c.Source)
+				for _, other := range parent.Schema.Fields(inherited) {
+					if other.Name != field.Name && other.ScopedID(nil).String() == id.String() {
+						r.diagnostics = append(r.diagnostics, schema.Errorf(schema.CodeDuplicateFieldID, field.Span,
+							"Duplicate field ID %s in model '%s': also used by '%s' in '%s'", id, block.Name, other.Name, parent.Path))
+					}
+				}
+			}
+		}
+	}
+}
+
+// definitions returns the models and generic models a schema defines,
+// leaving out those Check generated.
+func definitions(s *schema.Schema) []*schema.Model {
+	var models []*schema.Model
+	for _, model := range append(append([]*schema.Model{}, s.Models...), s.Generics...) {
+		if model.Instance == nil && model.InlineOf == nil {
+			models = append(models, model)
+		}
+	}
+	return models
+}
+
+// scopedIn returns id with a local source replaced by source, the scope of
+// the file that assigned it.
+func scopedIn(id schema.ScopedID, source schema.EntityIDSource) schema.ScopedID {
+	if id.Source.Kind == schema.LocalSource {
+		id.Source = source
+	}
+	return id
+}
+
+// apply builds the schema of a context file from its parents' schemas
+// (§7.6): plugin imports merge by name; type aliases, models and generic
+// models the file defines replace or modify the parents' ones of the same
+// name; and the file's removals drop them.
+func apply(parents []*schema.Schema, file *schema.Schema) *schema.Schema {
+	if len(parents) == 0 {
+		return file
+	}
+	out := &schema.Schema{Extends: file.Extends, Imports: file.Imports, Functions: file.Functions}
+	removed := map[string]bool{}
+	for _, removal := range file.Removals {
+		removed[removal.Name] = true
+	}
+
+	for _, parent := range append(parents, file) {
+		for _, plugin := range parent.Plugins {
+			out.Plugins = mergePlugin(out.Plugins, plugin)
+		}
+	}
+
+	for _, parent := range parents {
+		for _, alias := range parent.TypeAliases {
+			out.TypeAliases = replace(out.TypeAliases, alias, func(a *schema.TypeAlias) string { return a.Name })
+		}
+		for _, model := range parent.Models {
+			// Models Check generated belong to the schema they were
+			// generated for.
+			if model.Instance == nil && model.InlineOf == nil {
+				out.Models = replace(out.Models, model, func(m *schema.Model) string { return m.Name })
+			}
+		}
+		for _, generic := range parent.Generics {
+			out.Generics = replace(out.Generics, generic, func(m *schema.Model) string { return m.Name })
+		}
+	}
+	for _, alias := range file.TypeAliases {
+		out.TypeAliases = replace(out.TypeAliases, alias, func(a *schema.TypeAlias) string { return a.Name })
+	}
+	for _, model := range file.Models {
+		if inherited := lookup(out.Models, model.Name); inherited != nil {
+			model = modify(inherited, model)
+		}
+		out.Models = replace(out.Models, model, func(m *schema.Model) string { return m.Name })
+	}
+	for _, generic := range file.Generics {
+		out.Generics = replace(out.Generics, generic, func(m *schema.Model) string { return m.Name })
+	}
+
+	out.TypeAliases = drop(out.TypeAliases, removed, func(a *schema.TypeAlias) string { return a.Name })
+	out.Models = drop(out.Models, removed, func(m *schema.Model) string { return m.Name })
+	out.Generics = drop(out.Generics, removed, func(m *schema.Model) string { return m.Name })
+	return out
+}
+
+// modify applies a context's block for an inherited model (§7.3): its
+// removals drop fields, its fields replace or add to the inherited ones,
+// its overrides and configs merge into the inherited configs, and its
+// parents are added.
+func modify(inherited, block *schema.Model) *schema.Model {
+	m := *inherited
+	m.Fields, m.Removals, m.Overrides = nil, append([]*schema.Removal{}, inherited.Removals...), append([]*schema.FieldOverride{}, inherited.Overrides...)
+	removed := map[string]bool{}
+	for _, removal := range block.Removals {
+		removed[removal.Name] = true
+		if inherited.Field(removal.Name) == nil {
+			// The field comes from a parent model, so the removal stays.
+			m.Removals = append(m.Removals, removal)
+		}
+	}
+	for _, field := range inherited.Fields {
+		switch redefined := block.Field(field.Name); {
+		case removed[field.Name]:
+		case redefined != nil:
+			f := *redefined
+			f.Configs = mergeConfigs(field.Configs, redefined.Configs)
+			if f.ID == 0 {
+				f.ID, f.IDSource = field.ID, field.IDSource
+			}
+			m.Fields = append(m.Fields, &f)
+		default:
+			if override := overrideOf(block, field.Name); override != nil {
+				f := *field
+				f.Configs = mergeConfigs(field.Configs, override.Configs)
+				field = &f
+			}
+			m.Fields = append(m.Fields, field)
+		}
+	}
+	for _, field := range block.Fields {
+		if inherited.Field(field.Name) == nil {
+			m.Fields = append(m.Fields, field)
+		}
+	}
+	for _, override := range block.Overrides {
+		if inherited.Field(override.Name) == nil {
+			m.Overrides = append(m.Overrides, override)
+		}
+	}
+	m.Parents = append([]*schema.Reference{}, inherited.Parents...)
+	for _, parent := range block.Parents {
+		if !hasParent(m.Parents, parent.Name) {
+			m.Parents = append(m.Parents, parent)
+		}
+	}
+	m.Configs = mergeConfigs(inherited.Configs, block.Configs)
+	if block.ID != 0 {
+		m.ID, m.IDSource = block.ID, block.IDSource
+	}
+	return &m
+}
+
+func overrideOf(model *schema.Model, name string) *schema.FieldOverride {
+	for _, override := range model.Overrides {
+		if override.Name == name {
+			return override
+		}
+	}
+	return nil
+}
+
+func hasParent(parents []*schema.Reference, name string) bool {
+	for _, parent := range parents {
+		if parent.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeConfigs deep merges the configs of override into those of base by
+// plugin name (§7.4).
+func mergeConfigs(base, override []*schema.Config) []*schema.Config {
+	out := append([]*schema.Config{}, base...)
+	for _, config := range override {
+		merged := false
+		for i, existing := range out {
+			if existing.Name == config.Name {
+				out[i] = &schema.Config{Name: config.Name, Value: value.Merge(existing.Value, config.Value), Span: config.Span}
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			out = append(out, config)
+		}
+	}
+	return out
+}
+
+func mergePlugin(plugins []*schema.Plugin, plugin *schema.Plugin) []*schema.Plugin {
+	for i, existing := range plugins {
+		if existing.Name != plugin.Name {
+			continue
+		}
+		merged := *plugin
+		if existing.Config != nil && plugin.Config != nil {
+			config := value.Merge(*existing.Config, *plugin.Config)
+			merged.Config = &config
+		} else if plugin.Config == nil {
+			merged.Config = existing.Config
+		}
+		out := append([]*schema.Plugin{}, plugins...)
+		out[i] = &merged
+		return out
+	}
+	return append(plugins, plugin)
+}
+
+func lookup(models []*schema.Model, name string) *schema.Model {
+	for _, model := range models {
+		if model.Name == name {
+			return model
+		}
+	}
+	return nil
+}
+
+// replace puts definition in place of the one with the same name in list,
+// or appends it.
+func replace[T any](list []T, definition T, name func(T) string) []T {
+	for i, existing := range list {
+		if name(existing) == name(definition) {
+			out := append([]T{}, list...)
+			out[i] = definition
+			return out
+		}
+	}
+	return append(list, definition)
+}
+
+func drop[T any](list []T, removed map[string]bool, name func(T) string) []T {
+	var out []T
+	for _, definition := range list {
+		if !removed[name(definition)] {
+			out = append(out, definition)
+		}
+	}
+	return out
+}
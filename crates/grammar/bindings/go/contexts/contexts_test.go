@@ -0,0 +1,405 @@
+package contexts_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/larner-dev/cdm/bindings/go/contexts"
+	"github.com/larner-dev/cdm/bindings/go/schema"
+)
+
+// loader serves files from memory, parsing them on each load.
+func loader(t *testing.T, files map[string]string) contexts.Loader {
+	return func(path string) (*schema.Schema, error) {
+		source, ok := files[path]
+		if !ok {
+			return nil, os.ErrNotExist
+		}
+		s, diagnostics := schema.Parse([]byte(source))
+		if len(diagnostics) > 0 {
+			t.Fatalf("Parse(%s) returned diagnostics: %v", path, diagnostics)
+		}
+		return s, nil
+	}
+}
+
+func mustResolve(t *testing.T, path string, load contexts.Loader) *contexts.Context {
+	t.Helper()
+
+	c, diagnostics := contexts.Resolve(path, load)
+	if len(diagnostics) > 0 {
+		t.Fatalf("Resolve(%s) returned diagnostics: %v", path, diagnostics)
+	}
+	return c
+}
+
+var files = map[string]string{
+	"schema/base.cdm": `@sql { dialect: "postgres", schema: "public" }
+
+User {
+  id: string #1
+  email: string #2
+  password_hash: string #3
+  status: string = "active" #4
+  @sql { table: "users" }
+} #10
+
+Post {
+  id: string #1
+  title: string #2
+  author: User #3
+} #11
+
+AuditLog {
+  id: string #1
+  entry: string #2
+} #12
+`,
+	"schema/api.cdm": `extends "./base.cdm"
+
+@sql { schema: "api" }
+
+-AuditLog
+
+User {
+  -password_hash
+  email { @sql { type: "citext" } }
+}
+
+ApiKey {
+  key: string #1
+} #13
+`,
+	"schema/admin.cdm": `extends "./base.cdm"
+
+User {
+  status: "active" | "banned" #4
+  @sql { table: "admin_users" }
+}
+`,
+	"schema/warehouse.cdm": `extends "./api.cdm"
+
+Post {
+  -author
+  word_count: number #4
+}
+`,
+}
+
+func TestResolve(t *testing.T) {
+	c := mustResolve(t, "schema/warehouse.cdm", loader(t, files))
+
+	if len(c.Parents) != 1 || c.Parents[0].Path != "schema/api.cdm" || c.Root().Path != "schema/base.cdm" {
+		t.Fatalf("chain = %s -> %v, root %s", c.Path, c.Parents, c.Root().Path)
+	}
+	s := c.Schema
+	if s.Model("AuditLog") != nil || s.Model("ApiKey") == nil {
+		t.Errorf("models = %v", names(s))
+	}
+	if got := s.Plugins[0].Config.String(); !strings.Contains(got, `dialect: "postgres"`) || !strings.Contains(got, `schema: "api"`) {
+		t.Errorf("@sql import = %s, want the base and api configs merged", got)
+	}
+
+	user := s.Model("User")
+	if user.ID != 10 || user.Field("password_hash") != nil || user.Config("sql").Value.String() != `{ table: "users" }` {
+		t.Errorf("User = %+v", user)
+	}
+	if email := user.Field("email"); email.ID != 2 || email.Config("sql").Value.String() != `{ type: "citext" }` {
+		t.Errorf("email = %+v", email)
+	}
+	post := s.Model("Post")
+	if post.Field("author") != nil || post.Field("word_count") == nil || post.Field("title").ID != 2 {
+		t.Errorf("Post fields = %v", post.Fields)
+	}
+
+	// The base is left as it is.
+	if base := c.Root().Schema; base.Model("User").Field("password_hash") == nil || base.Model("AuditLog") == nil {
+		t.Error("resolving a context should not change the files it extends")
+	}
+}
+
+func TestResolveRedefinedField(t *testing.T) {
+	s := mustResolve(t, "schema/admin.cdm", loader(t, files)).Schema
+
+	user := s.Model("User")
+	status := user.Field("status")
+	if status.Type.String() != `"active" | "banned"` || status.Default != nil {
+		t.Errorf("status = %+v", status)
+	}
+	if user.Config("sql").Value.String() != `{ table: "admin_users" }` {
+		t.Errorf("@sql = %s", user.Config("sql").Value)
+	}
+}
+
+func TestResolveErrors(t *testing.T) {
+	load := loader(t, map[string]string{
+		"a.cdm":      "extends \"./b.cdm\"\n",
+		"b.cdm":      "extends \"./a.cdm\"\n",
+		"broken.cdm": "extends \"./missing.cdm\"\n",
+	})
+
+	_, diagnostics := contexts.Resolve("a.cdm", load)
+	if len(diagnostics) != 1 || diagnostics[0].Code != schema.CodeCircularExtends ||
+		!strings.Contains(diagnostics[0].Message, "a.cdm -> b.cdm -> a.cdm") {
+		t.Errorf("diagnostics = %v, want an E301 naming the cycle", diagnostics)
+	}
+
+	c, diagnostics := contexts.Resolve("broken.cdm", load)
+	if len(diagnostics) != 1 || diagnostics[0].Code != schema.CodeExtendsNotFound || len(c.Parents) != 0 {
+		t.Errorf("diagnostics = %v, want an E304", diagnostics)
+	}
+}
+
+func TestResolveSelectiveImports(t *testing.T) {
+	load := loader(t, map[string]string{
+		"app/base.cdm":  "import { User as Account } from \"../auth/auth.cdm\"\n\nSession {\n  account: Account\n}\n",
+		"app/api.cdm":   "extends \"./base.cdm\"\n\nimport { Session, Role } from \"./base.cdm\"\n",
+		"auth/auth.cdm": "Email: string\n\nUser {\n  email: Email\n}\n",
+	})
+
+	c, diagnostics := contexts.Resolve("app/api.cdm", load)
+	var got []string
+	for _, d := range diagnostics {
+		got = append(got, d.Code+": "+d.Message)
+	}
+	want := []string{
+		"E609: 'Role' is not exported by template './base.cdm'; it exports 'Session'",
+		"W103: 'Session' is imported from './base.cdm' but never used",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("diagnostics =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	base := c.Parents[0]
+	account := base.Imports.Lookup("Account")
+	if account == nil || account.Definition.Name != "User" || account.Definition.Source != "../auth/auth.cdm" || !account.Used {
+		t.Fatalf("Account = %+v, want the used User of ../auth/auth.cdm", account)
+	}
+	if deps := base.Imports.Dependencies; len(deps) != 1 || deps[0].Name != "Email" {
+		t.Errorf("dependencies = %+v, want Email", deps)
+	}
+	if c.Schema.Model("User") != nil {
+		t.Error("the resolved schema includes the imported User")
+	}
+}
+
+func TestResolveConstraints(t *testing.T) {
+	load := loader(t, map[string]string{
+		"base.cdm": "Score: number { @validation { min: 0 } }\n",
+		"api.cdm":  "extends \"./base.cdm\"\n\nPost {\n  score: Score { @validation { max: 10, max_length: 2 } }\n}\n",
+	})
+
+	// The field's type is defined by the extended file, so the constraints
+	// are only checkable against the resolved schema.
+	_, diagnostics := contexts.Resolve("api.cdm", load)
+	if len(diagnostics) != 1 || diagnostics[0].Code != schema.CodeInvalidConstraint ||
+		diagnostics[0].Message != "max_length cannot apply to type 'Score'; it constrains strings and arrays" {
+		t.Errorf("diagnostics = %v, want an E110 for max_length", diagnostics)
+	}
+}
+
+func TestResolveClassifications(t *testing.T) {
+	load := loader(t, map[string]string{
+		"base.cdm": "Email: string { @classify { level: \"pii\", category: \"email\" } }\n",
+		"api.cdm":  "extends \"./base.cdm\"\n\nUser {\n  email: Email\n  notes: string { @classify { level: \"secret\" } }\n}\n",
+	})
+
+	// User.email is personal data through the alias Email, which is defined
+	// by the extended file and sets no retention.
+	_, diagnostics := contexts.Resolve("api.cdm", load)
+	var got []string
+	for _, d := range diagnostics {
+		got = append(got, fmt.Sprintf("%s %d", d.Code, d.Span.Start.Row))
+	}
+	if want := "E402 4, E111 3"; strings.Join(got, ", ") != want {
+		t.Errorf("diagnostics = %v, want %s", diagnostics, want)
+	}
+}
+
+func TestResolveInheritedDeprecations(t *testing.T) {
+	load := loader(t, map[string]string{
+		"base.cdm": "Email: string { @deprecated { since: \"2.3\" } }\n\nLegacy {\n  x: string\n  @deprecated { replacement: \"User\" }\n}\n\nUser {\n  email: Email\n}\n",
+		"api.cdm": "extends \"./base.cdm\"\n\nContact: Email\n\nAccount extends Legacy {\n  backup: Email { @deprecated {} }\n}\n\n" +
+			"Archive {\n  old: Legacy\n  @deprecated {}\n}\n\nUser {\n  @deprecated {}\n}\n\nPost {\n  author: User\n}\n",
+	})
+
+	// Uses of Email and Legacy are warned about; the reference to User is
+	// left to Check, since api.cdm deprecates User itself, and references
+	// from deprecated definitions are not reported.
+	_, diagnostics := contexts.Resolve("api.cdm", load)
+	var got []string
+	for _, d := range diagnostics {
+		got = append(got, fmt.Sprintf("%s %d: %s", d.Code, d.Span.Start.Row, d.Message))
+	}
+	want := []string{
+		"W007 2: 'Email' is deprecated since 2.3",
+		"W007 4: 'Legacy' is deprecated (use 'User' instead)",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("diagnostics =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestCompare(t *testing.T) {
+	load := loader(t, files)
+	var resolved []*contexts.Context
+	for _, path := range []string{"schema/api.cdm", "schema/admin.cdm", "schema/warehouse.cdm"} {
+		resolved = append(resolved, mustResolve(t, path, load))
+	}
+	report := contexts.Compare(resolved...)
+
+	want := map[string]string{
+		"User":               "included,reconfigured,included",
+		"User.email":         "reconfigured,included,reconfigured",
+		"User.password_hash": "removed,included,removed",
+		"User.status":        "included,overridden,included",
+		"Post":               "included,included,included",
+		"Post.author":        "included,included,removed",
+		"Post.word_count":    "-,-,added",
+		"AuditLog":           "removed,included,removed",
+		"ApiKey":             "added,-,added",
+		"ApiKey.key":         "added,-,added",
+	}
+	var got []string
+	for _, row := range report.Rows {
+		name := row.Model
+		if row.Field != "" {
+			name += "." + row.Field
+		}
+		var statuses []string
+		for _, status := range row.Statuses {
+			statuses = append(statuses, status.String())
+		}
+		if w, ok := want[name]; ok && strings.Join(statuses, ",") != w {
+			t.Errorf("%s = %s, want %s", name, strings.Join(statuses, ","), w)
+		}
+		got = append(got, name)
+	}
+	if order := strings.Join(got, " "); !strings.HasPrefix(order, "User User.id User.email User.password_hash User.status Post ") ||
+		!strings.HasSuffix(order, "ApiKey ApiKey.key") {
+		t.Errorf("rows = %s", order)
+	}
+}
+
+func TestReportFormats(t *testing.T) {
+	report := contexts.Report{
+		Contexts: []string{"api.cdm", "admin.cdm"},
+		Rows: []contexts.Row{
+			{Model: "User", Statuses: []contexts.Status{contexts.Included, contexts.Reconfigured}},
+			{Model: "User", Field: "a|b", Statuses: []contexts.Status{contexts.Removed, contexts.Absent}},
+		},
+	}
+
+	var table strings.Builder
+	if err := report.WriteTable(&table); err != nil {
+		t.Fatal(err)
+	}
+	if want := "Model  Field  api.cdm   admin.cdm\nUser          included  reconfigured\nUser   a|b    removed   -\n"; table.String() != want {
+		t.Errorf("table =\n%s\nwant\n%s", table.String(), want)
+	}
+
+	var markdown strings.Builder
+	if err := report.WriteMarkdown(&markdown); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(markdown.String(), "| User | a\\|b | removed | - |\n") {
+		t.Errorf("markdown =\n%s", markdown.String())
+	}
+
+	var html strings.Builder
+	if err := report.WriteHTML(&html); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(html.String(), `<td class="reconfigured">reconfigured</td>`) ||
+		!strings.Contains(html.String(), `<td class="absent">-</td>`) {
+		t.Errorf("html =\n%s", html.String())
+	}
+}
+
+func names(s *schema.Schema) string {
+	var out []string
+	for _, model := range s.Models {
+		out = append(out, model.Name)
+	}
+	return fmt.Sprint(out)
+}
+
+func TestResolveTemplateIDs(t *testing.T) {
+	load := loader(t, map[string]string{
+		"cdm/auth": `User {
+  id: string #1
+  email: string #2
+} #10
+`,
+		"app/shared.cdm": `Team {
+  name: string #1
+} #20
+`,
+		"app/main.cdm": `extends "cdm/auth"
+extends "./shared.cdm"
+
+User {
+  nickname: string #2
+}
+
+Account {
+  owner: User #1
+} #10
+`,
+	})
+
+	c := mustResolve(t, "app/main.cdm", load)
+	if c.Parents[0].Path != "cdm/auth" || c.Parents[0].Source.String() != "cdm/auth" || c.Parents[1].Source.Kind != schema.LocalSource {
+		t.Fatalf("parents = %+v, %+v", c.Parents[0], c.Parents[1])
+	}
+	user := c.Schema.Model("User")
+	if got := user.ScopedID().String(); got != "cdm/auth:#10" {
+		t.Errorf("User = %s, want the template's scope", got)
+	}
+	var fields []string
+	for _, field := range user.Fields {
+		fields = append(fields, field.ScopedID(user).String())
+	}
+	if got, want := strings.Join(fields, " "), "cdm/auth:#10.#1 cdm/auth:#10.#2 cdm/auth:#10.local:#2"; got != want {
+		t.Errorf("User fields = %s, want %s", got, want)
+	}
+	if got := c.Schema.Model("Account").ScopedID().String(); got != "#10" {
+		t.Errorf("Account = %s", got)
+	}
+	if diagnostics := c.Schema.Check(); len(diagnostics) > 0 {
+		t.Errorf("Check returned diagnostics: %v", diagnostics)
+	}
+}
+
+func TestResolveIDCollisions(t *testing.T) {
+	load := loader(t, map[string]string{
+		"base.cdm": `User {
+  id: string #1
+  email: string #2
+} #10
+`,
+		"api.cdm": `extends "./base.cdm"
+
+User {
+  handle: string #2
+}
+
+Account {} #10
+`,
+	})
+
+	_, diagnostics := contexts.Resolve("api.cdm", load)
+	var got []string
+	for _, d := range diagnostics {
+		got = append(got, d.Code+": "+d.Message)
+	}
+	want := []string{
+		"E501: Duplicate entity ID #10: also used by 'User' in 'base.cdm'",
+		"E502: Duplicate field ID #2 in model 'User': also used by 'email' in 'base.cdm'",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("diagnostics =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
@@ -0,0 +1,276 @@
+package contexts
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/larner-dev/cdm/bindings/go/schema"
+	"github.com/larner-dev/cdm/bindings/go/value"
+)
+
+// Status is what a context does with a model or field of its base.
+type Status string
+
+const (
+	// Absent: neither the base nor the context has it.
+	Absent Status = ""
+	// Included: the context keeps it as the base defines it.
+	Included Status = "included"
+	// Added: the context has it and the base does not.
+	Added Status = "added"
+	// Removed: the base has it and the context removes it.
+	Removed Status = "removed"
+	// Overridden: the context changes a field's type, optionality or
+	// default, or a model's parents.
+	Overridden Status = "overridden"
+	// Reconfigured: the context changes only plugin configs.
+	Reconfigured Status = "reconfigured"
+)
+
+func (s Status) String() string {
+	if s == Absent {
+		return "-"
+	}
+	return string(s)
+}
+
+// Report is a matrix of the models and fields of several contexts against
+// what each context does with them.
+type Report struct {
+	// Paths of the compared contexts, one per status column
+	Contexts []string
+	Rows     []Row
+}
+
+// Row is the status of one model, or one field of a model, in each context.
+type Row struct {
+	Model string
+	// Empty for the row of the model itself
+	Field    string
+	Statuses []Status
+}
+
+// Compare reports, for every model and field of the given contexts, what
+// each context does with it relative to the root of its chain, which is
+// usually the base schema the contexts share. Fields are compared after
+// inheritance. Rows follow the order in which models and fields first
+// appear, base definitions first.
+func Compare(contexts ...*Context) Report {
+	r := Report{}
+	type key struct{ model, field string }
+	index := map[key]int{}
+	row := func(model, field string) int {
+		k := key{model, field}
+		if i, ok := index[k]; ok {
+			return i
+		}
+		index[k] = len(r.Rows)
+		r.Rows = append(r.Rows, Row{Model: model, Field: field, Statuses: make([]Status, len(contexts))})
+		return index[k]
+	}
+	// Rows are added for every schema before statuses are filled in, so a
+	// model's fields stay together under it.
+	var schemas []*schema.Schema
+	for _, c := range contexts {
+		r.Contexts = append(r.Contexts, c.Path)
+		schemas = append(schemas, c.Root().Schema, c.Schema)
+	}
+	for _, model := range definedModels(schemas) {
+		row(model, "")
+		for _, s := range schemas {
+			if m := s.Model(model); m != nil && m.Instance == nil {
+				for _, field := range s.Fields(m) {
+					row(model, field.Name)
+				}
+			}
+		}
+	}
+
+	for i, c := range contexts {
+		base := c.Root().Schema
+		for _, rw := range r.Rows {
+			rw.Statuses[i] = status(base, c.Schema, rw.Model, rw.Field)
+		}
+	}
+	return r
+}
+
+// definedModels returns the names of the models the schemas define, in the
+// order they first appear, leaving out models Check generated.
+func definedModels(schemas []*schema.Schema) []string {
+	var names []string
+	seen := map[string]bool{}
+	for _, s := range schemas {
+		for _, model := range s.Models {
+			if model.Instance == nil && model.InlineOf == nil && !seen[model.Name] {
+				seen[model.Name] = true
+				names = append(names, model.Name)
+			}
+		}
+	}
+	return names
+}
+
+func status(base, context *schema.Schema, modelName, fieldName string) Status {
+	before, after := base.Model(modelName), context.Model(modelName)
+	if fieldName == "" {
+		switch {
+		case before == nil && after == nil:
+			return Absent
+		case before == nil:
+			return Added
+		case after == nil:
+			return Removed
+		case before == after:
+			return Included
+		case !sameParents(before.Parents, after.Parents):
+			return Overridden
+		case !sameConfigs(before.Configs, after.Configs):
+			return Reconfigured
+		}
+		return Included
+	}
+
+	var b, a *schema.Field
+	if before != nil {
+		b = fieldOf(base.Fields(before), fieldName)
+	}
+	if after != nil {
+		a = fieldOf(context.Fields(after), fieldName)
+	}
+	switch {
+	case b == nil && a == nil:
+		return Absent
+	case b == nil:
+		return Added
+	case a == nil:
+		return Removed
+	case b == a:
+		return Included
+	case b.FieldType().String() != a.FieldType().String() || b.Optional != a.Optional || !sameDefault(b.Default, a.Default):
+		return Overridden
+	case !sameConfigs(b.Configs, a.Configs):
+		return Reconfigured
+	}
+	return Included
+}
+
+func fieldOf(fields []*schema.Field, name string) *schema.Field {
+	for _, field := range fields {
+		if field.Name == name {
+			return field
+		}
+	}
+	return nil
+}
+
+func sameParents(before, after []*schema.Reference) bool {
+	if len(before) != len(after) {
+		return false
+	}
+	for i := range before {
+		if before[i].Name != after[i].Name {
+			return false
+		}
+	}
+	return true
+}
+
+func sameConfigs(before, after []*schema.Config) bool {
+	if len(before) != len(after) {
+		return false
+	}
+	for _, config := range before {
+		other := findConfig(after, config.Name)
+		if other == nil || !value.Equal(config.Value, other.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+func findConfig(configs []*schema.Config, name string) *schema.Config {
+	for _, config := range configs {
+		if config.Name == name {
+			return config
+		}
+	}
+	return nil
+}
+
+func sameDefault(before, after *value.Value) bool {
+	if before == nil || after == nil {
+		return before == after
+	}
+	return value.Equal(*before, *after)
+}
+
+// header returns the column headings: Model, Field and one per context.
+func (r Report) header() []string {
+	return append([]string{"Model", "Field"}, r.Contexts...)
+}
+
+func (row Row) cells() []string {
+	cells := []string{row.Model, row.Field}
+	for _, status := range row.Statuses {
+		cells = append(cells, status.String())
+	}
+	return cells
+}
+
+// WriteTable writes the report as a table with aligned columns for a
+// terminal.
+func (r Report) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(r.header(), "\t"))
+	for _, row := range r.Rows {
+		fmt.Fprintln(tw, strings.Join(row.cells(), "\t"))
+	}
+	return tw.Flush()
+}
+
+// WriteMarkdown writes the report as a Markdown table.
+func (r Report) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	header := r.header()
+	b.WriteString("| " + strings.Join(header, " | ") + " |\n")
+	b.WriteString("|" + strings.Repeat(" --- |", len(header)) + "\n")
+	for _, row := range r.Rows {
+		cells := row.cells()
+		for i, cell := range cells {
+			cells[i] = strings.ReplaceAll(cell, "|", `\|`)
+		}
+		b.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteHTML writes the report as an HTML table. Each status cell has the
+// status as its class, such as class="removed", for styling; absent cells
+// have class="absent".
+func (r Report) WriteHTML(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("<table>\n  <thead>\n    <tr>")
+	for _, heading := range r.header() {
+		b.WriteString("<th>" + html.EscapeString(heading) + "</th>")
+	}
+	b.WriteString("</tr>\n  </thead>\n  <tbody>\n")
+	for _, row := range r.Rows {
+		b.WriteString("    <tr><td>" + html.EscapeString(row.Model) + "</td><td>" + html.EscapeString(row.Field) + "</td>")
+		for _, status := range row.Statuses {
+			class := string(status)
+			if status == Absent {
+				class = "absent"
+			}
+			b.WriteString(`<td class="` + class + `">` + html.EscapeString(status.String()) + "</td>")
+		}
+		b.WriteString("</tr>\n")
+	}
+	b.WriteString("  </tbody>\n</table>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
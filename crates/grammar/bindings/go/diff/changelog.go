@@ -0,0 +1,61 @@
+package diff
+
+import (
+	"strings"
+
+	"github.com/larner-dev/cdm/bindings/go/schema"
+)
+
+// changelogSections lists the changelog headings in the order they are
+// written.
+var changelogSections = []string{"Added", "Changed", "Deprecated", "Removed"}
+
+// Changelog renders deltas as a Markdown changelog with Added, Changed,
+// Deprecated and Removed sections. Empty sections are left out, and deltas
+// keep their order within a section.
+func Changelog(deltas []Delta) string {
+	sections := map[string][]string{}
+	for _, d := range deltas {
+		section := sectionOf(d)
+		sections[section] = append(sections[section], d.String())
+	}
+
+	var b strings.Builder
+	for _, section := range changelogSections {
+		lines := sections[section]
+		if len(lines) == 0 {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString("### " + section + "\n\n")
+		for _, line := range lines {
+			b.WriteString("- " + line + "\n")
+		}
+	}
+	return b.String()
+}
+
+func sectionOf(d Delta) string {
+	switch d := d.(type) {
+	case ModelAdded, FieldAdded, TypeAliasAdded, IndexAdded:
+		return "Added"
+	case ModelRemoved, FieldRemoved, TypeAliasRemoved, IndexRemoved:
+		return "Removed"
+	case FieldDeprecationChanged:
+		return deprecationSection(d.After)
+	case ModelDeprecationChanged:
+		return deprecationSection(d.After)
+	case TypeAliasDeprecationChanged:
+		return deprecationSection(d.After)
+	}
+	return "Changed"
+}
+
+func deprecationSection(after *schema.Deprecation) string {
+	if after == nil {
+		return "Changed"
+	}
+	return "Deprecated"
+}
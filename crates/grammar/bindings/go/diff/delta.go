@@ -0,0 +1,374 @@
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/larner-dev/cdm/bindings/go/schema"
+	"github.com/larner-dev/cdm/bindings/go/value"
+)
+
+// Delta is a single change between two versions of a schema. The concrete
+// types mirror the Delta enum plugins receive (§12.5 of the specification).
+type Delta interface {
+	// Type is the snake_case name of the delta in JSON, such as
+	// "field_added".
+	Type() string
+	// String describes the change in one sentence, as in a changelog.
+	String() string
+}
+
+type ModelAdded struct {
+	Name  string        `json:"name"`
+	After *schema.Model `json:"after"`
+}
+
+type ModelRemoved struct {
+	Name   string        `json:"name"`
+	Before *schema.Model `json:"before"`
+}
+
+type ModelRenamed struct {
+	OldName string          `json:"old_name"`
+	NewName string          `json:"new_name"`
+	ID      schema.EntityID `json:"id,omitempty"`
+	Before  *schema.Model   `json:"before"`
+	After   *schema.Model   `json:"after"`
+}
+
+type FieldAdded struct {
+	Model string        `json:"model"`
+	Field string        `json:"field"`
+	After *schema.Field `json:"after"`
+}
+
+type FieldRemoved struct {
+	Model  string        `json:"model"`
+	Field  string        `json:"field"`
+	Before *schema.Field `json:"before"`
+}
+
+type FieldRenamed struct {
+	Model   string          `json:"model"`
+	OldName string          `json:"old_name"`
+	NewName string          `json:"new_name"`
+	ID      schema.EntityID `json:"id,omitempty"`
+	Before  *schema.Field   `json:"before"`
+	After   *schema.Field   `json:"after"`
+}
+
+type FieldTypeChanged struct {
+	Model  string           `json:"model"`
+	Field  string           `json:"field"`
+	Before *schema.TypeExpr `json:"before"`
+	After  *schema.TypeExpr `json:"after"`
+}
+
+type FieldOptionalityChanged struct {
+	Model  string `json:"model"`
+	Field  string `json:"field"`
+	Before bool   `json:"before"`
+	After  bool   `json:"after"`
+}
+
+// FieldNullabilityChanged records a field starting or ceasing to admit
+// null, such as `string` becoming `string | null`. The rest of the type is
+// compared separately, so this is the only delta for such a change.
+type FieldNullabilityChanged struct {
+	Model  string `json:"model"`
+	Field  string `json:"field"`
+	Before bool   `json:"before"`
+	After  bool   `json:"after"`
+}
+
+type FieldDefaultChanged struct {
+	Model  string       `json:"model"`
+	Field  string       `json:"field"`
+	Before *value.Value `json:"before"`
+	After  *value.Value `json:"after"`
+}
+
+// FieldDeprecationChanged records a field becoming deprecated (Before is
+// nil), no longer deprecated (After is nil), or a change to its reason,
+// version or replacement.
+type FieldDeprecationChanged struct {
+	Model  string              `json:"model"`
+	Field  string              `json:"field"`
+	Before *schema.Deprecation `json:"before"`
+	After  *schema.Deprecation `json:"after"`
+}
+
+// FieldComputationChanged records a field becoming computed (Before is
+// nil), no longer computed (After is nil), or a change to its expression.
+// Both schemas must have been checked, since Check records computations.
+type FieldComputationChanged struct {
+	Model  string              `json:"model"`
+	Field  string              `json:"field"`
+	Before *schema.Computation `json:"before"`
+	After  *schema.Computation `json:"after"`
+}
+
+type TypeAliasAdded struct {
+	Name  string            `json:"name"`
+	After *schema.TypeAlias `json:"after"`
+}
+
+type TypeAliasRemoved struct {
+	Name   string            `json:"name"`
+	Before *schema.TypeAlias `json:"before"`
+}
+
+type TypeAliasRenamed struct {
+	OldName string            `json:"old_name"`
+	NewName string            `json:"new_name"`
+	ID      schema.EntityID   `json:"id,omitempty"`
+	Before  *schema.TypeAlias `json:"before"`
+	After   *schema.TypeAlias `json:"after"`
+}
+
+type TypeAliasTypeChanged struct {
+	Name   string           `json:"name"`
+	Before *schema.TypeExpr `json:"before"`
+	After  *schema.TypeExpr `json:"after"`
+}
+
+// TypeAliasDeprecationChanged is the type alias counterpart of
+// FieldDeprecationChanged.
+type TypeAliasDeprecationChanged struct {
+	Name   string              `json:"name"`
+	Before *schema.Deprecation `json:"before"`
+	After  *schema.Deprecation `json:"after"`
+}
+
+// ModelDeprecationChanged is the model counterpart of
+// FieldDeprecationChanged.
+type ModelDeprecationChanged struct {
+	Model  string              `json:"model"`
+	Before *schema.Deprecation `json:"before"`
+	After  *schema.Deprecation `json:"after"`
+}
+
+type InheritanceAdded struct {
+	Model  string `json:"model"`
+	Parent string `json:"parent"`
+}
+
+type InheritanceRemoved struct {
+	Model  string `json:"model"`
+	Parent string `json:"parent"`
+}
+
+// GlobalConfigChanged holds the plugin import configs of each version as
+// one `{ "plugin": { ... } }` object.
+type GlobalConfigChanged struct {
+	Before value.Value `json:"before"`
+	After  value.Value `json:"after"`
+}
+
+// ProjectionChanged records a change to the `pick` or `omit` definition of
+// a projected model. Before is nil when the model was not a projection, and
+// After is nil when it no longer is one. The fields it gains or loses are
+// reported as field deltas of their own.
+type ProjectionChanged struct {
+	Model  string             `json:"model"`
+	Before *schema.Projection `json:"before"`
+	After  *schema.Projection `json:"after"`
+}
+
+// IndexAdded records an index, unique constraint or primary key that a
+// model gains, declared or inherited (see schema.Index).
+type IndexAdded struct {
+	Model string        `json:"model"`
+	After *schema.Index `json:"after"`
+}
+
+type IndexRemoved struct {
+	Model  string        `json:"model"`
+	Before *schema.Index `json:"before"`
+}
+
+// IndexChanged records a change to the fields or options of an index that
+// keeps its name. Databases cannot alter most of these in place, so
+// migrations usually drop and recreate it.
+type IndexChanged struct {
+	Model  string        `json:"model"`
+	Name   string        `json:"name"`
+	Before *schema.Index `json:"before"`
+	After  *schema.Index `json:"after"`
+}
+
+// ModelConfigChanged holds a model's plugin configs. Indexes in the @sql
+// config are compared on their own and left out.
+type ModelConfigChanged struct {
+	Model  string      `json:"model"`
+	Before value.Value `json:"before"`
+	After  value.Value `json:"after"`
+}
+
+type FieldConfigChanged struct {
+	Model  string      `json:"model"`
+	Field  string      `json:"field"`
+	Before value.Value `json:"before"`
+	After  value.Value `json:"after"`
+}
+
+func (ModelAdded) Type() string                  { return "model_added" }
+func (ModelRemoved) Type() string                { return "model_removed" }
+func (ModelRenamed) Type() string                { return "model_renamed" }
+func (FieldAdded) Type() string                  { return "field_added" }
+func (FieldRemoved) Type() string                { return "field_removed" }
+func (FieldRenamed) Type() string                { return "field_renamed" }
+func (FieldTypeChanged) Type() string            { return "field_type_changed" }
+func (FieldOptionalityChanged) Type() string     { return "field_optionality_changed" }
+func (FieldNullabilityChanged) Type() string     { return "field_nullability_changed" }
+func (FieldDefaultChanged) Type() string         { return "field_default_changed" }
+func (FieldDeprecationChanged) Type() string     { return "field_deprecation_changed" }
+func (FieldComputationChanged) Type() string     { return "field_computation_changed" }
+func (TypeAliasAdded) Type() string              { return "type_alias_added" }
+func (TypeAliasRemoved) Type() string            { return "type_alias_removed" }
+func (TypeAliasRenamed) Type() string            { return "type_alias_renamed" }
+func (TypeAliasTypeChanged) Type() string        { return "type_alias_type_changed" }
+func (TypeAliasDeprecationChanged) Type() string { return "type_alias_deprecation_changed" }
+func (ModelDeprecationChanged) Type() string     { return "model_deprecation_changed" }
+func (InheritanceAdded) Type() string            { return "inheritance_added" }
+func (InheritanceRemoved) Type() string          { return "inheritance_removed" }
+func (ProjectionChanged) Type() string           { return "projection_changed" }
+func (GlobalConfigChanged) Type() string         { return "global_config_changed" }
+func (IndexAdded) Type() string                  { return "index_added" }
+func (IndexRemoved) Type() string                { return "index_removed" }
+func (IndexChanged) Type() string                { return "index_changed" }
+func (ModelConfigChanged) Type() string          { return "model_config_changed" }
+func (FieldConfigChanged) Type() string          { return "field_config_changed" }
+
+func (d ModelAdded) String() string   { return fmt.Sprintf("Added model '%s'", d.Name) }
+func (d ModelRemoved) String() string { return fmt.Sprintf("Removed model '%s'", d.Name) }
+func (d ModelRenamed) String() string {
+	return fmt.Sprintf("Renamed model '%s' to '%s'", d.OldName, d.NewName)
+}
+func (d FieldAdded) String() string {
+	return fmt.Sprintf("Added field '%s.%s'", d.Model, d.Field)
+}
+func (d FieldRemoved) String() string {
+	return fmt.Sprintf("Removed field '%s.%s'", d.Model, d.Field)
+}
+func (d FieldRenamed) String() string {
+	return fmt.Sprintf("Renamed field '%s.%s' to '%s'", d.Model, d.OldName, d.NewName)
+}
+func (d FieldTypeChanged) String() string {
+	return fmt.Sprintf("Changed type of '%s.%s' from %s to %s", d.Model, d.Field, d.Before, d.After)
+}
+func (d FieldOptionalityChanged) String() string {
+	if d.After {
+		return fmt.Sprintf("Made '%s.%s' optional", d.Model, d.Field)
+	}
+	return fmt.Sprintf("Made '%s.%s' required", d.Model, d.Field)
+}
+func (d FieldNullabilityChanged) String() string {
+	if d.After {
+		return fmt.Sprintf("Made '%s.%s' nullable", d.Model, d.Field)
+	}
+	return fmt.Sprintf("Made '%s.%s' non-nullable", d.Model, d.Field)
+}
+func (d FieldDefaultChanged) String() string {
+	return fmt.Sprintf("Changed default of '%s.%s' from %s to %s", d.Model, d.Field, describeDefault(d.Before), describeDefault(d.After))
+}
+func (d FieldDeprecationChanged) String() string {
+	return describeDeprecation(d.Model+"."+d.Field, d.Before, d.After)
+}
+func (d FieldComputationChanged) String() string {
+	switch {
+	case d.After == nil:
+		return fmt.Sprintf("'%s.%s' is no longer computed", d.Model, d.Field)
+	case d.Before == nil:
+		return fmt.Sprintf("Made '%s.%s' computed from %s", d.Model, d.Field, d.After.From)
+	}
+	return fmt.Sprintf("Changed computation of '%s.%s' from %s to %s", d.Model, d.Field, d.Before.From, d.After.From)
+}
+func (d TypeAliasAdded) String() string { return fmt.Sprintf("Added type alias '%s'", d.Name) }
+func (d TypeAliasRemoved) String() string {
+	return fmt.Sprintf("Removed type alias '%s'", d.Name)
+}
+func (d TypeAliasRenamed) String() string {
+	return fmt.Sprintf("Renamed type alias '%s' to '%s'", d.OldName, d.NewName)
+}
+func (d TypeAliasTypeChanged) String() string {
+	return fmt.Sprintf("Changed type alias '%s' from %s to %s", d.Name, d.Before, d.After)
+}
+func (d TypeAliasDeprecationChanged) String() string {
+	return describeDeprecation(d.Name, d.Before, d.After)
+}
+func (d ModelDeprecationChanged) String() string {
+	return describeDeprecation(d.Model, d.Before, d.After)
+}
+func (d InheritanceAdded) String() string {
+	return fmt.Sprintf("Model '%s' now extends '%s'", d.Model, d.Parent)
+}
+func (d InheritanceRemoved) String() string {
+	return fmt.Sprintf("Model '%s' no longer extends '%s'", d.Model, d.Parent)
+}
+func (d ProjectionChanged) String() string {
+	switch {
+	case d.Before == nil:
+		return fmt.Sprintf("Model '%s' is now a projection: %s", d.Model, d.After)
+	case d.After == nil:
+		return fmt.Sprintf("Model '%s' is no longer a projection", d.Model)
+	}
+	return fmt.Sprintf("Changed projection '%s' from %s to %s", d.Model, d.Before, d.After)
+}
+func (d GlobalConfigChanged) String() string { return "Changed plugin configuration" }
+func (d IndexAdded) String() string {
+	return fmt.Sprintf("Added index '%s' on '%s'", d.After.Name, d.Model)
+}
+func (d IndexRemoved) String() string {
+	return fmt.Sprintf("Removed index '%s' on '%s'", d.Before.Name, d.Model)
+}
+func (d IndexChanged) String() string {
+	return fmt.Sprintf("Changed index '%s' on '%s'", d.Name, d.Model)
+}
+func (d ModelConfigChanged) String() string {
+	return fmt.Sprintf("Changed configuration of model '%s'", d.Model)
+}
+func (d FieldConfigChanged) String() string {
+	return fmt.Sprintf("Changed configuration of '%s.%s'", d.Model, d.Field)
+}
+
+func describeDefault(v *value.Value) string {
+	if v == nil {
+		return "none"
+	}
+	return v.String()
+}
+
+func describeDeprecation(name string, before, after *schema.Deprecation) string {
+	switch {
+	case after == nil:
+		return fmt.Sprintf("'%s' is no longer deprecated", name)
+	case before == nil:
+		return fmt.Sprintf("Deprecated '%s'%s", name, after.Details())
+	}
+	return fmt.Sprintf("Changed deprecation of '%s'%s", name, after.Details())
+}
+
+// MarshalJSON encodes deltas as a JSON array of objects tagged with their
+// "type", the format plugins receive in `migrate`.
+func MarshalJSON(deltas []Delta) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, d := range deltas {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		body, err := json.Marshal(d)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&buf, `{"type":%q`, d.Type())
+		if len(body) > 2 {
+			buf.WriteByte(',')
+		}
+		buf.Write(body[1:])
+	}
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,393 @@
+// Package diff computes the deltas between two versions of a schema, the
+// changes that migrations are generated from and changelogs describe.
+//
+// Entities are matched by their scoped entity ID where they have one, so a
+// changed name with the same ID is a rename, while `#10` of an extended
+// template and a local `#10` are different entities. Fields are matched by
+// their own source and ID within the model that declares them, so an
+// inherited `#1` and a child's own `#1` are different fields. Models
+// generated for instantiations of generic models are matched by their
+// instance key, which follows the IDs of the generic model and its
+// arguments, and models generated for inline object types by the key of the
+// field that declares them. Entities without IDs are matched by name, and an
+// entity whose name changed without an ID is a removal plus an addition.
+package diff
+
+import (
+	"sort"
+
+	"github.com/larner-dev/cdm/bindings/go/schema"
+	"github.com/larner-dev/cdm/bindings/go/types"
+	"github.com/larner-dev/cdm/bindings/go/value"
+)
+
+// Compare returns the deltas that turn before into after: global config
+// first, then type aliases, then models with their fields, each in the
+// order they are declared.
+//
+// Both schemas should have been checked, so that models generated for
+// generic instantiations exist, projections are linked to their sources
+// and computed fields are recorded. A change to a source field is then also reported on every
+// projection that keeps the field.
+func Compare(before, after *schema.Schema) []Delta {
+	c := &comparer{
+		before:    before,
+		after:     after,
+		beforeEnv: types.NewEnv(before),
+		afterEnv:  types.NewEnv(after),
+		owners:    map[*schema.Field]*schema.Model{},
+	}
+	for _, s := range []*schema.Schema{before, after} {
+		for _, model := range s.Models {
+			for _, field := range model.Fields {
+				c.owners[field] = model
+			}
+		}
+	}
+	c.globalConfig()
+	c.typeAliases()
+	c.models()
+	return c.deltas
+}
+
+type comparer struct {
+	before, after       *schema.Schema
+	beforeEnv, afterEnv *types.Env
+	// Model declaring each field of either version
+	owners map[*schema.Field]*schema.Model
+	deltas []Delta
+}
+
+func (c *comparer) add(d Delta) {
+	c.deltas = append(c.deltas, d)
+}
+
+func (c *comparer) globalConfig() {
+	before, after := pluginConfigs(c.before), pluginConfigs(c.after)
+	if !sameConfig(before, after) {
+		c.add(GlobalConfigChanged{Before: before, After: after})
+	}
+}
+
+func (c *comparer) typeAliases() {
+	pairs, added, removed := match(c.before.TypeAliases, c.after.TypeAliases, func(a *schema.TypeAlias) (string, string) {
+		return a.Name, a.ScopedID().String()
+	})
+	for _, p := range pairs {
+		b, a := p.before, p.after
+		if b.Name != a.Name {
+			c.add(TypeAliasRenamed{OldName: b.Name, NewName: a.Name, ID: a.ID, Before: b, After: a})
+			continue
+		}
+		if !c.sameType(b.Type, a.Type) {
+			c.add(TypeAliasTypeChanged{Name: a.Name, Before: b.Type, After: a.Type})
+		}
+		if before, after := b.Deprecation(), a.Deprecation(); !before.Equal(after) {
+			c.add(TypeAliasDeprecationChanged{Name: a.Name, Before: before, After: after})
+		}
+	}
+	for _, a := range added {
+		c.add(TypeAliasAdded{Name: a.Name, After: a})
+	}
+	for _, b := range removed {
+		c.add(TypeAliasRemoved{Name: b.Name, Before: b})
+	}
+}
+
+func (c *comparer) models() {
+	pairs, added, removed := match(c.before.Models, c.after.Models, modelKey)
+	for _, p := range pairs {
+		b, a := p.before, p.after
+		if b.Name != a.Name {
+			c.add(ModelRenamed{OldName: b.Name, NewName: a.Name, ID: a.ID, Before: b, After: a})
+		}
+		c.fields(b, a)
+		if b.Projection != nil || a.Projection != nil {
+			// A projection's parent is its source, which the projection
+			// itself describes.
+			if !b.Projection.Equal(a.Projection) {
+				c.add(ProjectionChanged{Model: a.Name, Before: b.Projection, After: a.Projection})
+			}
+		} else {
+			c.inheritance(b, a)
+		}
+		c.indexes(b, a)
+		if before, after := modelConfigs(b.Configs), modelConfigs(a.Configs); !sameConfig(before, after) {
+			c.add(ModelConfigChanged{Model: a.Name, Before: before, After: after})
+		}
+		if before, after := b.Deprecation(), a.Deprecation(); !before.Equal(after) {
+			c.add(ModelDeprecationChanged{Model: a.Name, Before: before, After: after})
+		}
+	}
+	for _, m := range added {
+		c.add(ModelAdded{Name: m.Name, After: m})
+	}
+	for _, m := range removed {
+		c.add(ModelRemoved{Name: m.Name, Before: m})
+	}
+}
+
+// modelKey returns the name and identity models are matched by.
+func modelKey(m *schema.Model) (string, string) {
+	if m.Instance != nil {
+		return m.Name, m.Instance.Key
+	}
+	if m.InlineOf != nil {
+		return m.Name, m.InlineOf.Key
+	}
+	return m.Name, m.ScopedID().String()
+}
+
+// fields compares the effective fields of a model, inherited ones
+// included, since those are what a table or type is generated from.
+func (c *comparer) fields(beforeModel, afterModel *schema.Model) {
+	model := afterModel.Name
+	pairs, added, removed := match(c.before.Fields(beforeModel), c.after.Fields(afterModel), func(f *schema.Field) (string, string) {
+		id := f.ScopedID(nil).String()
+		if id == "" {
+			return f.Name, ""
+		}
+		owner := c.owners[f]
+		if owner == nil {
+			return f.Name, id
+		}
+		name, scope := modelKey(owner)
+		if scope == "" {
+			scope = name
+		}
+		return f.Name, scope + "." + id
+	})
+	beforeDeprecations, afterDeprecations := c.before.FieldDeprecations(beforeModel), c.after.FieldDeprecations(afterModel)
+	for _, p := range pairs {
+		b, a := p.before, p.after
+		if b.Name != a.Name {
+			c.add(FieldRenamed{Model: model, OldName: b.Name, NewName: a.Name, ID: a.ID, Before: b, After: a})
+			continue
+		}
+		beforeType, beforeNull := types.WithoutNull(c.beforeEnv.Normalize(b.FieldType()))
+		afterType, afterNull := types.WithoutNull(c.afterEnv.Normalize(a.FieldType()))
+		if types.Classify(beforeType, afterType) != types.Same {
+			c.add(FieldTypeChanged{Model: model, Field: a.Name, Before: b.FieldType(), After: a.FieldType()})
+		}
+		if b.Optional != a.Optional {
+			c.add(FieldOptionalityChanged{Model: model, Field: a.Name, Before: b.Optional, After: a.Optional})
+		}
+		if beforeNull != afterNull {
+			c.add(FieldNullabilityChanged{Model: model, Field: a.Name, Before: beforeNull, After: afterNull})
+		}
+		if !sameDefault(b.Default, a.Default) {
+			c.add(FieldDefaultChanged{Model: model, Field: a.Name, Before: b.Default, After: a.Default})
+		}
+		if !b.Computed.Equal(a.Computed) {
+			c.add(FieldComputationChanged{Model: model, Field: a.Name, Before: b.Computed, After: a.Computed})
+		}
+		if before, after := fieldConfigs(b.Configs), fieldConfigs(a.Configs); !sameConfig(before, after) {
+			c.add(FieldConfigChanged{Model: model, Field: a.Name, Before: before, After: after})
+		}
+		before, after := beforeDeprecations[b.Name], afterDeprecations[a.Name]
+		if !before.Equal(after) {
+			c.add(FieldDeprecationChanged{Model: model, Field: a.Name, Before: before, After: after})
+		}
+	}
+	for _, f := range added {
+		c.add(FieldAdded{Model: model, Field: f.Name, After: f})
+	}
+	for _, f := range removed {
+		c.add(FieldRemoved{Model: model, Field: f.Name, Before: f})
+	}
+}
+
+// indexes compares the effective indexes of a model by name, so that a
+// renamed index is dropped and created again.
+func (c *comparer) indexes(beforeModel, afterModel *schema.Model) {
+	model := afterModel.Name
+	pairs, added, removed := match(c.before.Indexes(beforeModel), c.after.Indexes(afterModel), func(i *schema.Index) (string, string) {
+		return i.Name, ""
+	})
+	for _, p := range pairs {
+		if !p.before.Equal(p.after) {
+			c.add(IndexChanged{Model: model, Name: p.after.Name, Before: p.before, After: p.after})
+		}
+	}
+	for _, i := range added {
+		c.add(IndexAdded{Model: model, After: i})
+	}
+	for _, i := range removed {
+		c.add(IndexRemoved{Model: model, Before: i})
+	}
+}
+
+func (c *comparer) inheritance(before, after *schema.Model) {
+	beforeParents, afterParents := map[string]bool{}, map[string]bool{}
+	for _, parent := range before.Parents {
+		beforeParents[parent.Name] = true
+	}
+	for _, parent := range after.Parents {
+		afterParents[parent.Name] = true
+		if !beforeParents[parent.Name] {
+			c.add(InheritanceAdded{Model: after.Name, Parent: parent.Name})
+		}
+	}
+	for _, parent := range before.Parents {
+		if !afterParents[parent.Name] {
+			c.add(InheritanceRemoved{Model: after.Name, Parent: parent.Name})
+		}
+	}
+}
+
+// sameType compares types after resolving each side's aliases, so that
+// reordering a union or inlining an alias is not a change.
+func (c *comparer) sameType(before, after *schema.TypeExpr) bool {
+	return types.Classify(c.beforeEnv.Normalize(before), c.afterEnv.Normalize(after)) == types.Same
+}
+
+func sameDefault(before, after *value.Value) bool {
+	if before == nil || after == nil {
+		return before == after
+	}
+	return value.Equal(*before, *after)
+}
+
+// sameConfig compares configs as JSON objects, where key order does not
+// matter.
+func sameConfig(before, after value.Value) bool {
+	return value.Equal(sortKeys(before), sortKeys(after))
+}
+
+func sortKeys(v value.Value) value.Value {
+	switch v.Kind {
+	case value.Object:
+		entries := make([]value.Entry, len(v.Entries))
+		for i, entry := range v.Entries {
+			entries[i] = value.Entry{Key: entry.Key, Value: sortKeys(entry.Value)}
+		}
+		sort.SliceStable(entries, func(i, j int) bool { return entries[i].Key < entries[j].Key })
+		v.Entries = entries
+	case value.Array:
+		items := make([]value.Value, len(v.Items))
+		for i, item := range v.Items {
+			items[i] = sortKeys(item)
+		}
+		v.Items = items
+	}
+	return v
+}
+
+type pair[T any] struct {
+	before, after T
+}
+
+// match pairs the entities of two versions. Entities that share an
+// identity, such as an entity ID, are paired first; the rest are paired by
+// name unless both sides have identities, which then identify different
+// entities. Unpaired entities are added or removed. Results keep
+// declaration order.
+func match[T comparable](before, after []T, key func(T) (name, identity string)) (pairs []pair[T], added, removed []T) {
+	byID := map[string]T{}
+	for _, b := range before {
+		if _, id := key(b); id != "" {
+			byID[id] = b
+		}
+	}
+	paired := map[T]bool{}
+	partner := map[T]T{}
+	for _, a := range after {
+		if _, id := key(a); id != "" {
+			if b, ok := byID[id]; ok && !paired[b] {
+				paired[b] = true
+				partner[a] = b
+			}
+		}
+	}
+
+	byName := map[string]T{}
+	for _, b := range before {
+		if !paired[b] {
+			name, _ := key(b)
+			byName[name] = b
+		}
+	}
+	for _, a := range after {
+		if _, ok := partner[a]; ok {
+			continue
+		}
+		name, afterID := key(a)
+		if b, ok := byName[name]; ok && !paired[b] {
+			if _, beforeID := key(b); beforeID == "" || afterID == "" {
+				paired[b] = true
+				partner[a] = b
+			}
+		}
+	}
+
+	for _, a := range after {
+		if b, ok := partner[a]; ok {
+			pairs = append(pairs, pair[T]{before: b, after: a})
+		} else {
+			added = append(added, a)
+		}
+	}
+	for _, b := range before {
+		if !paired[b] {
+			removed = append(removed, b)
+		}
+	}
+	return pairs, added, removed
+}
+
+// configs merges plugin configs into one `{ "plugin": { ... } }` object.
+// @deprecated is compared on its own, so it is left out.
+func configs(list []*schema.Config) value.Value {
+	out := value.Value{Kind: value.Object, Entries: []value.Entry{}}
+	for _, config := range list {
+		if config.Name != "deprecated" {
+			out.Entries = append(out.Entries, value.Entry{Key: config.Name, Value: config.Value})
+		}
+	}
+	return out
+}
+
+// fieldConfigs is configs without @computed, which is compared on its own.
+func fieldConfigs(list []*schema.Config) value.Value {
+	out := configs(list)
+	entries := out.Entries[:0]
+	for _, entry := range out.Entries {
+		if entry.Key != "computed" {
+			entries = append(entries, entry)
+		}
+	}
+	out.Entries = entries
+	return out
+}
+
+// modelConfigs is configs without the `indexes` of @sql, which are compared
+// on their own.
+func modelConfigs(list []*schema.Config) value.Value {
+	out := configs(list)
+	for i, entry := range out.Entries {
+		if entry.Key != "sql" || entry.Value.Kind != value.Object {
+			continue
+		}
+		sql := entry.Value
+		sql.Entries = nil
+		for _, setting := range entry.Value.Entries {
+			if setting.Key != "indexes" {
+				sql.Entries = append(sql.Entries, setting)
+			}
+		}
+		out.Entries[i].Value = sql
+	}
+	return out
+}
+
+func pluginConfigs(s *schema.Schema) value.Value {
+	out := value.Value{Kind: value.Object, Entries: []value.Entry{}}
+	for _, plugin := range s.Plugins {
+		config := value.Value{Kind: value.Object}
+		if plugin.Config != nil {
+			config = *plugin.Config
+		}
+		out.Entries = append(out.Entries, value.Entry{Key: plugin.Name, Value: config})
+	}
+	return out
+}
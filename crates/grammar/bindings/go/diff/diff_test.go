@@ -0,0 +1,470 @@
+package diff_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/larner-dev/cdm/bindings/go/diff"
+	"github.com/larner-dev/cdm/bindings/go/schema"
+)
+
+func mustParse(t *testing.T, source string) *schema.Schema {
+	t.Helper()
+
+	s, diagnostics := schema.Parse([]byte(source))
+	if len(diagnostics) > 0 {
+		t.Fatalf("Parse returned diagnostics: %v", diagnostics)
+	}
+	return s
+}
+
+// compare diffs two sources and returns one line per delta, "type: description".
+func compare(t *testing.T, before, after string) []string {
+	t.Helper()
+
+	var out []string
+	for _, d := range diff.Compare(mustParse(t, before), mustParse(t, after)) {
+		out = append(out, d.Type()+": "+d.String())
+	}
+	return out
+}
+
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		name          string
+		before, after string
+		want          []string
+	}{
+		{
+			name:   "unchanged",
+			before: "Status: \"a\" | \"b\"\nUser {\n  name: string\n}",
+			after:  "Status: \"b\" | \"a\"\nUser {\n  name\n}",
+		},
+		{
+			name:   "renames by ID",
+			before: "Email: string #1\nUser {\n  mail: Email #1\n} #2",
+			after:  "EmailAddress: string #1\nAccount {\n  email: EmailAddress #1\n} #2",
+			want: []string{
+				"type_alias_renamed: Renamed type alias 'Email' to 'EmailAddress'",
+				"model_renamed: Renamed model 'User' to 'Account'",
+				"field_renamed: Renamed field 'Account.mail' to 'email'",
+			},
+		},
+		{
+			name:   "renames without IDs",
+			before: "User {\n  mail: string\n}",
+			after:  "User {\n  email: string\n}",
+			want: []string{
+				"field_added: Added field 'User.email'",
+				"field_removed: Removed field 'User.mail'",
+			},
+		},
+		{
+			name:   "different IDs with the same name",
+			before: "User {\n  email: string #1\n}",
+			after:  "User {\n  email: string #2\n}",
+			want: []string{
+				"field_added: Added field 'User.email'",
+				"field_removed: Removed field 'User.email'",
+			},
+		},
+		{
+			name:   "ID assigned",
+			before: "User {\n  email: string\n}",
+			after:  "User {\n  email: string #1\n} #1",
+		},
+		{
+			name:   "field changes",
+			before: "User {\n  age: number\n  bio?: string\n  role: string = \"user\"\n  name: string { @sql { type: \"TEXT\" } }\n}",
+			after:  "User {\n  age: string\n  bio: string\n  role: string = \"member\"\n  name: string { @sql { type: \"VARCHAR\" } }\n}",
+			want: []string{
+				"field_type_changed: Changed type of 'User.age' from number to string",
+				"field_optionality_changed: Made 'User.bio' required",
+				`field_default_changed: Changed default of 'User.role' from "user" to "member"`,
+				"field_config_changed: Changed configuration of 'User.name'",
+			},
+		},
+		{
+			name:   "inherited fields",
+			before: "Base {\n  id: string\n}\nUser extends Base {\n  name: string\n}",
+			after:  "Base {\n  id: number\n}\nUser {\n  name: string\n}",
+			want: []string{
+				"field_type_changed: Changed type of 'Base.id' from string to number",
+				"field_removed: Removed field 'User.id'",
+				"inheritance_removed: Model 'User' no longer extends 'Base'",
+			},
+		},
+		{
+			name:   "configs",
+			before: "@sql { dialect: \"postgres\", schema: \"public\" }\nUser {\n  @sql { table: \"users\" }\n}",
+			after:  "@sql { schema: \"public\", dialect: \"postgres\" }\nUser {\n  @sql { table: \"people\" }\n}",
+			want: []string{
+				"model_config_changed: Changed configuration of model 'User'",
+			},
+		},
+		{
+			name:   "alias type",
+			before: "Status: \"a\" | \"b\"",
+			after:  "Status: \"a\" | \"b\" | \"c\"",
+			want: []string{
+				`type_alias_type_changed: Changed type alias 'Status' from "a" | "b" to "a" | "b" | "c"`,
+			},
+		},
+		{
+			name:   "deprecations",
+			before: "Email: string\nLegacy {\n  x: string\n}\nBase {\n  name: string\n}\nUser extends Base {\n  email: string { @sql { type: \"TEXT\" } }\n}",
+			after: "Email: string { @deprecated { since: \"2.3\" } }\nLegacy {\n  @deprecated { replacement: \"User\" }\n  x: string\n}\n" +
+				"Base {\n  name: string\n}\nUser extends Base {\n  email: string { @sql { type: \"TEXT\" } @deprecated { reason: \"use contacts\" } }\n  name { @deprecated {} }\n}",
+			want: []string{
+				"type_alias_deprecation_changed: Deprecated 'Email' since 2.3",
+				"model_deprecation_changed: Deprecated 'Legacy' (use 'User' instead)",
+				"field_deprecation_changed: Deprecated 'User.name'",
+				"field_deprecation_changed: Deprecated 'User.email': use contacts",
+			},
+		},
+		{
+			name:   "undeprecated",
+			before: "User {\n  email: string { @deprecated { since: \"1\" } }\n}",
+			after:  "User {\n  email: string\n}",
+			want: []string{
+				"field_deprecation_changed: 'User.email' is no longer deprecated",
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := compare(t, c.before, c.after)
+			if strings.Join(got, "\n") != strings.Join(c.want, "\n") {
+				t.Errorf("Compare =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(c.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	before := mustParse(t, "User {\n  email: string\n  bio?: string\n}")
+	after := mustParse(t, "User {\n  email: string { @deprecated { since: \"2.3\" } }\n  bio: string\n}")
+
+	encoded, err := diff.MarshalJSON(diff.Compare(before, after))
+	if err != nil {
+		t.Fatalf("MarshalJSON returned error: %v", err)
+	}
+	want := `[{"type":"field_deprecation_changed","model":"User","field":"email","before":null,"after":{"since":"2.3"}},` +
+		`{"type":"field_optionality_changed","model":"User","field":"bio","before":true,"after":false}]`
+	if string(encoded) != want {
+		t.Errorf("MarshalJSON =\n%s\nwant\n%s", encoded, want)
+	}
+
+	if encoded, _ := diff.MarshalJSON(nil); string(encoded) != "[]" {
+		t.Errorf("MarshalJSON(nil) = %s, want []", encoded)
+	}
+}
+
+func TestChangelog(t *testing.T) {
+	before := mustParse(t, "User {\n  email: string\n  legacy: string\n}")
+	after := mustParse(t, "User {\n  email: string { @deprecated { replacement: \"contact\" } }\n  contact: string\n}\nPost {\n  title: string\n}")
+
+	want := `### Added
+
+- Added field 'User.contact'
+- Added model 'Post'
+
+### Deprecated
+
+- Deprecated 'User.email' (use 'contact' instead)
+
+### Removed
+
+- Removed field 'User.legacy'
+`
+	if got := diff.Changelog(diff.Compare(before, after)); got != want {
+		t.Errorf("Changelog =\n%s\nwant\n%s", got, want)
+	}
+}
+
+// withPage parses source, makes its Page model generic over T, and types
+// Feed.users as Page<arg>, then checks the schema. Generics are set up
+// directly so this test does not depend on the generated parser knowing
+// generic syntax.
+func withPage(t *testing.T, source, arg string) *schema.Schema {
+	t.Helper()
+
+	s := mustParse(t, source)
+	page := s.Model("Page")
+	page.Params = []*schema.Reference{{Name: "T"}}
+	s.Generics = []*schema.Model{page}
+	s.Models = []*schema.Model{s.Model(arg), s.Model("Feed")}
+	s.Model("Feed").Field("users").Type = &schema.TypeExpr{Kind: schema.Identifier, Name: "Page", Args: []*schema.TypeExpr{schema.Named(arg)}}
+	if diagnostics := s.Check(); len(diagnostics) > 0 {
+		t.Fatalf("Check returned diagnostics: %v", diagnostics)
+	}
+	return s
+}
+
+func TestCompareInstances(t *testing.T) {
+	before := withPage(t, "User {\n  name: string\n} #10\nPage {\n  items: T[] #1\n} #12\nFeed {\n  users: string\n}", "User")
+	after := withPage(t, "Member {\n  name: string\n} #10\nPage {\n  items: T[] #1\n  total: number #2\n} #12\nFeed {\n  users: string\n}", "Member")
+
+	var got []string
+	for _, d := range diff.Compare(before, after) {
+		got = append(got, d.Type()+": "+d.String())
+	}
+	want := []string{
+		"model_renamed: Renamed model 'User' to 'Member'",
+		"field_type_changed: Changed type of 'Feed.users' from Page<User> to Page<Member>",
+		"model_renamed: Renamed model 'Page_User' to 'Page_Member'",
+		"field_type_changed: Changed type of 'Page_Member.items' from User[] to Member[]",
+		"field_added: Added field 'Page_Member.total'",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Compare =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+// withProjection parses and checks source after adding the projection
+// `UserPublic: <operator> User { fields }`, set up directly so this test
+// does not depend on the generated parser knowing projection syntax.
+func withProjection(t *testing.T, source, operator string, fields ...string) *schema.Schema {
+	t.Helper()
+
+	s := mustParse(t, source)
+	p := &schema.Projection{Operator: operator, Source: &schema.Reference{Name: "User"}}
+	for _, field := range fields {
+		p.Fields = append(p.Fields, &schema.Reference{Name: field})
+	}
+	s.Models = append(s.Models, &schema.Model{Name: "UserPublic", ID: 20, Projection: p})
+	if diagnostics := s.Check(); len(diagnostics) > 0 {
+		t.Fatalf("Check returned diagnostics: %v", diagnostics)
+	}
+	return s
+}
+
+func TestCompareProjections(t *testing.T) {
+	before := withProjection(t, "User {\n  id: string #1\n  name: string #2\n  password_hash: string #3\n} #10", "pick", "id", "name")
+	after := withProjection(t, "User {\n  id: number #1\n  name: string { @sql { type: \"TEXT\" } } #2\n  email: string #4\n  password_hash: string #3\n} #10", "pick", "id", "name", "email")
+
+	var got []string
+	for _, d := range diff.Compare(before, after) {
+		got = append(got, d.Type()+": "+d.String())
+	}
+	want := []string{
+		"field_type_changed: Changed type of 'User.id' from string to number",
+		"field_config_changed: Changed configuration of 'User.name'",
+		"field_added: Added field 'User.email'",
+		"field_type_changed: Changed type of 'UserPublic.id' from string to number",
+		"field_config_changed: Changed configuration of 'UserPublic.name'",
+		"field_added: Added field 'UserPublic.email'",
+		"projection_changed: Changed projection 'UserPublic' from pick User { id, name } to pick User { id, name, email }",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Compare =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestCompareInlineObjects(t *testing.T) {
+	inline := func(source string, fields ...*schema.Field) *schema.Schema {
+		s := mustParse(t, source)
+		s.Models[0].Fields[0].Type = &schema.TypeExpr{Kind: schema.Identifier, Inline: true, Fields: fields}
+		if diagnostics := s.Check(); len(diagnostics) > 0 {
+			t.Fatalf("Check returned diagnostics: %v", diagnostics)
+		}
+		return s
+	}
+	before := inline("User {\n  address: string #3\n} #10", &schema.Field{Name: "street", ID: 1})
+	after := inline("User {\n  home: string #3\n} #10", &schema.Field{Name: "line1", ID: 1}, &schema.Field{Name: "zip", ID: 2})
+
+	var got []string
+	for _, d := range diff.Compare(before, after) {
+		got = append(got, d.Type()+": "+d.String())
+	}
+	want := []string{
+		"field_renamed: Renamed field 'User.address' to 'home'",
+		"model_renamed: Renamed model 'User_address' to 'User_home'",
+		"field_renamed: Renamed field 'User_home.street' to 'line1'",
+		"field_added: Added field 'User_home.zip'",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Compare =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestCompareNullability(t *testing.T) {
+	// Null members are set up directly so the test does not depend on the
+	// generated parser knowing nullable types.
+	nullable := func(source string) *schema.Schema {
+		s := mustParse(t, source)
+		for _, field := range s.Models[0].Fields {
+			field.Type = &schema.TypeExpr{Kind: schema.Union, Members: []*schema.TypeExpr{field.FieldType(), {Kind: schema.Null}}}
+		}
+		return s
+	}
+	before := mustParse(t, "User {\n  bio: string\n  age: number\n}")
+	after := nullable("User {\n  bio: string\n  age: string\n}")
+
+	var got []string
+	for _, d := range diff.Compare(before, after) {
+		got = append(got, d.Type()+": "+d.String())
+	}
+	want := []string{
+		"field_nullability_changed: Made 'User.bio' nullable",
+		"field_type_changed: Changed type of 'User.age' from number to string | null",
+		"field_nullability_changed: Made 'User.age' nullable",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Compare =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestCompareIndexes(t *testing.T) {
+	before := `Base {
+  id: string
+  @sql { indexes: { primary: { fields: ["id"], primary: true } } }
+}
+
+User extends Base {
+  email: string
+  name: string
+  @sql {
+    table_name: "users",
+    indexes: {
+      email_unique: { fields: ["email"], unique: true },
+      name_idx: { fields: ["name"] }
+    }
+  }
+}`
+	after := `Base {
+  id: string
+  @sql { indexes: { primary: { fields: ["id"], primary: true } } }
+}
+
+User extends Base {
+  email: string
+  name: string
+  @sql {
+    table_name: "users",
+    indexes: {
+      email_unique: { fields: ["email"], unique: true, where: "deleted_at IS NULL" },
+      name_lower_idx: { fields: ["name"] }
+    }
+  }
+}`
+	// The inherited primary key is unchanged, and since only indexes
+	// changed there is no model_config_changed.
+	got := compare(t, before, after)
+	want := []string{
+		"index_changed: Changed index 'email_unique' on 'User'",
+		"index_added: Added index 'name_lower_idx' on 'User'",
+		"index_removed: Removed index 'name_idx' on 'User'",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Compare =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	encoded, err := diff.MarshalJSON(diff.Compare(mustParse(t, before), mustParse(t, after))[:1])
+	if err != nil {
+		t.Fatalf("MarshalJSON returned error: %v", err)
+	}
+	wantJSON := `[{"type":"index_changed","model":"User","name":"email_unique",` +
+		`"before":{"name":"email_unique","fields":["email"],"unique":true},` +
+		`"after":{"name":"email_unique","fields":["email"],"unique":true,"where":"deleted_at IS NULL"}}]`
+	if string(encoded) != wantJSON {
+		t.Errorf("MarshalJSON =\n%s\nwant\n%s", encoded, wantJSON)
+	}
+}
+
+func TestCompareComputations(t *testing.T) {
+	checked := func(source string) *schema.Schema {
+		s := mustParse(t, source)
+		if diagnostics := s.Check(); len(diagnostics) > 0 {
+			t.Fatalf("Check returned diagnostics: %v", diagnostics)
+		}
+		return s
+	}
+	before := checked(`Order {
+  price: number
+  quantity: number
+  total: number { @computed { from: "price * quantity" } }
+  label: string { @computed { from: "upper(name)" } }
+  name: string
+  discount: number
+}`)
+	after := checked(`Order {
+  price: number
+  quantity: number
+  total: number { @computed { from: "(price * quantity)" } }
+  label: string { @computed { from: "lower(name)" } }
+  name: string
+  discount: number { @computed { from: "price * 0.1" } }
+}`)
+
+	// Redundant parentheses do not change a computation.
+	var got []string
+	for _, d := range diff.Compare(before, after) {
+		got = append(got, d.Type()+": "+d.String())
+	}
+	want := []string{
+		"field_computation_changed: Changed computation of 'Order.label' from upper(name) to lower(name)",
+		"field_computation_changed: Made 'Order.discount' computed from price * 0.1",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Compare =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestCompareScopedIDs(t *testing.T) {
+	auth := schema.TemplateIDSource("cdm/auth", nil)
+	before := mustParse(t, "User {\n  email: string #2\n} #10\n")
+	after := mustParse(t, "Member {\n  email: string #2\n} #10\n\nAccount {\n  mail: string #2\n} #11\n")
+	// The template's #10 is not the local #10, so Member is new rather
+	// than a rename of User; within Account, the local field #2 is a
+	// rename only of a local field #2.
+	after.Model("Member").IDSource = auth
+	beforeAccount := mustParse(t, "Account {\n  email: string #2\n} #11\n").Model("Account")
+	beforeAccount.Fields[0].IDSource = auth
+	before.Models = append(before.Models, beforeAccount)
+
+	deltas := func() []string {
+		var out []string
+		for _, d := range diff.Compare(before, after) {
+			out = append(out, d.Type()+": "+d.String())
+		}
+		return out
+	}
+	got := deltas()
+	want := []string{
+		"field_added: Added field 'Account.mail'",
+		"field_removed: Removed field 'Account.email'",
+		"model_added: Added model 'Member'",
+		"model_removed: Removed model 'User'",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Compare =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	after.Model("Member").IDSource = schema.EntityIDSource{}
+	if got := deltas(); len(got) == 0 || !strings.HasPrefix(got[0], "model_renamed: ") {
+		t.Errorf("Compare = %v, want User renamed to Member when both IDs are local", got)
+	}
+}
+
+// Field IDs are scoped to the model that declares them, so a child may reuse
+// an inherited field's ID.
+func TestCompareInheritedFieldIDs(t *testing.T) {
+	for _, source := range []string{
+		"Base {\n  a: string #1\n} #10\n\nChild extends Base {\n  b: number #1\n} #11\n",
+		"Base {\n  a: string #1\n}\n\nChild extends Base {\n  b: number #1\n}\n",
+	} {
+		s := mustParse(t, source)
+		if deltas := diff.Compare(s, s); len(deltas) > 0 {
+			t.Errorf("Compare of %q with itself = %v, want no deltas", source, deltas)
+		}
+	}
+
+	got := compare(t,
+		"Base {\n  a: string #1\n} #10\n\nChild extends Base {\n  b: number #1\n} #11\n",
+		"Base {\n  a: string #1\n} #10\n\nChild extends Base {\n  c: number #1\n} #11\n")
+	want := []string{"field_renamed: Renamed field 'Child.b' to 'c'"}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Compare =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
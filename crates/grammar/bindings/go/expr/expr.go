@@ -0,0 +1,469 @@
+// Package expr parses the expressions of computed fields, such as
+//
+//	AVG(reviews.rating)
+//	price * quantity - discount
+//	first_name || ' ' || last_name
+//
+// The language is a small, SQL-like subset that generators can translate to
+// views, generated columns or code: number, string (single or double
+// quoted), true, false and null literals; field paths such as
+// `author.name`; function calls, including the aggregates AVG, SUM, COUNT,
+// MIN and MAX and `COUNT(*)`; the operators `+ - * / %`, `||` for string
+// concatenation, `= != <> < <= > >=`, and `and`, `or` and `not`; and
+// parentheses. Keywords and function names are case-insensitive.
+package expr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Error reports an expression that cannot be parsed.
+type Error struct {
+	// Byte offset of the problem in the expression
+	Offset  int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s at byte %d", e.Message, e.Offset)
+}
+
+// Expr is a node of a parsed expression.
+type Expr interface {
+	// String renders the expression in a normalized form: keywords and
+	// function names in upper case, strings single-quoted, and parentheses
+	// only where precedence needs them.
+	String() string
+	// Offset is the byte offset of the node in the expression.
+	Offset() int
+}
+
+// Literal is a number, string, boolean or null literal.
+type Literal struct {
+	// "number", "string", "boolean" or "null"
+	Kind string
+	// Number and boolean literals as written; the decoded string for strings
+	Text string
+	Pos  int
+}
+
+// Path is a reference to a field, such as `rating`, or through relations to
+// a field of another model, such as `author.name`.
+type Path struct {
+	Names []string
+	Pos   int
+}
+
+// Call is a function call. Star is set for `COUNT(*)`.
+type Call struct {
+	// Name in upper case
+	Name string
+	Args []Expr
+	Star bool
+	Pos  int
+}
+
+// Unary is `-x` or `NOT x`.
+type Unary struct {
+	// "-" or "NOT"
+	Op  string
+	X   Expr
+	Pos int
+}
+
+// Binary is a binary operation. Op is written as in String, with `==`
+// spelled `=` and `<>` spelled `!=`.
+type Binary struct {
+	Op   string
+	X, Y Expr
+	Pos  int
+}
+
+func (e *Literal) Offset() int { return e.Pos }
+func (e *Path) Offset() int    { return e.Pos }
+func (e *Call) Offset() int    { return e.Pos }
+func (e *Unary) Offset() int   { return e.Pos }
+func (e *Binary) Offset() int  { return e.Pos }
+
+func (e *Literal) String() string {
+	if e.Kind == "string" {
+		return "'" + strings.ReplaceAll(e.Text, "'", "''") + "'"
+	}
+	return e.Text
+}
+
+func (e *Path) String() string { return strings.Join(e.Names, ".") }
+
+func (e *Call) String() string {
+	if e.Star {
+		return e.Name + "(*)"
+	}
+	args := make([]string, len(e.Args))
+	for i, arg := range e.Args {
+		args[i] = arg.String()
+	}
+	return e.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+func (e *Unary) String() string {
+	x := e.X.String()
+	if precedence(e.X) < unaryPrecedence {
+		x = "(" + x + ")"
+	}
+	if e.Op == "NOT" {
+		return "NOT " + x
+	}
+	return e.Op + x
+}
+
+func (e *Binary) String() string {
+	x, y := e.X.String(), e.Y.String()
+	p := binaryPrecedence[e.Op]
+	if precedence(e.X) < p {
+		x = "(" + x + ")"
+	}
+	// Operators are left-associative, so an equal right operand needs them,
+	// except a NOT, which stops before AND and OR anyway.
+	unary, ok := e.Y.(*Unary)
+	not := ok && unary.Op == "NOT" && p <= binaryPrecedence["AND"]
+	if precedence(e.Y) <= p && !not {
+		y = "(" + y + ")"
+	}
+	return x + " " + e.Op + " " + y
+}
+
+// Aggregates are the functions that fold a field over several records.
+var Aggregates = []string{"AVG", "SUM", "COUNT", "MIN", "MAX"}
+
+// IsAggregate reports whether the function name, in any case, is one of
+// Aggregates.
+func IsAggregate(name string) bool {
+	for _, aggregate := range Aggregates {
+		if strings.EqualFold(name, aggregate) {
+			return true
+		}
+	}
+	return false
+}
+
+// Walk calls visit with e and every node below it, parents first. The
+// aggregate is the innermost aggregate call enclosing the node, or nil.
+func Walk(e Expr, visit func(e Expr, aggregate *Call)) {
+	walk(e, nil, visit)
+}
+
+func walk(e Expr, aggregate *Call, visit func(Expr, *Call)) {
+	visit(e, aggregate)
+	switch e := e.(type) {
+	case *Call:
+		if IsAggregate(e.Name) {
+			aggregate = e
+		}
+		for _, arg := range e.Args {
+			walk(arg, aggregate, visit)
+		}
+	case *Unary:
+		walk(e.X, aggregate, visit)
+	case *Binary:
+		walk(e.X, aggregate, visit)
+		walk(e.Y, aggregate, visit)
+	}
+}
+
+const unaryPrecedence = 7
+
+var binaryPrecedence = map[string]int{
+	"OR":  1,
+	"AND": 2,
+	"=":   3, "!=": 3, "<": 3, "<=": 3, ">": 3, ">=": 3,
+	"+": 4, "-": 4, "||": 4,
+	"*": 5, "/": 5, "%": 5,
+}
+
+func precedence(e Expr) int {
+	switch e := e.(type) {
+	case *Binary:
+		return binaryPrecedence[e.Op]
+	case *Unary:
+		if e.Op == "NOT" {
+			// NOT binds looser than comparisons: `NOT a = b` is `NOT (a = b)`.
+			return binaryPrecedence["AND"]
+		}
+		return unaryPrecedence
+	}
+	return unaryPrecedence + 1
+}
+
+// Parse parses an expression. Errors are *Error.
+func Parse(source string) (Expr, error) {
+	tokens, err := scan(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	e, err := p.expression(0)
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != eof {
+		return nil, &Error{Offset: t.pos, Message: fmt.Sprintf("unexpected %s", t)}
+	}
+	return e, nil
+}
+
+type tokenKind int
+
+const (
+	eof tokenKind = iota
+	identifier
+	number
+	str
+	operator
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case eof:
+		return "end of expression"
+	case str:
+		return "string"
+	}
+	return "'" + t.text + "'"
+}
+
+var operators = []string{"||", "!=", "<>", "<=", ">=", "==", "+", "-", "*", "/", "%", "=", "<", ">", "(", ")", ",", "."}
+
+func scan(source string) ([]token, error) {
+	var tokens []token
+	i := 0
+	for i < len(source) {
+		c := source[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '_' || isLetter(c):
+			start := i
+			for i < len(source) && (source[i] == '_' || isLetter(source[i]) || isDigit(source[i])) {
+				i++
+			}
+			tokens = append(tokens, token{identifier, source[start:i], start})
+		case isDigit(c):
+			start := i
+			for i < len(source) && (isDigit(source[i]) || source[i] == '.') {
+				i++
+			}
+			if _, err := strconv.ParseFloat(source[start:i], 64); err != nil {
+				return nil, &Error{Offset: start, Message: fmt.Sprintf("malformed number '%s'", source[start:i])}
+			}
+			tokens = append(tokens, token{number, source[start:i], start})
+		case c == '\'' || c == '"':
+			start := i
+			var b strings.Builder
+			for i++; ; i++ {
+				if i >= len(source) {
+					return nil, &Error{Offset: start, Message: "unterminated string"}
+				}
+				if source[i] == c {
+					// A doubled quote stands for itself, as in SQL.
+					if i+1 < len(source) && source[i+1] == c {
+						b.WriteByte(c)
+						i++
+						continue
+					}
+					i++
+					break
+				}
+				b.WriteByte(source[i])
+			}
+			tokens = append(tokens, token{str, b.String(), start})
+		default:
+			op := ""
+			for _, candidate := range operators {
+				if strings.HasPrefix(source[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				return nil, &Error{Offset: i, Message: fmt.Sprintf("unexpected character %q", c)}
+			}
+			tokens = append(tokens, token{operator, op, i})
+			i += len(op)
+		}
+	}
+	return append(tokens, token{eof, "", len(source)}), nil
+}
+
+func isLetter(c byte) bool { return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' }
+func isDigit(c byte) bool  { return c >= '0' && c <= '9' }
+
+type parser struct {
+	tokens []token
+	next   int
+}
+
+func (p *parser) peek() token { return p.tokens[p.next] }
+
+func (p *parser) advance() token {
+	t := p.tokens[p.next]
+	if t.kind != eof {
+		p.next++
+	}
+	return t
+}
+
+// binaryOp returns the normalized operator t stands for, if it is one.
+func binaryOp(t token) (string, bool) {
+	text := t.text
+	switch t.kind {
+	case identifier:
+		text = strings.ToUpper(text)
+		if text != "AND" && text != "OR" {
+			return "", false
+		}
+	case operator:
+		switch text {
+		case "==":
+			text = "="
+		case "<>":
+			text = "!="
+		}
+	default:
+		return "", false
+	}
+	_, ok := binaryPrecedence[text]
+	return text, ok
+}
+
+// expression parses operators binding tighter than min by precedence
+// climbing.
+func (p *parser) expression(min int) (Expr, error) {
+	x, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		op, ok := binaryOp(t)
+		if !ok || binaryPrecedence[op] <= min {
+			return x, nil
+		}
+		p.advance()
+		y, err := p.expression(binaryPrecedence[op])
+		if err != nil {
+			return nil, err
+		}
+		x = &Binary{Op: op, X: x, Y: y, Pos: t.pos}
+	}
+}
+
+func (p *parser) unary() (Expr, error) {
+	t := p.peek()
+	switch {
+	case t.kind == operator && t.text == "-":
+		p.advance()
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &Unary{Op: "-", X: x, Pos: t.pos}, nil
+	case t.kind == identifier && strings.EqualFold(t.text, "not"):
+		p.advance()
+		x, err := p.expression(binaryPrecedence["AND"])
+		if err != nil {
+			return nil, err
+		}
+		return &Unary{Op: "NOT", X: x, Pos: t.pos}, nil
+	}
+	return p.primary()
+}
+
+func (p *parser) primary() (Expr, error) {
+	t := p.advance()
+	switch t.kind {
+	case number:
+		return &Literal{Kind: "number", Text: t.text, Pos: t.pos}, nil
+	case str:
+		return &Literal{Kind: "string", Text: t.text, Pos: t.pos}, nil
+	case operator:
+		if t.text != "(" {
+			break
+		}
+		e, err := p.expression(0)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case identifier:
+		switch strings.ToLower(t.text) {
+		case "true", "false":
+			return &Literal{Kind: "boolean", Text: strings.ToLower(t.text), Pos: t.pos}, nil
+		case "null":
+			return &Literal{Kind: "null", Text: "null", Pos: t.pos}, nil
+		case "and", "or", "not":
+			return nil, &Error{Offset: t.pos, Message: fmt.Sprintf("unexpected %s", t)}
+		}
+		if next := p.peek(); next.kind == operator && next.text == "(" {
+			p.advance()
+			return p.call(t)
+		}
+		path := &Path{Names: []string{t.text}, Pos: t.pos}
+		for next := p.peek(); next.kind == operator && next.text == "."; next = p.peek() {
+			p.advance()
+			name := p.advance()
+			if name.kind != identifier {
+				return nil, &Error{Offset: name.pos, Message: fmt.Sprintf("expected a field name after '.', found %s", name)}
+			}
+			path.Names = append(path.Names, name.text)
+		}
+		return path, nil
+	}
+	return nil, &Error{Offset: t.pos, Message: fmt.Sprintf("unexpected %s", t)}
+}
+
+// call parses the arguments of a call to name, whose '(' has been read.
+func (p *parser) call(name token) (Expr, error) {
+	c := &Call{Name: strings.ToUpper(name.text), Pos: name.pos}
+	if t := p.peek(); t.kind == operator && t.text == "*" && strings.EqualFold(name.text, "count") {
+		p.advance()
+		c.Star = true
+		return c, p.expect(")")
+	}
+	if t := p.peek(); t.kind == operator && t.text == ")" {
+		p.advance()
+		return c, nil
+	}
+	for {
+		arg, err := p.expression(0)
+		if err != nil {
+			return nil, err
+		}
+		c.Args = append(c.Args, arg)
+		t := p.advance()
+		if t.kind == operator && t.text == ")" {
+			return c, nil
+		}
+		if t.kind != operator || t.text != "," {
+			return nil, &Error{Offset: t.pos, Message: fmt.Sprintf("expected ',' or ')', found %s", t)}
+		}
+	}
+}
+
+func (p *parser) expect(text string) error {
+	t := p.advance()
+	if t.kind != operator || t.text != text {
+		return &Error{Offset: t.pos, Message: fmt.Sprintf("expected '%s', found %s", text, t)}
+	}
+	return nil
+}
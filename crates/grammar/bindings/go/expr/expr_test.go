@@ -0,0 +1,83 @@
+package expr_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/larner-dev/cdm/bindings/go/expr"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		source string
+		want   string
+	}{
+		{"avg(reviews.rating)", "AVG(reviews.rating)"},
+		{"count(*)", "COUNT(*)"},
+		{"price * quantity - discount", "price * quantity - discount"},
+		{"price * (quantity - discount)", "price * (quantity - discount)"},
+		{"a - (b - c)", "a - (b - c)"},
+		{`first_name || " " || last_name`, "first_name || ' ' || last_name"},
+		{"stock > 0 and not archived or featured == true", "stock > 0 AND NOT archived OR featured = true"},
+		{"not a = b", "NOT (a = b)"},
+		{"-price <> 3.5", "-price != 3.5"},
+		{"coalesce(nickname, 'it''s', null)", "COALESCE(nickname, 'it''s', null)"},
+		{"now()", "NOW()"},
+	}
+	for _, c := range cases {
+		e, err := expr.Parse(c.source)
+		if err != nil {
+			t.Errorf("Parse(%q) returned error: %v", c.source, err)
+			continue
+		}
+		if got := e.String(); got != c.want {
+			t.Errorf("Parse(%q) = %s, want %s", c.source, got, c.want)
+		}
+		// The normalized form parses to itself.
+		if again, err := expr.Parse(e.String()); err != nil || again.String() != c.want {
+			t.Errorf("Parse(%q) = %v, %v", e.String(), again, err)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := []struct {
+		source string
+		want   string
+	}{
+		{"", "unexpected end of expression at byte 0"},
+		{"AVG(rating", "expected ',' or ')', found end of expression at byte 10"},
+		{"a +", "unexpected end of expression at byte 3"},
+		{"author.", "expected a field name after '.', found end of expression at byte 7"},
+		{"'open", "unterminated string at byte 0"},
+		{"a # b", "unexpected character '#' at byte 2"},
+		{"a b", "unexpected 'b' at byte 2"},
+		{"1.2.3", "malformed number '1.2.3' at byte 0"},
+	}
+	for _, c := range cases {
+		_, err := expr.Parse(c.source)
+		if err == nil || err.Error() != c.want {
+			t.Errorf("Parse(%q) error = %v, want %s", c.source, err, c.want)
+		}
+	}
+}
+
+func TestWalk(t *testing.T) {
+	e, err := expr.Parse("SUM(items.price * items.quantity) / count(*) + fee")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	var paths []string
+	expr.Walk(e, func(e expr.Expr, aggregate *expr.Call) {
+		if path, ok := e.(*expr.Path); ok {
+			name := "-"
+			if aggregate != nil {
+				name = aggregate.Name
+			}
+			paths = append(paths, path.String()+" "+name)
+		}
+	})
+	if got, want := strings.Join(paths, ", "), "items.price SUM, items.quantity SUM, fee -"; got != want {
+		t.Errorf("paths = %s, want %s", got, want)
+	}
+}
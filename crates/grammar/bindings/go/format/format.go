@@ -0,0 +1,240 @@
+// Package format lays out CDM source the way `cdm format` does: a blank line
+// between top-level items, each model member on its own line indented by two
+// spaces, and single spaces around `:`, `=`, `|` and `extends`. Comments on
+// the lines directly above an item stay attached to it, and a comment after
+// an item on its last line stays on that line.
+//
+// Only type aliases, models, fields and the type expressions in them are laid
+// out. Directives, projections, plugin configs, default values and inline
+// object types keep their source text, as do definitions with a comment
+// inside them, so formatting never moves a comment to a different node.
+package format
+
+import (
+	"errors"
+	"strings"
+
+	tree_sitter_cdm "github.com/larner-dev/cdm/bindings/go"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// Indent is the indentation of model members.
+const Indent = "  "
+
+// Source returns source formatted. Source with syntax errors is returned
+// unchanged with an error, since the text around an error cannot be laid
+// out without the risk of changing what it means.
+func Source(source []byte) ([]byte, error) {
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	if err := parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_cdm.Language())); err != nil {
+		return source, err
+	}
+
+	tree := parser.Parse(source, nil)
+	defer tree.Close()
+	root := tree.RootNode()
+	if root.HasError() {
+		return source, errors.New("source has syntax errors")
+	}
+
+	f := formatter{source: source}
+	var b strings.Builder
+	var previous *tree_sitter.Node
+	for i := uint(0); i < root.NamedChildCount(); i++ {
+		node := root.NamedChild(i)
+		sep := ""
+		if previous != nil {
+			sep = separator(previous, node)
+			b.WriteString(sep)
+		}
+		switch node.Kind() {
+		case "type_alias":
+			b.WriteString(f.typeAlias(node))
+		case "model_definition":
+			b.WriteString(f.model(node))
+		default:
+			b.WriteString(f.text(node))
+		}
+		// A comment after an item is spaced from what follows as the item is.
+		if sep != " " {
+			previous = node
+		}
+	}
+	if previous == nil {
+		return nil, nil
+	}
+	return []byte(b.String() + "\n"), nil
+}
+
+// separator returns the whitespace between two consecutive top-level items:
+// a space before a comment on the line the previous item ends on, a line
+// break after a comment on the line directly above the next item, and a
+// blank line otherwise.
+func separator(previous, next *tree_sitter.Node) string {
+	end := previous.EndPosition().Row
+	switch {
+	case next.Kind() == "comment" && next.StartPosition().Row == end:
+		return " "
+	case previous.Kind() == "comment" && next.StartPosition().Row == end+1:
+		return "\n"
+	}
+	return "\n\n"
+}
+
+type formatter struct {
+	source []byte
+}
+
+func (f *formatter) text(node *tree_sitter.Node) string {
+	if node == nil {
+		return ""
+	}
+	return node.Utf8Text(f.source)
+}
+
+// hasComment reports whether a comment is a child of node. Comments nested
+// in children that keep their source text are kept with them.
+func hasComment(node *tree_sitter.Node) bool {
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		if node.NamedChild(i).Kind() == "comment" {
+			return true
+		}
+	}
+	return false
+}
+
+// suffix formats the optional parts that follow a definition: its plugin
+// block and entity ID.
+func (f *formatter) suffix(node *tree_sitter.Node) string {
+	var b strings.Builder
+	if plugins := node.ChildByFieldName("plugins"); plugins != nil {
+		b.WriteString(" " + f.text(plugins))
+	}
+	if id := node.ChildByFieldName("id"); id != nil {
+		b.WriteString(" #" + strings.TrimSpace(strings.TrimPrefix(f.text(id), "#")))
+	}
+	return b.String()
+}
+
+func (f *formatter) typeAlias(node *tree_sitter.Node) string {
+	t, ok := f.typeExpr(node.ChildByFieldName("type"))
+	if !ok || hasComment(node) {
+		return f.text(node)
+	}
+	return f.text(node.ChildByFieldName("name")) + ": " + t + f.suffix(node)
+}
+
+func (f *formatter) model(node *tree_sitter.Node) string {
+	if hasComment(node) {
+		return f.text(node)
+	}
+	var b strings.Builder
+	b.WriteString(f.text(node.ChildByFieldName("name")))
+
+	if parameters := node.ChildByFieldName("parameters"); parameters != nil {
+		if hasComment(parameters) {
+			return f.text(node)
+		}
+		b.WriteString("<" + strings.Join(f.fieldTexts(parameters, "parameter"), ", ") + ">")
+	}
+	if extends := node.ChildByFieldName("extends"); extends != nil {
+		if hasComment(extends) {
+			return f.text(node)
+		}
+		b.WriteString(" extends " + strings.Join(f.fieldTexts(extends, "parent"), ", "))
+	}
+
+	b.WriteString(" {\n")
+	body := node.ChildByFieldName("body")
+	for i := uint(0); i < body.NamedChildCount(); i++ {
+		member := body.NamedChild(i)
+		if member.Kind() == "field_definition" {
+			b.WriteString(Indent + f.field(member) + "\n")
+		} else {
+			b.WriteString(Indent + f.text(member) + "\n")
+		}
+	}
+	b.WriteString("}")
+
+	if id := node.ChildByFieldName("id"); id != nil {
+		b.WriteString(" #" + strings.TrimSpace(strings.TrimPrefix(f.text(id), "#")))
+	}
+	return b.String()
+}
+
+func (f *formatter) field(node *tree_sitter.Node) string {
+	if hasComment(node) {
+		return f.text(node)
+	}
+	var b strings.Builder
+	b.WriteString(f.text(node.ChildByFieldName("name")))
+	if node.ChildByFieldName("optional") != nil {
+		b.WriteString("?")
+	}
+	if typeNode := node.ChildByFieldName("type"); typeNode != nil {
+		t, ok := f.typeExpr(typeNode)
+		if !ok {
+			return f.text(node)
+		}
+		b.WriteString(": " + t)
+		if value := node.ChildByFieldName("default"); value != nil {
+			b.WriteString(" = " + f.text(value))
+		}
+	}
+	return b.String() + f.suffix(node)
+}
+
+// typeExpr formats a type expression. It reports false when the expression
+// has a comment inside it.
+func (f *formatter) typeExpr(node *tree_sitter.Node) (string, bool) {
+	if hasComment(node) {
+		return "", false
+	}
+	switch node.Kind() {
+	case "union_type", "key_union_type":
+		var members []string
+		for i := uint(0); i < node.NamedChildCount(); i++ {
+			member, ok := f.typeExpr(node.NamedChild(i))
+			if !ok {
+				return "", false
+			}
+			members = append(members, member)
+		}
+		return strings.Join(members, " | "), true
+	case "array_type":
+		element, ok := f.typeExpr(node.NamedChild(0))
+		return element + "[]", ok
+	case "map_type":
+		valueType, ok := f.typeExpr(node.ChildByFieldName("value_type"))
+		if !ok {
+			return "", false
+		}
+		keyType, ok := f.typeExpr(node.ChildByFieldName("key_type"))
+		return valueType + "[" + keyType + "]", ok
+	case "generic_type":
+		cursor := node.Walk()
+		defer cursor.Close()
+		var arguments []string
+		for _, argument := range node.ChildrenByFieldName("argument", cursor) {
+			t, ok := f.typeExpr(&argument)
+			if !ok {
+				return "", false
+			}
+			arguments = append(arguments, t)
+		}
+		return f.text(node.ChildByFieldName("name")) + "<" + strings.Join(arguments, ", ") + ">", true
+	}
+	return f.text(node), true
+}
+
+// fieldTexts returns the source text of each child of node in field.
+func (f *formatter) fieldTexts(node *tree_sitter.Node, field string) []string {
+	cursor := node.Walk()
+	defer cursor.Close()
+	var texts []string
+	for _, child := range node.ChildrenByFieldName(field, cursor) {
+		texts = append(texts, f.text(&child))
+	}
+	return texts
+}
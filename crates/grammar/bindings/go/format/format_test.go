@@ -0,0 +1,68 @@
+package format_test
+
+import (
+	"testing"
+
+	"github.com/larner-dev/cdm/bindings/go/format"
+)
+
+func TestSource(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{
+			name:   "spacing",
+			source: "@sql { dialect: \"postgres\" }\nEmail:string   #1\nStatus:\"a\"|\"b\"|null #2\n\n\n\nUser   extends Base,Timestamped{\n      name ?:string=\"x\"   { @sql { type: \"TEXT\" } } #1\n  tags:string[] #2\n\n  scores :number[ \"a\"|\"b\" ] #3\n  -password\n@sql { table: \"users\" }\n}#  10\n",
+			want:   "@sql { dialect: \"postgres\" }\n\nEmail: string #1\n\nStatus: \"a\" | \"b\" | null #2\n\nUser extends Base, Timestamped {\n  name?: string = \"x\" { @sql { type: \"TEXT\" } } #1\n  tags: string[] #2\n  scores: number[\"a\" | \"b\"] #3\n  -password\n  @sql { table: \"users\" }\n} #10\n",
+		},
+		{
+			name:   "generics",
+			source: "Page< T,U >{\n  items:T[]\n  next ?: Page<T ,U>\n}\n\nFeed {\n  posts: Page<Post,string>\n  meta: { total: number }\n}\n",
+			want:   "Page<T, U> {\n  items: T[]\n  next?: Page<T, U>\n}\n\nFeed {\n  posts: Page<Post, string>\n  meta: { total: number }\n}\n",
+		},
+		{
+			name:   "comments",
+			source: "// Users\nUser {\n  // The name\n  name:string\n}\n",
+			want:   "// Users\nUser {\n  // The name\n  name: string\n}\n",
+		},
+		{
+			name:   "comment placement",
+			source: "// Models\n\n// Accounts\n// and logins\nAccount{\n  id:string\n}   // primary\nEmail:string\n// trailing\n",
+			want:   "// Models\n\n// Accounts\n// and logins\nAccount {\n  id: string\n} // primary\n\nEmail: string\n\n// trailing\n",
+		},
+		{
+			name:   "empty",
+			source: "\n\n",
+			want:   "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := format.Source([]byte(test.source))
+			if err != nil {
+				t.Fatalf("Source returned %v", err)
+			}
+			if string(got) != test.want {
+				t.Errorf("Source =\n%s\nwant\n%s", got, test.want)
+			}
+			again, err := format.Source(got)
+			if err != nil || string(again) != string(got) {
+				t.Errorf("formatting again gave\n%s (%v)", again, err)
+			}
+		})
+	}
+}
+
+func TestSourceSyntaxError(t *testing.T) {
+	source := []byte("User {\n  name: \n}\n")
+	got, err := format.Source(source)
+	if err == nil {
+		t.Fatal("Source returned no error")
+	}
+	if string(got) != string(source) {
+		t.Errorf("Source = %q; want the source unchanged", got)
+	}
+}
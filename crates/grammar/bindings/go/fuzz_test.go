@@ -0,0 +1,495 @@
+package tree_sitter_cdm_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tree_sitter_cdm "github.com/larner-dev/cdm/bindings/go"
+	"github.com/larner-dev/cdm/bindings/go/contexts"
+	"github.com/larner-dev/cdm/bindings/go/format"
+	"github.com/larner-dev/cdm/bindings/go/ledger"
+	"github.com/larner-dev/cdm/bindings/go/schema"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// Maximum time a single parse may take before the input is considered a hang.
+const parseTimeout = 2 * time.Second
+
+// Maximum time resolving and checking a context chain may take.
+const resolveTimeout = 10 * time.Second
+
+// Spec documents whose ```cdm code blocks seed the fuzz corpus, relative to
+// this package directory.
+var specDocuments = []string{
+	"../../../../specs/spec.md",
+	"../../../../specs/templates.md",
+	"../../../../specs/plugins.md",
+}
+
+// Inputs that have tripped up the parser before: CRLF newlines and escape
+// sequences inside string literals.
+var edgeCaseSeeds = []string{
+	"User {\r\n  name: string #1\r\n} #10\r\n",
+	"Email: string\r\n\r\nUser {\r\n  email: Email\r\n}\r\n",
+	"@sql {\r\n  dialect: \"postgres\",\r\n  schema: \"public\"\r\n}\r\n",
+	"Status: \"a\\\"b\" | \"c\\\\d\" | \"\\/\"\n",
+	"Quote: \"\\b\\f\\n\\r\\t\"\n",
+	"Smile: \"\\ud83d\\ude00\"\n",
+	"Bad: \"\\x\"\n",
+	"Unterminated: \"abc\n",
+	"User {\n  bio: string = \"line1\\nline2\" { @sql { comment: \"\\u00e9\" } }\n}\n",
+}
+
+// specExamples extracts every ```cdm fenced block from the spec documents.
+// Missing documents are skipped so the fuzz targets still run from a partial
+// checkout.
+func specExamples(tb testing.TB) []string {
+	tb.Helper()
+
+	var examples []string
+	for _, doc := range specDocuments {
+		content, err := os.ReadFile(filepath.FromSlash(doc))
+		if err != nil {
+			continue
+		}
+
+		var block []string
+		inBlock := false
+		for _, line := range strings.Split(string(content), "\n") {
+			trimmed := strings.TrimSpace(line)
+			switch {
+			case !inBlock && trimmed == "```cdm":
+				inBlock = true
+				block = block[:0]
+			case inBlock && trimmed == "```":
+				inBlock = false
+				examples = append(examples, strings.Join(block, "\n")+"\n")
+			case inBlock:
+				block = append(block, line)
+			}
+		}
+	}
+	return examples
+}
+
+// addSeeds seeds f with the spec examples and the edge cases.
+func addSeeds(f *testing.F) {
+	for _, example := range specExamples(f) {
+		f.Add(example)
+	}
+	for _, seed := range edgeCaseSeeds {
+		f.Add(seed)
+	}
+}
+
+func newParser(tb testing.TB) *tree_sitter.Parser {
+	tb.Helper()
+
+	parser := tree_sitter.NewParser()
+	if err := parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_cdm.Language())); err != nil {
+		tb.Fatalf("Error loading Contextual Data Models grammar: %v", err)
+	}
+	return parser
+}
+
+// parseWithDeadline parses source, failing the test if the parser does not
+// finish within parseTimeout. The parse runs on its own goroutine so a hang
+// is reported instead of stalling the fuzz worker.
+func parseWithDeadline(tb testing.TB, parser *tree_sitter.Parser, source []byte) *tree_sitter.Tree {
+	tb.Helper()
+
+	done := make(chan *tree_sitter.Tree, 1)
+	go func() {
+		done <- parser.Parse(source, nil)
+	}()
+
+	select {
+	case tree := <-done:
+		if tree == nil {
+			tb.Fatalf("parser returned no tree for input %q", source)
+		}
+		return tree
+	case <-time.After(parseTimeout):
+		tb.Fatalf("parser did not finish within %v for input %q", parseTimeout, source)
+		return nil
+	}
+}
+
+// checkRanges verifies that every child lies within its parent and that
+// siblings appear in source order.
+func checkRanges(tb testing.TB, node *tree_sitter.Node, sourceLen uint) {
+	tb.Helper()
+
+	start, end := node.ByteRange()
+	if start > end || end > sourceLen {
+		tb.Fatalf("node %s has invalid range %d..%d (source length %d)", node.Kind(), start, end, sourceLen)
+	}
+
+	prevEnd := start
+	for i := uint(0); i < node.ChildCount(); i++ {
+		child := node.Child(i)
+		childStart, childEnd := child.ByteRange()
+		if childStart < prevEnd || childEnd > end {
+			tb.Fatalf(
+				"child %s at %d..%d is outside parent %s at %d..%d or overlaps its previous sibling",
+				child.Kind(), childStart, childEnd, node.Kind(), start, end,
+			)
+		}
+		prevEnd = childEnd
+		checkRanges(tb, child, sourceLen)
+	}
+}
+
+// FuzzParse checks that arbitrary input never crashes or hangs the parser and
+// that the resulting tree is well formed. Run it with:
+//
+//	go test -run '^$' -fuzz FuzzParse ./bindings/go
+//
+// Failing inputs are minimized into testdata/fuzz/FuzzParse; check them in so
+// they keep running as regression cases.
+func FuzzParse(f *testing.F) {
+	addSeeds(f)
+
+	parser := newParser(f)
+	defer parser.Close()
+
+	f.Fuzz(func(t *testing.T, source string) {
+		tree := parseWithDeadline(t, parser, []byte(source))
+		defer tree.Close()
+
+		root := tree.RootNode()
+		checkRanges(t, root, uint(len(source)))
+
+		// The grammar treats `\r\n` exactly like `\n`, so converting an
+		// error-free LF source to CRLF must produce the same tree shape.
+		if root.HasError() || strings.Contains(source, "\r") {
+			return
+		}
+		crlf := bytes.ReplaceAll([]byte(source), []byte("\n"), []byte("\r\n"))
+		crlfTree := parseWithDeadline(t, parser, crlf)
+		defer crlfTree.Close()
+
+		crlfRoot := crlfTree.RootNode()
+		if crlfRoot.HasError() {
+			t.Fatalf("CRLF variant failed to parse: %s", crlfRoot.ToSexp())
+		}
+		if got, want := crlfRoot.ToSexp(), root.ToSexp(); got != want {
+			t.Fatalf("CRLF variant parsed differently\n got: %s\nwant: %s", got, want)
+		}
+	})
+}
+
+// syntaxShape describes a tree in a form that layout does not change: its
+// S-expression followed by the text of every token that is not whitespace.
+func syntaxShape(tree *tree_sitter.Tree, source []byte) string {
+	var b strings.Builder
+	b.WriteString(tree.RootNode().ToSexp())
+
+	cursor := tree.Walk()
+	defer cursor.Close()
+	for {
+		node := cursor.Node()
+		if node.ChildCount() == 0 {
+			if text := node.Utf8Text(source); strings.TrimSpace(text) != "" {
+				b.WriteString("\n" + text)
+			}
+		}
+		if cursor.GotoFirstChild() {
+			continue
+		}
+		for !cursor.GotoNextSibling() {
+			if !cursor.GotoParent() {
+				return b.String()
+			}
+		}
+	}
+}
+
+// FuzzFormat checks that formatting is idempotent: formatting formatted
+// source changes nothing. Source with syntax errors must be refused.
+//
+//	go test -run '^$' -fuzz FuzzFormat ./bindings/go
+func FuzzFormat(f *testing.F) {
+	addSeeds(f)
+
+	parser := newParser(f)
+	defer parser.Close()
+
+	f.Fuzz(func(t *testing.T, source string) {
+		tree := parseWithDeadline(t, parser, []byte(source))
+		defer tree.Close()
+
+		formatted, err := format.Source([]byte(source))
+		if tree.RootNode().HasError() {
+			if err == nil {
+				t.Fatalf("Source formatted input with syntax errors")
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("Source returned %v for input without syntax errors", err)
+		}
+
+		again, err := format.Source(formatted)
+		if err != nil {
+			t.Fatalf("formatted source does not parse: %v\n%s", err, formatted)
+		}
+		if !bytes.Equal(again, formatted) {
+			t.Fatalf("formatting is not idempotent\nonce:\n%s\ntwice:\n%s", formatted, again)
+		}
+	})
+}
+
+// FuzzFormatReparse checks that formatted source parses to the same tree as
+// the original, with the same tokens: formatting only changes layout.
+//
+//	go test -run '^$' -fuzz FuzzFormatReparse ./bindings/go
+func FuzzFormatReparse(f *testing.F) {
+	addSeeds(f)
+
+	parser := newParser(f)
+	defer parser.Close()
+
+	f.Fuzz(func(t *testing.T, source string) {
+		tree := parseWithDeadline(t, parser, []byte(source))
+		defer tree.Close()
+		if tree.RootNode().HasError() {
+			return
+		}
+
+		formatted, err := format.Source([]byte(source))
+		if err != nil {
+			t.Fatalf("Source returned %v for input without syntax errors", err)
+		}
+		formattedTree := parseWithDeadline(t, parser, formatted)
+		defer formattedTree.Close()
+
+		if got, want := syntaxShape(formattedTree, formatted), syntaxShape(tree, []byte(source)); got != want {
+			t.Fatalf("formatted source parsed differently\n got: %s\nwant: %s\nformatted:\n%s", got, want, formatted)
+		}
+	})
+}
+
+// duplicateIDs counts the entity IDs that files, resolved from api.cdm,
+// give to more than one definition (E501, E502).
+func duplicateIDs(files map[string]string) int {
+	load := func(path string) (*schema.Schema, error) {
+		text, ok := files[path]
+		if !ok {
+			return nil, os.ErrNotExist
+		}
+		s, _ := schema.Parse([]byte(text))
+		return s, nil
+	}
+
+	_, diagnostics := contexts.Resolve("api.cdm", load)
+	for _, text := range files {
+		s, _ := schema.Parse([]byte(text))
+		diagnostics = append(diagnostics, s.Check()...)
+	}
+	count := 0
+	for _, d := range diagnostics {
+		if d.Code == schema.CodeDuplicateEntityID || d.Code == schema.CodeDuplicateFieldID {
+			count++
+		}
+	}
+	return count
+}
+
+// FuzzAssignIDs checks that assigning IDs never hands out an ID twice, either
+// within the ledger or in the files: a base file and a context extending it.
+//
+//	go test -run '^$' -fuzz FuzzAssignIDs ./bindings/go
+func FuzzAssignIDs(f *testing.F) {
+	for _, example := range specExamples(f) {
+		f.Add(example, "")
+	}
+	f.Add("Email: string #1\n\nUser {\n  email: Email #1\n}\n\nPost {\n  title: string\n}\n",
+		"User {\n  handle: string\n}\n\nSession {\n  token: string #1\n}\n")
+
+	f.Fuzz(func(t *testing.T, base, child string) {
+		files := map[string]string{
+			"base.cdm": base,
+			"api.cdm":  "extends \"./base.cdm\"\n\n" + child,
+		}
+		l, err := ledger.Read(strings.NewReader(""))
+		if err != nil {
+			t.Fatal(err)
+		}
+		p := &ledger.Project{
+			Paths: []string{"api.cdm"},
+			ReadFile: func(path string) ([]byte, error) {
+				text, ok := files[path]
+				if !ok {
+					return nil, os.ErrNotExist
+				}
+				return []byte(text), nil
+			},
+		}
+
+		before := duplicateIDs(files)
+		a, _ := p.Assign(l, "")
+		if a == nil {
+			return
+		}
+
+		allocated := map[string]bool{}
+		for _, e := range a.Entries {
+			if e.Op != ledger.Allocate {
+				continue
+			}
+			key := fmt.Sprintf("%s #%d", e.Scope, e.ID)
+			if allocated[key] {
+				t.Fatalf("Assign allocated %s twice", key)
+			}
+			allocated[key] = true
+		}
+
+		for path, text := range a.Files {
+			files[path] = string(text)
+		}
+		if after := duplicateIDs(files); after > before {
+			t.Fatalf("Assign added %d duplicate IDs\nbase.cdm:\n%s\napi.cdm:\n%s", after-before, files["base.cdm"], files["api.cdm"])
+		}
+	})
+}
+
+// FuzzResolve checks that resolving and checking a context chain terminates
+// on any input, including cyclic model inheritance, and that two files
+// extending each other are reported as E301. The input is the body of both
+// files.
+//
+//	go test -run '^$' -fuzz FuzzResolve ./bindings/go
+func FuzzResolve(f *testing.F) {
+	addSeeds(f)
+	f.Add("A extends B {\n  a: string\n}\n\nB extends A {\n  b: string\n}\n")
+	f.Add("Tree: Node | string\nNode: Tree[]\n")
+	f.Add("Page<T> extends Page<T> {\n  items: T[]\n}\n")
+
+	f.Fuzz(func(t *testing.T, source string) {
+		files := map[string]string{
+			"a.cdm": "extends \"./b.cdm\"\n" + source,
+			"b.cdm": "extends \"./a.cdm\"\n" + source,
+		}
+		extends := map[string]string{"a.cdm": "./b.cdm", "b.cdm": "./a.cdm"}
+		// Whether both files' first directive survives the input
+		cyclic := true
+		load := func(path string) (*schema.Schema, error) {
+			text, ok := files[path]
+			if !ok {
+				return nil, os.ErrNotExist
+			}
+			s, _ := schema.Parse([]byte(text))
+			if len(s.Extends) == 0 || s.Extends[0].Source != extends[path] {
+				cyclic = false
+			}
+			return s, nil
+		}
+
+		type result struct {
+			diagnostics []schema.Diagnostic
+			// Whether the resolved schema has a cycle of models or aliases
+			circular bool
+		}
+		done := make(chan result, 1)
+		go func() {
+			var r result
+			c, diagnostics := contexts.Resolve("a.cdm", load)
+			if c != nil {
+				r.circular = hasDefinitionCycle(c.Schema)
+				diagnostics = append(diagnostics, c.Schema.Check()...)
+			}
+			r.diagnostics = diagnostics
+			done <- r
+		}()
+
+		select {
+		case r := <-done:
+			if cyclic && !hasCode(r.diagnostics, schema.CodeCircularExtends) {
+				t.Fatalf("circular extends chain was not reported; diagnostics: %v", r.diagnostics)
+			}
+			if r.circular && !hasCode(r.diagnostics, schema.CodeCircularInheritance) {
+				t.Fatalf("circular inheritance or alias reference was not reported for input %q; diagnostics: %v", source, r.diagnostics)
+			}
+		case <-time.After(resolveTimeout):
+			t.Fatalf("resolving did not finish within %v for input %q", resolveTimeout, source)
+		}
+	})
+}
+
+func hasCode(diagnostics []schema.Diagnostic, code string) bool {
+	for _, d := range diagnostics {
+		if d.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// hasDefinitionCycle reports whether a declared model of s extends itself or
+// a type alias refers to itself. Projections are left out, since Check
+// relinks them to their sources.
+func hasDefinitionCycle(s *schema.Schema) bool {
+	next := map[string][]string{}
+	for _, model := range s.Models {
+		if _, ok := next[model.Name]; ok || model.Projection != nil || model.Instance != nil || model.InlineOf != nil {
+			continue
+		}
+		next[model.Name] = []string{}
+		for _, parent := range model.Parents {
+			next[model.Name] = append(next[model.Name], parent.Name)
+		}
+	}
+	aliases := map[string][]string{}
+	for _, alias := range s.TypeAliases {
+		if _, ok := aliases[alias.Name]; ok || alias.Type == nil {
+			continue
+		}
+		aliases[alias.Name] = []string{}
+		alias.Type.Walk(func(t *schema.TypeExpr) {
+			if t.Kind == schema.Identifier && !t.Inline {
+				aliases[alias.Name] = append(aliases[alias.Name], t.Name)
+			}
+		})
+	}
+
+	for _, graph := range []map[string][]string{next, aliases} {
+		for start := range graph {
+			seen := map[string]bool{}
+			queue := append([]string(nil), graph[start]...)
+			for len(queue) > 0 {
+				name := queue[0]
+				queue = queue[1:]
+				if name == start {
+					return true
+				}
+				if !seen[name] {
+					seen[name] = true
+					queue = append(queue, graph[name]...)
+				}
+			}
+		}
+	}
+	return false
+}
+
+func TestSpecExamplesParse(t *testing.T) {
+	examples := specExamples(t)
+	if len(examples) == 0 {
+		t.Skip("spec documents not available")
+	}
+
+	parser := newParser(t)
+	defer parser.Close()
+
+	for _, example := range examples {
+		tree := parseWithDeadline(t, parser, []byte(example))
+		checkRanges(t, tree.RootNode(), uint(len(example)))
+		tree.Close()
+	}
+}
@@ -0,0 +1,371 @@
+// Package imports resolves selective template imports, such as
+//
+//	import { User, Email as AuthEmail } from "cdm/auth"
+//
+// A selective import brings only the listed definitions into scope, under
+// their alias when one is given, rather than the whole template under a
+// namespace. The definitions the listed ones are built from, such as the
+// aliases a model's fields use, are resolved as well, following the
+// template's own imports, so a generator can emit them without the rest of
+// the template. They stay out of scope in the importing file.
+package imports
+
+import (
+	"strings"
+
+	"github.com/larner-dev/cdm/bindings/go/position"
+	"github.com/larner-dev/cdm/bindings/go/schema"
+	"github.com/larner-dev/cdm/bindings/go/value"
+)
+
+// Loader returns the parsed entry file of the template an import names, as
+// written in the directive, such as "cdm/auth" or "./templates/shared".
+type Loader func(source string) (*schema.Schema, error)
+
+// Definition is a type alias, model or generic model defined by a template.
+type Definition struct {
+	// Source of the template that defines it
+	Source string
+	Name   string
+	// Exactly one of TypeAlias and Model is set; generic models are Models.
+	TypeAlias *schema.TypeAlias
+	Model     *schema.Model
+}
+
+// Symbol is a definition brought into scope by a selective import.
+type Symbol struct {
+	// Name the importing file refers to the definition by
+	Local      string
+	Definition *Definition
+	Import     *schema.Import
+	Name       *schema.ImportName
+	// Used is set when the importing file references Local.
+	Used bool
+}
+
+// Scope holds the definitions a schema imports selectively.
+type Scope struct {
+	// Imported definitions, in the order they are listed
+	Symbols []*Symbol
+	// Definitions the symbols depend on, directly or transitively, that are
+	// not imported themselves, in the order they were found
+	Dependencies []*Definition
+}
+
+// Lookup returns the symbol imported under the name local, or nil.
+func (s *Scope) Lookup(local string) *Symbol {
+	for _, symbol := range s.Symbols {
+		if symbol.Local == local {
+			return symbol
+		}
+	}
+	return nil
+}
+
+// Resolve resolves the selective imports of s, loading each template once
+// with load; namespaced imports are left alone. It reports templates that
+// cannot be loaded (E601), templates that re-export a name from one
+// another in a cycle (E604), names imported twice or also defined in s
+// (E605), names a template does not export (E609) and imported names s never
+// references (W103).
+func Resolve(s *schema.Schema, load Loader) (*Scope, []schema.Diagnostic) {
+	r := &resolver{
+		load:      load,
+		templates: map[string]*loaded{},
+		found:     map[key]bool{},
+		cycles:    map[key]bool{},
+		scope:     &Scope{},
+	}
+
+	defined := map[string]bool{}
+	for _, name := range exported(s) {
+		defined[name] = true
+	}
+	for _, imp := range s.Imports {
+		if len(imp.Names) == 0 {
+			continue
+		}
+		template := r.template(imp.Source, imp.Span)
+		if template == nil {
+			continue
+		}
+		for _, name := range imp.Names {
+			definition := lookup(template, imp.Source, name.Name)
+			local := name.Local()
+			switch {
+			case definition == nil:
+				r.errorf(schema.CodeUnknownImport, name, "'%s' is not exported by template '%s'; it exports %s",
+					name.Name, imp.Source, list(exported(template)))
+			case defined[local]:
+				r.errorf(schema.CodeDuplicateImport, name, "'%s' is imported from '%s' and also defined in this file", local, imp.Source)
+			case r.scope.Lookup(local) != nil:
+				r.errorf(schema.CodeDuplicateImport, name, "'%s' is imported twice", local)
+			default:
+				r.scope.Symbols = append(r.scope.Symbols, &Symbol{Local: local, Definition: definition, Import: imp, Name: name})
+				r.found[key{definition.Source, definition.Name}] = true
+			}
+		}
+	}
+
+	for _, symbol := range r.scope.Symbols {
+		r.dependencies(symbol.Definition, symbol.Import)
+	}
+
+	references(s, func(name string) {
+		if symbol := r.scope.Lookup(name); symbol != nil {
+			symbol.Used = true
+		}
+	})
+	for _, symbol := range r.scope.Symbols {
+		if !symbol.Used {
+			r.diagnostics = append(r.diagnostics, schema.Warnf(schema.CodeUnusedImport, symbol.Name.Span,
+				"'%s' is imported from '%s' but never used", symbol.Local, symbol.Import.Source))
+		}
+	}
+	return r.scope, r.diagnostics
+}
+
+type key struct{ source, name string }
+
+type loaded struct {
+	schema *schema.Schema
+	err    error
+}
+
+type resolver struct {
+	load      Loader
+	templates map[string]*loaded
+	found     map[key]bool
+	// Names at which a re-export cycle was reported
+	cycles      map[key]bool
+	scope       *Scope
+	diagnostics []schema.Diagnostic
+}
+
+func (r *resolver) errorf(code string, name *schema.ImportName, format string, args ...any) {
+	r.diagnostics = append(r.diagnostics, schema.Errorf(code, name.Span, format, args...))
+}
+
+// template loads the template at source, reporting it at span the first
+// time it cannot be loaded.
+func (r *resolver) template(source string, span position.Span) *schema.Schema {
+	t, ok := r.templates[source]
+	if !ok {
+		t = &loaded{}
+		t.schema, t.err = r.load(source)
+		r.templates[source] = t
+		if t.err != nil {
+			r.diagnostics = append(r.diagnostics, schema.Errorf(schema.CodeTemplateNotFound, span,
+				"Template not found: '%s': %v", source, t.err))
+		}
+	}
+	return t.schema
+}
+
+// dependencies adds the definitions that definition references, and theirs
+// in turn, to the scope. Templates that cannot be loaded are reported at via,
+// the import that led to them.
+func (r *resolver) dependencies(definition *Definition, via *schema.Import) {
+	template := r.templates[definition.Source].schema
+	definitionReferences(definition, func(name string) {
+		dependency := r.resolveName(template, definition.Source, name, via, nil)
+		if dependency == nil {
+			return
+		}
+		k := key{dependency.Source, dependency.Name}
+		if r.found[k] {
+			return
+		}
+		r.found[k] = true
+		r.scope.Dependencies = append(r.scope.Dependencies, dependency)
+		r.dependencies(dependency, via)
+	})
+}
+
+// resolveName finds the definition name refers to inside template: one of
+// its own definitions, one it imports selectively, or `ns.Name` from a
+// template it imports under a namespace. Built-in types and names that
+// cannot be resolved give nil. chain holds the names already followed to
+// get here, so that templates importing a name from one another are
+// reported instead of followed forever.
+func (r *resolver) resolveName(template *schema.Schema, source, name string, via *schema.Import, chain []key) *Definition {
+	k := key{source, name}
+	for i, seen := range chain {
+		if seen != k {
+			continue
+		}
+		if !r.cycles[k] {
+			r.cycles[k] = true
+			sources := make([]string, 0, len(chain)-i+1)
+			for _, c := range chain[i:] {
+				sources = append(sources, c.source)
+			}
+			r.diagnostics = append(r.diagnostics, schema.Errorf(schema.CodeCircularTemplate, via.Span,
+				"Circular template dependency: '%s' is imported along %s -> %s", chain[i].name, strings.Join(sources, " -> "), source))
+		}
+		return nil
+	}
+	chain = append(chain, k)
+
+	namespace, rest, qualified := strings.Cut(name, ".")
+	if !qualified {
+		if definition := lookup(template, source, name); definition != nil {
+			return definition
+		}
+	}
+	for _, imp := range template.Imports {
+		var target string
+		switch {
+		case qualified && imp.Namespace == namespace:
+			target = rest
+		case !qualified:
+			for _, n := range imp.Names {
+				if n.Local() == name {
+					target = n.Name
+				}
+			}
+		}
+		if target == "" {
+			continue
+		}
+		imported := r.template(imp.Source, via.Span)
+		if imported == nil {
+			return nil
+		}
+		return r.resolveName(imported, imp.Source, target, via, chain)
+	}
+	return nil
+}
+
+// lookup returns the definition template exports as name, or nil. Models
+// Check generated for the template are not exported.
+func lookup(template *schema.Schema, source, name string) *Definition {
+	if alias := template.TypeAlias(name); alias != nil {
+		return &Definition{Source: source, Name: name, TypeAlias: alias}
+	}
+	if model := template.Model(name); model != nil && model.Instance == nil && model.InlineOf == nil {
+		return &Definition{Source: source, Name: name, Model: model}
+	}
+	if generic := template.Generic(name); generic != nil {
+		return &Definition{Source: source, Name: name, Model: generic}
+	}
+	return nil
+}
+
+// exported lists the names of the type aliases, models and generic models
+// s defines.
+func exported(s *schema.Schema) []string {
+	var names []string
+	for _, alias := range s.TypeAliases {
+		names = append(names, alias.Name)
+	}
+	for _, model := range s.Models {
+		if model.Instance == nil && model.InlineOf == nil {
+			names = append(names, model.Name)
+		}
+	}
+	for _, generic := range s.Generics {
+		names = append(names, generic.Name)
+	}
+	return names
+}
+
+func list(names []string) string {
+	if len(names) == 0 {
+		return "nothing"
+	}
+	return "'" + strings.Join(names, "', '") + "'"
+}
+
+// definitionReferences calls visit with every name definition refers to:
+// the names in its types, its parents and its projection source. Type
+// parameters of a generic model are not references.
+func definitionReferences(definition *Definition, visit func(name string)) {
+	if alias := definition.TypeAlias; alias != nil {
+		typeReferences(alias.Type, visit)
+		return
+	}
+	model := definition.Model
+	params := map[string]bool{}
+	for _, param := range model.Params {
+		params[param.Name] = true
+	}
+	modelReferences(model, func(name string) {
+		if !params[name] {
+			visit(name)
+		}
+	})
+}
+
+func modelReferences(model *schema.Model, visit func(name string)) {
+	for _, parent := range model.Parents {
+		visit(parent.Name)
+	}
+	if model.Projection != nil {
+		visit(model.Projection.Source.Name)
+	}
+	for _, field := range model.Fields {
+		if field.Type != nil {
+			typeReferences(field.Type, visit)
+		}
+	}
+}
+
+// typeReferences calls visit with every name in t, including the fields of
+// inline object types. An instantiation refers to its generic model.
+func typeReferences(t *schema.TypeExpr, visit func(name string)) {
+	t.Walk(func(t *schema.TypeExpr) {
+		switch {
+		case t.Kind != schema.Identifier:
+		case t.Inline:
+			for _, field := range t.Fields {
+				if field.Type != nil {
+					typeReferences(field.Type, visit)
+				}
+			}
+		case t.Generic != "":
+			visit(t.Generic)
+		default:
+			visit(t.Name)
+		}
+	})
+}
+
+// references calls visit with every name s refers to in its types, parents,
+// projection sources and plugin configs, such as `input: User`.
+func references(s *schema.Schema, visit func(name string)) {
+	configs := func(configs []*schema.Config) {
+		for _, config := range configs {
+			valueReferences(config.Value, visit)
+		}
+	}
+	for _, alias := range s.TypeAliases {
+		typeReferences(alias.Type, visit)
+		configs(alias.Configs)
+	}
+	for _, model := range append(append([]*schema.Model{}, s.Models...), s.Generics...) {
+		modelReferences(model, visit)
+		configs(model.Configs)
+		for _, field := range model.Fields {
+			configs(field.Configs)
+		}
+		for _, override := range model.Overrides {
+			configs(override.Configs)
+		}
+	}
+}
+
+func valueReferences(v value.Value, visit func(name string)) {
+	switch v.Kind {
+	case value.Reference:
+		visit(v.Text)
+	case value.Array:
+		for _, item := range v.Items {
+			valueReferences(item, visit)
+		}
+	case value.Object:
+		for _, entry := range v.Entries {
+			valueReferences(entry.Value, visit)
+		}
+	}
+}
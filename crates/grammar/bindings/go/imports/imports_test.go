@@ -0,0 +1,169 @@
+package imports_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/larner-dev/cdm/bindings/go/imports"
+	"github.com/larner-dev/cdm/bindings/go/schema"
+)
+
+func mustParse(t *testing.T, source string) *schema.Schema {
+	t.Helper()
+	s, diagnostics := schema.Parse([]byte(source))
+	if len(diagnostics) > 0 {
+		t.Fatalf("Parse returned diagnostics: %v", diagnostics)
+	}
+	return s
+}
+
+var templates = map[string]string{
+	"cdm/auth": `import sql from "sql/postgres-types"
+
+Email: string #1
+Role: "admin" | "user" #2
+
+User {
+  id: sql.UUID #1
+  email: Email #2
+  roles: Role[] #3
+} #10
+
+Session {
+  user: User #1
+  token: string #2
+} #11
+
+Cursor: string
+`,
+	"sql/postgres-types": `UUID: string {
+  @sql { type: "UUID" }
+} #1
+
+Text: string #2
+`,
+}
+
+// load loads the templates above. cdm/auth also gets the generic model
+// `Page<T> { items: T[], next: Cursor }`, set up directly like the imports.
+func load(t *testing.T) imports.Loader {
+	return func(source string) (*schema.Schema, error) {
+		text, ok := templates[source]
+		if !ok {
+			return nil, errors.New("no such template")
+		}
+		s := mustParse(t, text)
+		if source == "cdm/auth" {
+			s.Generics = append(s.Generics, &schema.Model{
+				Name:   "Page",
+				Params: []*schema.Reference{{Name: "T"}},
+				Fields: []*schema.Field{
+					{Name: "items", Type: &schema.TypeExpr{Kind: schema.Array, Element: schema.Named("T")}},
+					{Name: "next", Type: schema.Named("Cursor")},
+				},
+			})
+		}
+		return s, nil
+	}
+}
+
+// selective adds `import { names } from "source"` to s. Imports are set up
+// directly so these tests do not depend on the generated parser knowing
+// selective import syntax. Names are written `Name` or `Name as Alias`.
+func selective(s *schema.Schema, source string, names ...string) {
+	imp := &schema.Import{Source: source}
+	for _, name := range names {
+		original, alias, _ := strings.Cut(name, " as ")
+		imp.Names = append(imp.Names, &schema.ImportName{Name: original, Alias: alias})
+	}
+	s.Imports = append(s.Imports, imp)
+}
+
+func TestParseSelectiveImport(t *testing.T) {
+	s, diagnostics := schema.Parse([]byte("import { User, Email as AuthEmail } from \"cdm/auth\"\n\nPost {\n  author: User\n}\n"))
+	if len(diagnostics) > 0 {
+		t.Fatalf("parse: %v", diagnostics)
+	}
+	imp := s.Imports[0]
+	if imp.Source != "cdm/auth" || len(imp.Names) != 2 || imp.Names[1].Name != "Email" || imp.Names[1].Local() != "AuthEmail" {
+		t.Errorf("import = %+v", imp)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	s := mustParse(t, "Post {\n  author: User\n  contact: AuthEmail\n}\n")
+	selective(s, "cdm/auth", "User", "Email as AuthEmail")
+
+	scope, diagnostics := imports.Resolve(s, load(t))
+	if len(diagnostics) > 0 {
+		t.Fatalf("Resolve returned diagnostics: %v", diagnostics)
+	}
+	if symbol := scope.Lookup("AuthEmail"); symbol == nil || symbol.Definition.TypeAlias == nil || symbol.Definition.Name != "Email" {
+		t.Errorf("AuthEmail = %+v", symbol)
+	}
+	if scope.Lookup("Email") != nil || scope.Lookup("Session") != nil {
+		t.Error("names that were not listed are in scope")
+	}
+
+	// Email is imported already; User's other dependencies come along,
+	// including the alias from the template's own namespaced import.
+	var dependencies []string
+	for _, d := range scope.Dependencies {
+		dependencies = append(dependencies, d.Source+" "+d.Name)
+	}
+	if got, want := strings.Join(dependencies, ", "), "sql/postgres-types UUID, cdm/auth Role"; got != want {
+		t.Errorf("dependencies = %s, want %s", got, want)
+	}
+}
+
+func TestResolveGeneric(t *testing.T) {
+	s := mustParse(t, "Feed {\n  posts: string\n}\n")
+	s.Model("Feed").Field("posts").Type = &schema.TypeExpr{Kind: schema.Identifier, Name: "Page", Args: []*schema.TypeExpr{schema.Named("string")}}
+	selective(s, "cdm/auth", "Page")
+
+	scope, diagnostics := imports.Resolve(s, load(t))
+	if len(diagnostics) > 0 {
+		t.Fatalf("Resolve returned diagnostics: %v", diagnostics)
+	}
+	if len(scope.Dependencies) != 1 || scope.Dependencies[0].Name != "Cursor" {
+		t.Errorf("dependencies = %+v, want Cursor only; T is a type parameter", scope.Dependencies)
+	}
+}
+
+func TestResolveErrors(t *testing.T) {
+	s := mustParse(t, "Role: string\n\nPost {\n  author: User\n  owner: Owner\n}\n")
+	selective(s, "cdm/auth", "User", "Admin", "Role", "Session as User", "Email", "User as Owner")
+	selective(s, "cdm/missing", "Thing")
+
+	_, diagnostics := imports.Resolve(s, load(t))
+	var got []string
+	for _, d := range diagnostics {
+		got = append(got, d.Code+": "+d.Message)
+	}
+	want := []string{
+		"E609: 'Admin' is not exported by template 'cdm/auth'; it exports 'Email', 'Role', 'Cursor', 'User', 'Session', 'Page'",
+		"E605: 'Role' is imported from 'cdm/auth' and also defined in this file",
+		"E605: 'User' is imported twice",
+		"E601: Template not found: 'cdm/missing': no such template",
+		"W103: 'Email' is imported from 'cdm/auth' but never used",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("diagnostics =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestResolveCircularReexport(t *testing.T) {
+	cyclic := map[string]string{
+		"blog/a": "import { Tag } from \"blog/b\"\n\nPost {\n  tag: Tag\n}\n",
+		"blog/b": "import { Tag } from \"blog/a\"\n",
+	}
+	s := mustParse(t, "import { Post } from \"blog/a\"\n\nFeed {\n  latest: Post\n}\n")
+	_, diagnostics := imports.Resolve(s, func(source string) (*schema.Schema, error) {
+		return mustParse(t, cyclic[source]), nil
+	})
+	if len(diagnostics) != 1 || diagnostics[0].Code != schema.CodeCircularTemplate ||
+		diagnostics[0].Message != "Circular template dependency: 'Tag' is imported along blog/a -> blog/b -> blog/a" {
+		t.Errorf("diagnostics = %v", diagnostics)
+	}
+}
@@ -0,0 +1,354 @@
+// Package ledger maintains a project's entity ID ledger, the checked-in
+// record of every entity ID the project has handed out:
+//
+//	{"op":"reserve","scope":"local","team":"payments","from":100,"to":199}
+//	{"op":"allocate","scope":"local","id":10,"name":"User"}
+//	{"op":"allocate","scope":"#10","id":3,"name":"password_hash"}
+//	{"op":"delete","scope":"#10","id":3,"name":"password_hash"}
+//	{"op":"restore","scope":"#10","id":3,"name":"password_hash"}
+//
+// The ledger lives at .cdm/ids.json. It is append-only, one JSON entry per
+// line, and entries do not depend on their order, except that a restore
+// cancels only the deletes before it. Branches that each append entries
+// merge by keeping both sides' lines; the union merge driver does this
+// without conflicts, and Append selects it in a .gitattributes file next to
+// the ledger. Teams that reserve ranges of IDs allocate from their own
+// ranges and cannot collide on merge. Deleted IDs stay in the ledger so they
+// are never handed out again (E503).
+package ledger
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/larner-dev/cdm/bindings/go/schema"
+)
+
+// DefaultPath is where a project keeps its ledger, relative to its root.
+const DefaultPath = ".cdm/ids.json"
+
+// GlobalScope is the scope of local type alias and model IDs. Field IDs are
+// scoped by their model, named by its scoped ID, such as "#10" or
+// "cdm/auth:#10" for the fields a project adds to a template's model, or by
+// its name when it has no ID.
+const GlobalScope = "local"
+
+// Op is what a ledger entry records.
+type Op string
+
+const (
+	// Reserve sets aside the IDs From to To of a scope for a team.
+	Reserve Op = "reserve"
+	// Allocate records that an ID was given to an entity.
+	Allocate Op = "allocate"
+	// Delete records that the entity holding an ID was removed. The ID is
+	// never allocated again.
+	Delete Op = "delete"
+	// Restore cancels the deletes of an ID recorded before it, for an
+	// entity that was recorded as deleted by mistake.
+	Restore Op = "restore"
+)
+
+// Entry is one line of the ledger.
+type Entry struct {
+	Op    Op     `json:"op"`
+	Scope string `json:"scope"`
+	// Allocated, deleted or restored ID
+	ID schema.EntityID `json:"id,omitempty"`
+	// Name of the entity when the entry was written
+	Name string `json:"name,omitempty"`
+	// Team a range is reserved for, or that allocated an ID from its range
+	Team string          `json:"team,omitempty"`
+	From schema.EntityID `json:"from,omitempty"`
+	To   schema.EntityID `json:"to,omitempty"`
+}
+
+// Ledger is the content of a ledger file.
+type Ledger struct {
+	// File the ledger was loaded from; empty for one read from elsewhere
+	Path    string
+	Entries []*Entry
+	scopes  map[string]*Scope
+}
+
+// Scope is the state of one ID scope, folded from the ledger's entries.
+type Scope struct {
+	Name string
+	// Names each allocated ID was given to. More than one name means
+	// branches allocated the same ID to different entities.
+	Allocated map[schema.EntityID][]string
+	// Name of the entity each deleted ID belonged to
+	Deleted  map[schema.EntityID]string
+	Reserved []Range
+}
+
+// Range is a range of IDs reserved for a team, From to To inclusive.
+type Range struct {
+	Team     string
+	From, To schema.EntityID
+}
+
+func (r Range) contains(id schema.EntityID) bool { return id >= r.From && id <= r.To }
+
+// Read reads a ledger, one JSON entry per line. Blank lines are skipped.
+func Read(r io.Reader) (*Ledger, error) {
+	l := &Ledger{}
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		e := &Entry{}
+		if err := json.Unmarshal(text, e); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if err := e.validate(); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		l.Add(e)
+	}
+	return l, scanner.Err()
+}
+
+// Load reads the ledger at path. A missing file is an empty ledger.
+func Load(path string) (*Ledger, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &Ledger{Path: path}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	l, err := Read(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	l.Path = path
+	return l, nil
+}
+
+func (e *Entry) validate() error {
+	switch e.Op {
+	case Reserve:
+		if e.Team == "" || e.From < 1 || e.To < e.From {
+			return fmt.Errorf("reserve needs a team and a range from 1 or above")
+		}
+	case Allocate, Delete, Restore:
+		if e.ID < 1 {
+			return fmt.Errorf("%s needs an id of 1 or above", e.Op)
+		}
+	default:
+		return fmt.Errorf("unknown op %q; expected reserve, allocate, delete or restore", e.Op)
+	}
+	if e.Scope == "" {
+		return fmt.Errorf("%s needs a scope", e.Op)
+	}
+	return nil
+}
+
+// Add appends e to the ledger in memory. WriteEntries or Append save it.
+func (l *Ledger) Add(e *Entry) {
+	l.Entries = append(l.Entries, e)
+	s := l.Scope(e.Scope)
+	switch e.Op {
+	case Reserve:
+		r := Range{Team: e.Team, From: e.From, To: e.To}
+		for _, reserved := range s.Reserved {
+			if reserved == r {
+				return
+			}
+		}
+		s.Reserved = append(s.Reserved, r)
+	case Allocate:
+		for _, name := range s.Allocated[e.ID] {
+			if name == e.Name {
+				return
+			}
+		}
+		s.Allocated[e.ID] = append(s.Allocated[e.ID], e.Name)
+	case Delete:
+		s.Deleted[e.ID] = e.Name
+	case Restore:
+		delete(s.Deleted, e.ID)
+	}
+}
+
+// Restore returns the entry that restores the deleted id of scope and adds
+// it to the ledger.
+func (l *Ledger) Restore(scope string, id schema.EntityID) (*Entry, error) {
+	s := l.Scope(scope)
+	name, ok := s.Deleted[id]
+	if !ok {
+		return nil, fmt.Errorf("the ID ledger does not record %s as deleted", display(scope, id))
+	}
+	e := &Entry{Op: Restore, Scope: scope, ID: id, Name: name}
+	l.Add(e)
+	return e, nil
+}
+
+// Scope returns the scope named name, which is empty if the ledger has no
+// entries for it.
+func (l *Ledger) Scope(name string) *Scope {
+	if l.scopes == nil {
+		l.scopes = map[string]*Scope{}
+	}
+	s, ok := l.scopes[name]
+	if !ok {
+		s = &Scope{Name: name, Allocated: map[schema.EntityID][]string{}, Deleted: map[schema.EntityID]string{}}
+		l.scopes[name] = s
+	}
+	return s
+}
+
+// Scopes returns the names of the scopes the ledger has entries for, sorted.
+func (l *Ledger) Scopes() []string {
+	var names []string
+	for name := range l.scopes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Next returns the ID to allocate next in s. A team with reserved ranges in
+// s allocates from them, after the highest ID used in each; any other
+// allocation comes after the highest ID used outside all reserved ranges and
+// skips them. IDs are never reused, so deleted IDs count as used.
+func (s *Scope) Next(team string) (schema.EntityID, error) {
+	var ids []schema.EntityID
+	for id := range s.Allocated {
+		ids = append(ids, id)
+	}
+	for id := range s.Deleted {
+		ids = append(ids, id)
+	}
+	// used returns the highest ID used in r, or outside all ranges for nil.
+	used := func(r *Range) schema.EntityID {
+		var highest schema.EntityID
+		for _, id := range ids {
+			if id > highest && (r == nil && !s.reserved(id) || r != nil && r.contains(id)) {
+				highest = id
+			}
+		}
+		return highest
+	}
+
+	own := false
+	for i := range s.Reserved {
+		r := &s.Reserved[i]
+		if team == "" || r.Team != team {
+			continue
+		}
+		own = true
+		next := max(used(r)+1, r.From)
+		if next <= r.To {
+			return next, nil
+		}
+	}
+	if own {
+		return 0, fmt.Errorf("the ranges reserved for team '%s' in scope '%s' are used up", team, s.Name)
+	}
+
+	next := used(nil) + 1
+	for s.reserved(next) {
+		for _, r := range s.Reserved {
+			if r.contains(next) {
+				next = r.To + 1
+			}
+		}
+	}
+	return next, nil
+}
+
+// owns reports whether id is in a range reserved for team.
+func (s *Scope) owns(team string, id schema.EntityID) bool {
+	for _, r := range s.Reserved {
+		if r.Team == team && r.contains(id) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Scope) reserved(id schema.EntityID) bool {
+	for _, r := range s.Reserved {
+		if r.contains(id) {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteEntries writes entries in the ledger format, one per line.
+func WriteEntries(w io.Writer, entries []*Entry) error {
+	for _, e := range entries {
+		line, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(append(line, '\n')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Append appends entries to the ledger file at path, creating it and its
+// directory if needed. It also adds `ids.json merge=union` to the
+// .gitattributes file in the ledger's directory unless the file already
+// names a merge driver for the ledger.
+func Append(path string, entries []*Entry) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	if err := addMergeRule(path); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if err := WriteEntries(f, entries); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// addMergeRule selects the union merge driver for the ledger at path in the
+// .gitattributes file next to it.
+func addMergeRule(path string) error {
+	attributes := filepath.Join(filepath.Dir(path), ".gitattributes")
+	text, err := os.ReadFile(attributes)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	name := filepath.Base(path)
+	for _, line := range strings.Split(string(text), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || fields[0] != name {
+			continue
+		}
+		for _, attr := range fields[1:] {
+			if strings.HasPrefix(attr, "merge") || strings.HasPrefix(attr, "-merge") || strings.HasPrefix(attr, "!merge") {
+				return nil
+			}
+		}
+	}
+	if len(text) > 0 && text[len(text)-1] != '\n' {
+		text = append(text, '\n')
+	}
+	text = append(text, name+" merge=union\n"...)
+	return os.WriteFile(attributes, text, 0o644)
+}
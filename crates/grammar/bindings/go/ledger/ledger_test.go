@@ -0,0 +1,320 @@
+package ledger_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/larner-dev/cdm/bindings/go/ledger"
+	"github.com/larner-dev/cdm/bindings/go/schema"
+)
+
+func mustRead(t *testing.T, text string) *ledger.Ledger {
+	t.Helper()
+
+	l, err := ledger.Read(strings.NewReader(text))
+	if err != nil {
+		t.Fatalf("Read returned %v", err)
+	}
+	return l
+}
+
+// project serves files from memory.
+func project(files map[string]string, paths ...string) *ledger.Project {
+	return &ledger.Project{
+		Paths: paths,
+		ReadFile: func(path string) ([]byte, error) {
+			text, ok := files[path]
+			if !ok {
+				return nil, os.ErrNotExist
+			}
+			return []byte(text), nil
+		},
+	}
+}
+
+func messages(diagnostics []ledger.Diagnostic) string {
+	var out []string
+	for _, d := range diagnostics {
+		out = append(out, d.Path+": "+d.Code+": "+d.Message)
+	}
+	return strings.Join(out, "\n")
+}
+
+func TestRead(t *testing.T) {
+	l := mustRead(t, `{"op":"reserve","scope":"local","team":"payments","from":100,"to":199}
+{"op":"allocate","scope":"local","id":10,"name":"User"}
+
+{"op":"allocate","scope":"local","id":10,"name":"User"}
+{"op":"allocate","scope":"#10","id":3,"name":"password_hash"}
+{"op":"delete","scope":"#10","id":3,"name":"password_hash"}
+`)
+
+	if got := strings.Join(l.Scopes(), " "); got != "#10 local" {
+		t.Errorf("Scopes = %s", got)
+	}
+	local := l.Scope(ledger.GlobalScope)
+	if names := local.Allocated[10]; len(names) != 1 || names[0] != "User" {
+		t.Errorf("Allocated[10] = %v; a line repeated by a merge should count once", names)
+	}
+	if len(local.Reserved) != 1 || local.Reserved[0] != (ledger.Range{Team: "payments", From: 100, To: 199}) {
+		t.Errorf("Reserved = %v", local.Reserved)
+	}
+	if l.Scope("#10").Deleted[3] != "password_hash" {
+		t.Errorf("Deleted = %v", l.Scope("#10").Deleted)
+	}
+
+	// A restore cancels the deletes before it, but not those after it.
+	restored := mustRead(t, `{"op":"delete","scope":"local","id":4,"name":"Legacy"}
+{"op":"restore","scope":"local","id":4,"name":"Legacy"}
+{"op":"delete","scope":"local","id":5,"name":"Invoice"}
+{"op":"restore","scope":"local","id":5,"name":"Invoice"}
+{"op":"delete","scope":"local","id":5,"name":"Invoice"}
+`).Scope(ledger.GlobalScope)
+	if _, deleted := restored.Deleted[4]; deleted || restored.Deleted[5] != "Invoice" {
+		t.Errorf("Deleted = %v, want only #5", restored.Deleted)
+	}
+
+	for _, text := range []string{
+		`{"op":"allocate","scope":"local","id":10,"name":"User"}` + "\n" + `{"op":"rename","scope":"local","id":10}`,
+		`{"op":"reserve","scope":"local","team":"payments","from":200,"to":100}`,
+		`{"op":"allocate","id":1}`,
+		`not json`,
+	} {
+		if _, err := ledger.Read(strings.NewReader(text)); err == nil {
+			t.Errorf("Read(%q) should fail", text)
+		}
+	}
+	if _, err := ledger.Read(strings.NewReader("\n" + `{"op":"rename","scope":"local","id":10}`)); err == nil || !strings.HasPrefix(err.Error(), "line 2: ") {
+		t.Errorf("err = %v, want one naming line 2", err)
+	}
+}
+
+func TestNext(t *testing.T) {
+	l := mustRead(t, `{"op":"reserve","scope":"local","team":"payments","from":10,"to":11}
+{"op":"reserve","scope":"local","team":"search","from":20,"to":29}
+{"op":"allocate","scope":"local","id":8,"name":"User"}
+{"op":"delete","scope":"local","id":9,"name":"Legacy"}
+{"op":"allocate","scope":"local","id":10,"name":"Invoice"}
+`)
+	s := l.Scope(ledger.GlobalScope)
+
+	cases := []struct {
+		team string
+		want schema.EntityID
+	}{
+		// Deleted IDs are never reused, and reserved ranges are skipped.
+		{"", 12},
+		{"payments", 11},
+		{"search", 20},
+		{"growth", 12},
+	}
+	for _, c := range cases {
+		if got, err := s.Next(c.team); err != nil || got != c.want {
+			t.Errorf("Next(%q) = %d, %v, want %d", c.team, got, err, c.want)
+		}
+	}
+
+	l.Add(&ledger.Entry{Op: ledger.Allocate, Scope: ledger.GlobalScope, ID: 11, Name: "Payment", Team: "payments"})
+	if _, err := s.Next("payments"); err == nil || !strings.Contains(err.Error(), "used up") {
+		t.Errorf("Next(payments) err = %v, want the range used up", err)
+	}
+	l.Add(&ledger.Entry{Op: ledger.Allocate, Scope: ledger.GlobalScope, ID: 12, Name: "Query"})
+	if got, _ := s.Next(""); got != 13 {
+		t.Errorf("Next = %d, want 13", got)
+	}
+}
+
+var files = map[string]string{
+	"base.cdm": `Email: string
+
+User {
+  id: string #1
+  email: Email
+} #1
+
+Post {
+  title: string
+  author: User
+}
+`,
+	"api.cdm": `extends "./base.cdm"
+
+User {
+  email: Email { @sql { type: "citext" } }
+  handle: string
+}
+
+ApiKey {
+  key: string
+}
+`,
+}
+
+func TestAssign(t *testing.T) {
+	l := mustRead(t, `{"op":"reserve","scope":"local","team":"api","from":100,"to":199}
+{"op":"allocate","scope":"local","id":2,"name":"Session"}
+{"op":"delete","scope":"local","id":2,"name":"Session"}
+`)
+	a, diagnostics := project(files, "api.cdm").Assign(l, "api")
+	if len(diagnostics) > 0 {
+		t.Fatalf("Assign returned diagnostics:\n%s", messages(diagnostics))
+	}
+
+	wantBase := `Email: string #100
+
+User {
+  id: string #1
+  email: Email #2
+} #1
+
+Post {
+  title: string #1
+  author: User #2
+} #101
+`
+	if got := string(a.Files["base.cdm"]); got != wantBase {
+		t.Errorf("base.cdm =\n%s\nwant\n%s", got, wantBase)
+	}
+	// The redefined email keeps the ID it inherits; the field added to
+	// User is numbered in User's scope.
+	wantAPI := `extends "./base.cdm"
+
+User {
+  email: Email { @sql { type: "citext" } }
+  handle: string #3
+}
+
+ApiKey {
+  key: string #1
+} #102
+`
+	if got := string(a.Files["api.cdm"]); got != wantAPI {
+		t.Errorf("api.cdm =\n%s\nwant\n%s", got, wantAPI)
+	}
+
+	var b strings.Builder
+	if err := ledger.WriteEntries(&b, a.Entries); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		`{"op":"allocate","scope":"local","id":1,"name":"User"}`,
+		`{"op":"allocate","scope":"local","id":100,"name":"Email","team":"api"}`,
+		`{"op":"allocate","scope":"#1","id":3,"name":"handle"}`,
+		`{"op":"allocate","scope":"#102","id":1,"name":"key"}`,
+	} {
+		if !strings.Contains(b.String(), line+"\n") {
+			t.Errorf("entries =\n%s\nwant a line %s", b.String(), line)
+		}
+	}
+
+	// Once the files are written, the sources and the ledger agree.
+	written := map[string]string{"base.cdm": string(a.Files["base.cdm"]), "api.cdm": string(a.Files["api.cdm"])}
+	if diagnostics := project(written, "api.cdm").Verify(l); len(diagnostics) > 0 {
+		t.Errorf("Verify returned diagnostics:\n%s", messages(diagnostics))
+	}
+
+	// Removing a model records its IDs as deleted only when the files are
+	// the whole project.
+	written["api.cdm"] = strings.Replace(written["api.cdm"], "ApiKey {\n  key: string #1\n} #102\n", "", 1)
+	a, diagnostics = project(written, "api.cdm").Assign(l, "api")
+	if len(diagnostics) > 0 || len(a.Files) != 0 || len(a.Entries) != 0 {
+		t.Fatalf("Assign = %v, %v, %s", a.Files, a.Entries, messages(diagnostics))
+	}
+	pruned := project(written, "api.cdm")
+	pruned.Prune = true
+	a, diagnostics = pruned.Assign(l, "api")
+	if len(diagnostics) > 0 || len(a.Files) != 0 {
+		t.Fatalf("Assign = %v, %s", a.Files, messages(diagnostics))
+	}
+	b.Reset()
+	ledger.WriteEntries(&b, a.Entries)
+	if want := `{"op":"delete","scope":"#102","id":1,"name":"key"}` + "\n" + `{"op":"delete","scope":"local","id":102,"name":"ApiKey"}` + "\n"; b.String() != want {
+		t.Errorf("entries =\n%s\nwant\n%s", b.String(), want)
+	}
+
+	// A deletion can be taken back, once.
+	e, err := l.Restore(ledger.GlobalScope, 102)
+	if err != nil || *e != (ledger.Entry{Op: ledger.Restore, Scope: ledger.GlobalScope, ID: 102, Name: "ApiKey"}) {
+		t.Errorf("Restore = %+v, %v", e, err)
+	}
+	if _, deleted := l.Scope(ledger.GlobalScope).Deleted[102]; deleted {
+		t.Error("#102 is still deleted after Restore")
+	}
+	if _, err := l.Restore(ledger.GlobalScope, 102); err == nil {
+		t.Error("Restore of an ID that is not deleted should fail")
+	}
+}
+
+func TestVerify(t *testing.T) {
+	files := map[string]string{
+		"schema.cdm": `User {
+  id: string #1
+  nickname: string #4
+} #1
+
+Team {} #3
+`,
+	}
+	l := mustRead(t, `{"op":"allocate","scope":"local","id":1,"name":"User"}
+{"op":"allocate","scope":"#1","id":1,"name":"id"}
+{"op":"allocate","scope":"#1","id":4,"name":"legacy"}
+{"op":"delete","scope":"#1","id":4,"name":"legacy"}
+{"op":"allocate","scope":"local","id":2,"name":"Group"}
+{"op":"allocate","scope":"local","id":5,"name":"Invoice"}
+{"op":"allocate","scope":"local","id":5,"name":"Refund"}
+{"op":"reserve","scope":"local","team":"payments","from":100,"to":199}
+{"op":"reserve","scope":"local","team":"payments","from":100,"to":199}
+{"op":"reserve","scope":"local","team":"search","from":150,"to":249}
+`)
+	l.Path = ".cdm/ids.json"
+
+	want := strings.Join([]string{
+		"schema.cdm: E503: Reused entity ID #1.#4: 'nickname' takes the ID of deleted 'legacy'",
+		"schema.cdm: E505: Entity ID #3 of 'Team' is not recorded in the ID ledger; run assign-ids",
+		".cdm/ids.json: E505: ID ledger allocates #2 to 'Group', which no file defines; run assign-ids -prune to record its deletion",
+		".cdm/ids.json: E505: ID ledger allocates #5 to more than one entity: 'Invoice', 'Refund'",
+		".cdm/ids.json: E505: ID ledger allocates #5 to 'Invoice', which no file defines; run assign-ids -prune to record its deletion",
+		".cdm/ids.json: E505: ID ledger reserves overlapping ranges in scope 'local': 100-199 for team 'payments' and 150-249 for team 'search'",
+	}, "\n")
+	if got := messages(project(files, "schema.cdm").Verify(l)); got != want {
+		t.Errorf("Verify =\n%s\nwant\n%s", got, want)
+	}
+
+	// Assign refuses to adopt a deleted ID.
+	if a, diagnostics := project(files, "schema.cdm").Assign(l, ""); a != nil || !strings.Contains(messages(diagnostics), "E503") {
+		t.Errorf("Assign = %v, %s, want an E503", a, messages(diagnostics))
+	}
+}
+
+func TestAppend(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, ".cdm", "ids.json")
+	entry := &ledger.Entry{Op: ledger.Allocate, Scope: ledger.GlobalScope, ID: 1, Name: "User"}
+	for i := 0; i < 2; i++ {
+		if err := ledger.Append(path, []*ledger.Entry{entry}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	l, err := ledger.Load(path)
+	if err != nil || len(l.Entries) != 2 {
+		t.Errorf("Load = %v, %v, want 2 entries", l, err)
+	}
+	// The ledger is merged with the union driver, and the rule is added once.
+	attributes, err := os.ReadFile(filepath.Join(dir, ".cdm", ".gitattributes"))
+	if err != nil || string(attributes) != "ids.json merge=union\n" {
+		t.Errorf(".gitattributes = %q, %v", attributes, err)
+	}
+
+	// A merge driver the project chose is kept.
+	other := filepath.Join(dir, "other")
+	os.MkdirAll(other, 0o755)
+	os.WriteFile(filepath.Join(other, ".gitattributes"), []byte("*.cdm text\nids.json merge=binary"), 0o644)
+	if err := ledger.Append(filepath.Join(other, "ids.json"), []*ledger.Entry{entry}); err != nil {
+		t.Fatal(err)
+	}
+	if attributes, _ := os.ReadFile(filepath.Join(other, ".gitattributes")); string(attributes) != "*.cdm text\nids.json merge=binary" {
+		t.Errorf(".gitattributes = %q", attributes)
+	}
+}
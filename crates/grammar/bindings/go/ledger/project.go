@@ -0,0 +1,471 @@
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/larner-dev/cdm/bindings/go/contexts"
+	"github.com/larner-dev/cdm/bindings/go/position"
+	"github.com/larner-dev/cdm/bindings/go/schema"
+)
+
+// Project is the set of CDM files whose IDs a ledger records.
+type Project struct {
+	// Context files to resolve, with the files they extend
+	Paths []string
+	// Prune tells Assign that Paths cover the whole project, so the
+	// ledger's IDs that no file defines are recorded as deleted. Without
+	// it, Assign never records a deletion.
+	Prune bool
+	// ReadFile returns the text of a local .cdm file.
+	ReadFile func(path string) ([]byte, error)
+	// LoadTemplate loads a template a file extends. Templates assign their
+	// own IDs, which the ledger does not record. Nil when no file extends a
+	// template.
+	LoadTemplate contexts.Loader
+}
+
+// Diagnostic is a diagnostic about one file of a project, or about the
+// ledger.
+type Diagnostic struct {
+	Path string
+	schema.Diagnostic
+}
+
+func (d Diagnostic) String() string { return d.Path + ": " + d.Diagnostic.String() }
+
+// Assignment is what Assign changes.
+type Assignment struct {
+	// New text of each file that had IDs assigned, by path
+	Files map[string][]byte
+	// Entries Assign added to the ledger, to append to its file
+	Entries []*Entry
+}
+
+// file is a local file of a project as parsed.
+type file struct {
+	path   string
+	text   []byte
+	schema *schema.Schema
+	// Offsets at which Assign inserts IDs, and the IDs
+	edits map[int]schema.EntityID
+}
+
+// entity is an entity with a local ID, as a file defines it.
+type entity struct {
+	scope string
+	id    schema.EntityID
+	name  string
+	path  string
+	span  position.Span
+}
+
+func (e *entity) display() string { return display(e.scope, e.id) }
+
+// display formats id of scope like a scoped ID, such as `#10` or `#10.#3`.
+func display(scope string, id schema.EntityID) string {
+	if scope == GlobalScope {
+		return fmt.Sprintf("#%d", id)
+	}
+	return fmt.Sprintf("%s.#%d", scope, id)
+}
+
+// fieldScope returns the ledger scope of the fields of model.
+func fieldScope(model *schema.Model) string {
+	if model.ID == 0 {
+		return model.Name
+	}
+	return model.ScopedID().String()
+}
+
+type loaded struct {
+	files    map[string]*file
+	order    []*file
+	contexts []*contexts.Context
+	// Loads files from files once parsed, so resolving again sees changes
+	// to them
+	loader contexts.Loader
+}
+
+// load parses the project's files and resolves its contexts. Files are
+// ordered so each comes after the files it extends.
+func (p *Project) load() (*loaded, []Diagnostic) {
+	l := &loaded{files: map[string]*file{}}
+	var diagnostics []Diagnostic
+	l.loader = func(path string) (*schema.Schema, error) {
+		if f, ok := l.files[path]; ok {
+			return f.schema, nil
+		}
+		if !strings.HasSuffix(path, ".cdm") {
+			if p.LoadTemplate == nil {
+				return nil, errors.New("templates cannot be loaded")
+			}
+			return p.LoadTemplate(path)
+		}
+		text, err := p.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		s, problems := schema.Parse(text)
+		for _, d := range problems {
+			diagnostics = append(diagnostics, Diagnostic{path, d})
+		}
+		if schema.HasErrors(problems) {
+			return nil, fmt.Errorf("%s has syntax errors", path)
+		}
+		l.files[path] = &file{path: path, text: text, schema: s, edits: map[int]schema.EntityID{}}
+		return s, nil
+	}
+	l.resolve(p.Paths, &diagnostics)
+
+	seen := map[string]bool{}
+	var visit func(c *contexts.Context)
+	visit = func(c *contexts.Context) {
+		if seen[c.Path] {
+			return
+		}
+		seen[c.Path] = true
+		for _, parent := range c.Parents {
+			visit(parent)
+		}
+		if f := l.files[c.Path]; f != nil && c.Source.Kind == schema.LocalSource {
+			l.order = append(l.order, f)
+		}
+	}
+	for _, c := range l.contexts {
+		visit(c)
+	}
+	return l, diagnostics
+}
+
+func (l *loaded) resolve(paths []string, diagnostics *[]Diagnostic) {
+	l.contexts = nil
+	for _, path := range paths {
+		c, problems := contexts.Resolve(path, l.loader)
+		for _, d := range problems {
+			*diagnostics = append(*diagnostics, Diagnostic{path, d})
+		}
+		if c != nil {
+			l.contexts = append(l.contexts, c)
+		}
+	}
+}
+
+// local returns the contexts of the project's own files, each once, in the
+// order of l.order.
+func (l *loaded) local() []*contexts.Context {
+	byPath := map[string]*contexts.Context{}
+	var visit func(c *contexts.Context)
+	visit = func(c *contexts.Context) {
+		if _, ok := byPath[c.Path]; ok {
+			return
+		}
+		byPath[c.Path] = c
+		for _, parent := range c.Parents {
+			visit(parent)
+		}
+	}
+	for _, c := range l.contexts {
+		visit(c)
+	}
+	var out []*contexts.Context
+	for _, f := range l.order {
+		out = append(out, byPath[f.path])
+	}
+	return out
+}
+
+// entities returns the entities with local IDs that the project's files
+// define. Type aliases and models are in GlobalScope; fields are in the
+// scope of the model they belong to once contexts are resolved, so a field
+// a context adds to an inherited model shares the model's scope.
+func (l *loaded) entities() []*entity {
+	var out []*entity
+	for _, c := range l.local() {
+		add := func(scope string, id schema.EntityID, source schema.EntityIDSource, name string, span position.Span) {
+			if id != 0 && source.Kind == schema.LocalSource {
+				out = append(out, &entity{scope: scope, id: id, name: name, path: c.Path, span: span})
+			}
+		}
+		for _, alias := range c.File.TypeAliases {
+			add(GlobalScope, alias.ID, alias.IDSource, alias.Name, alias.Span)
+		}
+		for _, model := range definitions(c.File) {
+			add(GlobalScope, model.ID, model.IDSource, model.Name, model.Span)
+			resolved := resolvedModel(c, model.Name)
+			if resolved == nil {
+				continue
+			}
+			for _, field := range model.Fields {
+				add(fieldScope(resolved), field.ID, field.IDSource, field.Name, field.Span)
+			}
+		}
+	}
+	return out
+}
+
+// used returns the IDs the project's files use, by scope.
+func (l *loaded) used() map[string]map[schema.EntityID]bool {
+	used := map[string]map[schema.EntityID]bool{}
+	for _, e := range l.entities() {
+		if used[e.scope] == nil {
+			used[e.scope] = map[schema.EntityID]bool{}
+		}
+		used[e.scope][e.id] = true
+	}
+	return used
+}
+
+// definitions returns the models and generic models of a file.
+func definitions(s *schema.Schema) []*schema.Model {
+	return append(append([]*schema.Model{}, s.Models...), s.Generics...)
+}
+
+// resolvedModel returns the model or generic model named name as c
+// resolves it.
+func resolvedModel(c *contexts.Context, name string) *schema.Model {
+	if model := c.Schema.Model(name); model != nil {
+		return model
+	}
+	return c.Schema.Generic(name)
+}
+
+// Assign gives an ID from the ledger to every type alias, model and field
+// of the project that has none, records IDs the files use but the ledger
+// does not, and, when p.Prune is set, records the deletion of IDs the
+// ledger allocates but no file uses. A model a context modifies and a field it redefines keep the ID
+// they inherit, and fields of inline object types are left alone. IDs are
+// allocated from team's reserved ranges when it has any (see Scope.Next).
+// Assign reports IDs the ledger records as deleted (E503). It adds its
+// entries to l and sets the IDs on the parsed files; when it reports errors
+// it returns no Assignment, and nothing should be written.
+func (p *Project) Assign(l *Ledger, team string) (*Assignment, []Diagnostic) {
+	project, diagnostics := p.load()
+	if hasErrors(diagnostics) {
+		return nil, diagnostics
+	}
+	var entries []*Entry
+	allocate := func(f *file, scope, name string, span position.Span) (schema.EntityID, error) {
+		s := l.Scope(scope)
+		id, err := s.Next(team)
+		if err != nil {
+			return 0, err
+		}
+		e := &Entry{Op: Allocate, Scope: scope, ID: id, Name: name}
+		if team != "" && s.owns(team, id) {
+			e.Team = team
+		}
+		l.Add(e)
+		entries = append(entries, e)
+		f.edits[span.EndByte] = id
+		return id, nil
+	}
+	fail := func(f *file, span position.Span, name string, err error) {
+		diagnostics = append(diagnostics, Diagnostic{f.path, schema.Errorf(schema.CodeUnrecordedEntityID, span,
+			"Cannot allocate an entity ID for '%s': %v", name, err)})
+	}
+
+	// adopt records the IDs the files already use, so that new IDs do not
+	// take them, either those of type aliases and models or those of fields.
+	adopt := func(global bool) {
+		for _, e := range project.entities() {
+			if (e.scope == GlobalScope) != global {
+				continue
+			}
+			s := l.Scope(e.scope)
+			if name, ok := s.Deleted[e.id]; ok {
+				diagnostics = append(diagnostics, reused(e, name))
+			} else if len(s.Allocated[e.id]) == 0 {
+				entry := &Entry{Op: Allocate, Scope: e.scope, ID: e.id, Name: e.name}
+				l.Add(entry)
+				entries = append(entries, entry)
+			}
+		}
+	}
+
+	// Type aliases and models come first, so fields are allocated in the
+	// scopes of their models' new IDs.
+	inherited := func(c *contexts.Context, name string) bool {
+		for _, parent := range c.Parents {
+			if parent.Schema.Model(name) != nil || parent.Schema.TypeAlias(name) != nil || parent.Schema.Generic(name) != nil {
+				return true
+			}
+		}
+		return false
+	}
+	adopt(true)
+	for _, c := range project.local() {
+		f := project.files[c.Path]
+		for _, alias := range f.schema.TypeAliases {
+			if alias.ID == 0 && !inherited(c, alias.Name) {
+				id, err := allocate(f, GlobalScope, alias.Name, alias.Span)
+				if err != nil {
+					fail(f, alias.Span, alias.Name, err)
+				}
+				alias.ID = id
+			}
+		}
+		for _, model := range definitions(f.schema) {
+			if model.ID == 0 && !inherited(c, model.Name) {
+				id, err := allocate(f, GlobalScope, model.Name, model.Span)
+				if err != nil {
+					fail(f, model.Span, model.Name, err)
+				}
+				model.ID = id
+			}
+		}
+	}
+
+	// Resolving again carries the new model IDs into the contexts.
+	project.resolve(p.Paths, &diagnostics)
+	adopt(false)
+	for _, c := range project.local() {
+		f := project.files[c.Path]
+		for _, model := range definitions(f.schema) {
+			resolved := resolvedModel(c, model.Name)
+			if resolved == nil {
+				continue
+			}
+			for _, field := range model.Fields {
+				if field.ID != 0 || inheritsField(c, model.Name, field.Name) {
+					continue
+				}
+				id, err := allocate(f, fieldScope(resolved), field.Name, field.Span)
+				if err != nil {
+					fail(f, field.Span, field.Name, err)
+				}
+				field.ID = id
+			}
+		}
+	}
+
+	if p.Prune {
+		used := project.used()
+		for _, name := range l.Scopes() {
+			s := l.Scope(name)
+			for _, id := range sortedIDs(s.Allocated) {
+				if _, deleted := s.Deleted[id]; !deleted && !used[name][id] {
+					entry := &Entry{Op: Delete, Scope: name, ID: id, Name: s.Allocated[id][0]}
+					l.Add(entry)
+					entries = append(entries, entry)
+				}
+			}
+		}
+	}
+	if hasErrors(diagnostics) {
+		return nil, diagnostics
+	}
+
+	a := &Assignment{Files: map[string][]byte{}, Entries: entries}
+	for _, f := range project.order {
+		if len(f.edits) > 0 {
+			a.Files[f.path] = insertIDs(f.text, f.edits)
+		}
+	}
+	return a, diagnostics
+}
+
+// Verify checks that the project's files and the ledger agree: every local
+// ID a file uses is allocated in the ledger (E505) and not recorded as
+// deleted (E503), every ID the ledger allocates and has not deleted is used
+// (E505), and no ID is allocated to two entities and no two teams' reserved
+// ranges overlap, as happens when branches that allocated the same ID or
+// reserved overlapping ranges are merged (E505).
+func (p *Project) Verify(l *Ledger) []Diagnostic {
+	project, diagnostics := p.load()
+	if hasErrors(diagnostics) {
+		return diagnostics
+	}
+	used := project.used()
+	for _, e := range project.entities() {
+		s := l.Scope(e.scope)
+		if name, ok := s.Deleted[e.id]; ok {
+			diagnostics = append(diagnostics, reused(e, name))
+		} else if len(s.Allocated[e.id]) == 0 {
+			diagnostics = append(diagnostics, Diagnostic{e.path, schema.Errorf(schema.CodeUnrecordedEntityID, e.span,
+				"Entity ID %s of '%s' is not recorded in the ID ledger; run assign-ids", e.display(), e.name)})
+		}
+	}
+
+	for _, name := range l.Scopes() {
+		s := l.Scope(name)
+		for _, id := range sortedIDs(s.Allocated) {
+			names := s.Allocated[id]
+			if len(names) > 1 {
+				diagnostics = append(diagnostics, Diagnostic{l.Path, schema.Errorf(schema.CodeUnrecordedEntityID, position.Span{},
+					"ID ledger allocates %s to more than one entity: '%s'", display(name, id), strings.Join(names, "', '"))})
+			}
+			if _, deleted := s.Deleted[id]; !deleted && !used[name][id] {
+				diagnostics = append(diagnostics, Diagnostic{l.Path, schema.Errorf(schema.CodeUnrecordedEntityID, position.Span{},
+					"ID ledger allocates %s to '%s', which no file defines; run assign-ids -prune to record its deletion", display(name, id), names[0])})
+			}
+		}
+		for i, a := range s.Reserved {
+			for _, b := range s.Reserved[i+1:] {
+				if a.From <= b.To && b.From <= a.To {
+					diagnostics = append(diagnostics, Diagnostic{l.Path, schema.Errorf(schema.CodeUnrecordedEntityID, position.Span{},
+						"ID ledger reserves overlapping ranges in scope '%s': %d-%d for team '%s' and %d-%d for team '%s'", name, a.From, a.To, a.Team, b.From, b.To, b.Team)})
+				}
+			}
+		}
+	}
+	return diagnostics
+}
+
+func reused(e *entity, name string) Diagnostic {
+	return Diagnostic{e.path, schema.Errorf(schema.CodeReusedEntityID, e.span,
+		"Reused entity ID %s: '%s' takes the ID of deleted '%s'", e.display(), e.name, name)}
+}
+
+// inheritsField reports whether a parent of c has a field named field in
+// model, whose ID a redefinition in c keeps.
+func inheritsField(c *contexts.Context, model, field string) bool {
+	for _, parent := range c.Parents {
+		if m := parent.Schema.Model(model); m != nil {
+			for _, f := range parent.Schema.Fields(m) {
+				if f.Name == field {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// insertIDs inserts ` #N` into text at each offset of edits.
+func insertIDs(text []byte, edits map[int]schema.EntityID) []byte {
+	var offsets []int
+	for offset := range edits {
+		offsets = append(offsets, offset)
+	}
+	sort.Ints(offsets)
+	var b strings.Builder
+	last := 0
+	for _, offset := range offsets {
+		b.Write(text[last:offset])
+		fmt.Fprintf(&b, " #%d", edits[offset])
+		last = offset
+	}
+	b.Write(text[last:])
+	return []byte(b.String())
+}
+
+func sortedIDs(ids map[schema.EntityID][]string) []schema.EntityID {
+	var out []schema.EntityID
+	for id := range ids {
+		out = append(out, id)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func hasErrors(diagnostics []Diagnostic) bool {
+	for _, d := range diagnostics {
+		if d.Severity == schema.Error {
+			return true
+		}
+	}
+	return false
+}
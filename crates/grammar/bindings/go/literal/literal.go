@@ -0,0 +1,220 @@
+// Package literal decodes and encodes CDM string literals.
+//
+// CDM strings are enclosed in double quotes and support the escapes `\"`,
+// `\\`, `\/`, `\b`, `\f`, `\n`, `\r`, `\t` and `\uXXXX`. A `\u` escape names
+// a UTF-16 code unit, so characters outside the Basic Multilingual Plane are
+// written as a surrogate pair (`\ud83d\ude00`). All other characters, including
+// raw newlines and non-ASCII text, appear verbatim.
+package literal
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// Error reports a malformed string literal.
+type Error struct {
+	// Byte offset of the problem. For Unquote this is relative to the start
+	// of the literal text; for Decode it is relative to the whole source.
+	Offset int
+	// Description of the problem
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s at byte %d", e.Message, e.Offset)
+}
+
+// Decode returns the value of a `string_literal` node. Error offsets are byte
+// offsets into source.
+func Decode(node *tree_sitter.Node, source []byte) (string, error) {
+	if node.Kind() != "string_literal" {
+		return "", &Error{
+			Offset:  int(node.StartByte()),
+			Message: fmt.Sprintf("expected string_literal, found %s", node.Kind()),
+		}
+	}
+
+	value, err := Unquote(node.Utf8Text(source))
+	if e, ok := err.(*Error); ok {
+		e.Offset += int(node.StartByte())
+	}
+	return value, err
+}
+
+// Unquote decodes literal text, including its surrounding quotes.
+func Unquote(text string) (string, error) {
+	if len(text) < 2 || text[0] != '"' || text[len(text)-1] != '"' {
+		return "", &Error{Offset: 0, Message: "string literal must be enclosed in double quotes"}
+	}
+
+	body := text[1 : len(text)-1]
+	if !strings.ContainsAny(body, "\\\"") {
+		return body, nil
+	}
+
+	var b strings.Builder
+	b.Grow(len(body))
+	for i := 0; i < len(body); {
+		c := body[i]
+		switch c {
+		case '"':
+			return "", &Error{Offset: i + 1, Message: "unescaped double quote in string literal"}
+		case '\\':
+			r, size, err := decodeEscape(body, i)
+			if err != nil {
+				return "", err
+			}
+			b.WriteRune(r)
+			i += size
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return b.String(), nil
+}
+
+// decodeEscape decodes the escape sequence starting at body[i], combining a
+// surrogate pair into a single rune. It returns the rune and the number of
+// bytes consumed.
+func decodeEscape(body string, i int) (rune, int, error) {
+	// Offsets in errors are relative to the opening quote.
+	offset := i + 1
+	if i+1 >= len(body) {
+		return 0, 0, &Error{Offset: offset, Message: "unterminated escape sequence"}
+	}
+
+	switch body[i+1] {
+	case '"':
+		return '"', 2, nil
+	case '\\':
+		return '\\', 2, nil
+	case '/':
+		return '/', 2, nil
+	case 'b':
+		return '\b', 2, nil
+	case 'f':
+		return '\f', 2, nil
+	case 'n':
+		return '\n', 2, nil
+	case 'r':
+		return '\r', 2, nil
+	case 't':
+		return '\t', 2, nil
+	case 'u':
+		unit, ok := hex4(body, i+2)
+		if !ok {
+			return 0, 0, &Error{Offset: offset, Message: "invalid unicode escape: expected \\u followed by 4 hex digits"}
+		}
+		r := rune(unit)
+		switch {
+		case utf16.IsSurrogate(r) && r < 0xDC00:
+			if i+7 < len(body) && body[i+6] == '\\' && body[i+7] == 'u' {
+				if low, ok := hex4(body, i+8); ok {
+					if pair := utf16.DecodeRune(r, rune(low)); pair != utf8.RuneError {
+						return pair, 12, nil
+					}
+				}
+			}
+			return 0, 0, &Error{Offset: offset, Message: fmt.Sprintf("unpaired high surrogate %s", body[i:i+6])}
+		case utf16.IsSurrogate(r):
+			return 0, 0, &Error{Offset: offset, Message: fmt.Sprintf("unpaired low surrogate %s", body[i:i+6])}
+		}
+		return r, 6, nil
+	}
+
+	_, size := utf8.DecodeRuneInString(body[i+1:])
+	return 0, 0, &Error{Offset: offset, Message: fmt.Sprintf("invalid escape sequence %q", body[i:i+1+size])}
+}
+
+// hex4 parses the four hex digits at body[start:].
+func hex4(body string, start int) (uint16, bool) {
+	if start+4 > len(body) {
+		return 0, false
+	}
+
+	var value uint16
+	for _, c := range []byte(body[start : start+4]) {
+		var digit byte
+		switch {
+		case c >= '0' && c <= '9':
+			digit = c - '0'
+		case c >= 'a' && c <= 'f':
+			digit = c - 'a' + 10
+		case c >= 'A' && c <= 'F':
+			digit = c - 'A' + 10
+		default:
+			return 0, false
+		}
+		value = value<<4 | uint16(digit)
+	}
+	return value, true
+}
+
+// Quote encodes s as a CDM string literal. Non-ASCII characters are written
+// verbatim; control characters are escaped. Invalid UTF-8 is replaced with
+// U+FFFD.
+func Quote(s string) string {
+	return quote(s, false)
+}
+
+// QuoteASCII is like Quote but escapes every non-ASCII character with `\u`,
+// using surrogate pairs outside the Basic Multilingual Plane.
+func QuoteASCII(s string) string {
+	return quote(s, true)
+}
+
+func quote(s string, asciiOnly bool) string {
+	const hex = "0123456789abcdef"
+
+	var b strings.Builder
+	b.Grow(len(s) + 2)
+	b.WriteByte('"')
+
+	writeUnit := func(unit rune) {
+		b.WriteString(`\u`)
+		for shift := 12; shift >= 0; shift -= 4 {
+			b.WriteByte(hex[unit>>shift&0xF])
+		}
+	}
+
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			switch {
+			case r < 0x20 || r == 0x7F:
+				writeUnit(r)
+			case r < utf8.RuneSelf || !asciiOnly:
+				b.WriteRune(r)
+			case r > 0xFFFF:
+				high, low := utf16.EncodeRune(r)
+				writeUnit(high)
+				writeUnit(low)
+			default:
+				writeUnit(r)
+			}
+		}
+	}
+
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,197 @@
+package literal_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	tree_sitter_cdm "github.com/larner-dev/cdm/bindings/go"
+	"github.com/larner-dev/cdm/bindings/go/literal"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+func parse(t testing.TB, source string) *tree_sitter.Tree {
+	t.Helper()
+
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	if err := parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_cdm.Language())); err != nil {
+		t.Fatalf("Error loading Contextual Data Models grammar: %v", err)
+	}
+	return parser.Parse([]byte(source), nil)
+}
+
+// findKind returns the first node of the given kind in document order.
+func findKind(node *tree_sitter.Node, kind string) *tree_sitter.Node {
+	if node.Kind() == kind {
+		return node
+	}
+	for i := uint(0); i < node.ChildCount(); i++ {
+		if found := findKind(node.Child(i), kind); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func TestUnquote(t *testing.T) {
+	cases := []struct {
+		text string
+		want string
+	}{
+		{`""`, ""},
+		{`"hello world"`, "hello world"},
+		{`"line1\nline2"`, "line1\nline2"},
+		{`"quote: \"value\""`, `quote: "value"`},
+		{`"\\ \/ \b \f \r \t"`, "\\ / \b \f \r \t"},
+		{`"caf\u00e9"`, "café"},
+		{`"\u00E9"`, "é"},
+		{`"\ud83d\ude00"`, "😀"},
+		{`"raw é and 😀"`, "raw é and 😀"},
+		{"\"multi\nline\"", "multi\nline"},
+	}
+	for _, c := range cases {
+		got, err := literal.Unquote(c.text)
+		if err != nil {
+			t.Errorf("Unquote(%s) returned error: %v", c.text, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("Unquote(%s) = %q, want %q", c.text, got, c.want)
+		}
+	}
+}
+
+func TestUnquoteErrors(t *testing.T) {
+	cases := []struct {
+		text    string
+		offset  int
+		message string
+	}{
+		{`abc`, 0, "enclosed in double quotes"},
+		{`"a\x"`, 2, `invalid escape sequence "\\x"`},
+		{`"\u12"`, 1, "4 hex digits"},
+		{`"\uzzzz"`, 1, "4 hex digits"},
+		{`"ok \ud83d"`, 4, "unpaired high surrogate"},
+		{`"\ud83d\u0041"`, 1, "unpaired high surrogate"},
+		{`"\ude00"`, 1, "unpaired low surrogate"},
+		{`"a"b"`, 2, "unescaped double quote"},
+		{`"a\"`, 2, "unterminated escape sequence"},
+	}
+	for _, c := range cases {
+		_, err := literal.Unquote(c.text)
+		var literalErr *literal.Error
+		if !errors.As(err, &literalErr) {
+			t.Errorf("Unquote(%s) error = %v, want *literal.Error", c.text, err)
+			continue
+		}
+		if literalErr.Offset != c.offset {
+			t.Errorf("Unquote(%s) error offset = %d, want %d", c.text, literalErr.Offset, c.offset)
+		}
+		if !strings.Contains(literalErr.Message, c.message) {
+			t.Errorf("Unquote(%s) error = %q, want it to mention %q", c.text, literalErr.Message, c.message)
+		}
+	}
+}
+
+func TestDecodeNode(t *testing.T) {
+	source := "Greeting: \"h\\u00e9llo \\\"world\\\"\" #1\n"
+	tree := parse(t, source)
+	defer tree.Close()
+
+	node := findKind(tree.RootNode(), "string_literal")
+	if node == nil {
+		t.Fatalf("no string_literal in %s", tree.RootNode().ToSexp())
+	}
+	got, err := literal.Decode(node, []byte(source))
+	if err != nil {
+		t.Fatalf("Decode returned error: %v", err)
+	}
+	if want := `héllo "world"`; got != want {
+		t.Errorf("Decode = %q, want %q", got, want)
+	}
+}
+
+func TestDecodeNodeErrorOffset(t *testing.T) {
+	source := "Smile: \"ok \\ud83d\"\n"
+	tree := parse(t, source)
+	defer tree.Close()
+
+	node := findKind(tree.RootNode(), "string_literal")
+	if node == nil {
+		t.Fatalf("no string_literal in %s", tree.RootNode().ToSexp())
+	}
+	_, err := literal.Decode(node, []byte(source))
+	var literalErr *literal.Error
+	if !errors.As(err, &literalErr) {
+		t.Fatalf("Decode error = %v, want *literal.Error", err)
+	}
+	if want := strings.Index(source, `\ud83d`); literalErr.Offset != want {
+		t.Errorf("error offset = %d, want %d", literalErr.Offset, want)
+	}
+}
+
+func TestDecodeWrongKind(t *testing.T) {
+	source := "Age: number\n"
+	tree := parse(t, source)
+	defer tree.Close()
+
+	if _, err := literal.Decode(tree.RootNode(), []byte(source)); err == nil {
+		t.Error("Decode of a source_file node should fail")
+	}
+}
+
+func TestQuote(t *testing.T) {
+	cases := []struct {
+		value string
+		quote string
+		ascii string
+	}{
+		{"plain", `"plain"`, `"plain"`},
+		{`say "hi"`, `"say \"hi\""`, `"say \"hi\""`},
+		{"a\\b/c", `"a\\b/c"`, `"a\\b/c"`},
+		{"tab\tnew\nline", `"tab\tnew\nline"`, `"tab\tnew\nline"`},
+		{"bell\x07", `"bell\u0007"`, `"bell\u0007"`},
+		{"café", `"café"`, `"caf\u00e9"`},
+		{"😀", `"😀"`, `"\ud83d\ude00"`},
+		{"bad\xffutf8", "\"bad\uFFFDutf8\"", `"bad\ufffdutf8"`},
+	}
+	for _, c := range cases {
+		if got := literal.Quote(c.value); got != c.quote {
+			t.Errorf("Quote(%q) = %s, want %s", c.value, got, c.quote)
+		}
+		if got := literal.QuoteASCII(c.value); got != c.ascii {
+			t.Errorf("QuoteASCII(%q) = %s, want %s", c.value, got, c.ascii)
+		}
+	}
+}
+
+func FuzzQuoteRoundTrip(f *testing.F) {
+	for _, seed := range []string{"", "hello", "a\"b\\c", "\r\n\t", "é😀", "\x00\x1f\x7f", "\\u0041"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, value string) {
+		if !utf8.ValidString(value) {
+			return
+		}
+		for _, quoted := range []string{literal.Quote(value), literal.QuoteASCII(value)} {
+			got, err := literal.Unquote(quoted)
+			if err != nil {
+				t.Fatalf("Unquote(%s) returned error: %v", quoted, err)
+			}
+			if got != value {
+				t.Fatalf("Unquote(%s) = %q, want %q", quoted, got, value)
+			}
+
+			// The encoded form must also be accepted by the grammar.
+			source := "Value: " + quoted + "\n"
+			tree := parse(t, source)
+			if root := tree.RootNode(); root.HasError() {
+				t.Fatalf("encoded literal %s does not parse: %s", quoted, root.ToSexp())
+			}
+			tree.Close()
+		}
+	})
+}
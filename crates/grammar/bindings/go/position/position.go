@@ -0,0 +1,292 @@
+// Package position converts between the byte offsets reported by tree-sitter
+// and the line/column positions used by editors and people.
+//
+// tree-sitter counts columns in UTF-8 bytes, LSP clients count UTF-16 code
+// units, and humans count characters (runes). A LineIndex is built once per
+// document and answers conversions between all three in O(log n): a search
+// for the line, then, on lines that are not pure ASCII, a search of the
+// character offsets the index records for that line.
+//
+// Lines end at `\n`. A `\r` immediately before the `\n` belongs to the line
+// terminator, mirroring the grammar's `_nls` rule (`\r?\n`), so columns never
+// point between the two. A lone `\r` is ordinary text.
+package position
+
+import (
+	"sort"
+	"unicode/utf8"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// Position is a zero-based line and column. The unit of Column depends on the
+// method that produced or consumes it.
+type Position struct {
+	Line   int
+	Column int
+}
+
+// Range is a half-open span between two positions.
+type Range struct {
+	Start Position
+	End   Position
+}
+
+// Span is the source extent of a syntax node: its byte range and the
+// tree-sitter points (byte columns) of both ends.
+type Span struct {
+	StartByte int
+	EndByte   int
+	Start     tree_sitter.Point
+	End       tree_sitter.Point
+}
+
+// NodeSpan returns the span of node.
+func NodeSpan(node *tree_sitter.Node) Span {
+	return Span{
+		StartByte: int(node.StartByte()),
+		EndByte:   int(node.EndByte()),
+		Start:     node.StartPosition(),
+		End:       node.EndPosition(),
+	}
+}
+
+// IsZero reports whether s is the zero Span, as carried by values that did
+// not come from source text.
+func (s Span) IsZero() bool {
+	return s == Span{}
+}
+
+// LineIndex maps between byte offsets and line/column positions in one
+// version of a document. Build a new index whenever the text changes.
+type LineIndex struct {
+	text []byte
+	// Byte offset of the first character of each line
+	lineStarts []int
+	// Characters of each line (excluding its terminator), or nil if the line
+	// is pure ASCII, in which case byte, rune and UTF-16 columns coincide.
+	lineUnits []*units
+}
+
+// units records where the characters of a line start. The rune column of
+// the character at offsets[i] is i; the last entry is the end of the line.
+type units struct {
+	// Byte column of each character, then of the end of the line
+	offsets []int
+	// UTF-16 column of each entry of offsets
+	utf16 []int
+}
+
+// NewLineIndex indexes text. The slice is retained, not copied.
+func NewLineIndex(text []byte) *LineIndex {
+	index := &LineIndex{
+		text:       text,
+		lineStarts: []int{0},
+	}
+
+	ascii := true
+	var nonASCII []int
+	for i, b := range text {
+		switch {
+		case b == '\n':
+			if !ascii {
+				nonASCII = append(nonASCII, len(index.lineStarts)-1)
+			}
+			index.lineStarts = append(index.lineStarts, i+1)
+			ascii = true
+		case b >= utf8.RuneSelf:
+			ascii = false
+		}
+	}
+	if !ascii {
+		nonASCII = append(nonASCII, len(index.lineStarts)-1)
+	}
+
+	index.lineUnits = make([]*units, len(index.lineStarts))
+	for _, line := range nonASCII {
+		index.lineUnits[line] = index.units(line)
+	}
+	return index
+}
+
+// units decodes the characters of line.
+func (index *LineIndex) units(line int) *units {
+	start, end := index.lineStarts[line], index.LineEnd(line)
+	u := &units{}
+	column := 0
+	for offset := start; offset < end; {
+		r, size := utf8.DecodeRune(index.text[offset:end])
+		u.offsets = append(u.offsets, offset-start)
+		u.utf16 = append(u.utf16, column)
+		column += utf16Len(r)
+		offset += size
+	}
+	u.offsets = append(u.offsets, end-start)
+	u.utf16 = append(u.utf16, column)
+	return u
+}
+
+// LineCount returns the number of lines. A trailing newline starts a final,
+// empty line.
+func (index *LineIndex) LineCount() int {
+	return len(index.lineStarts)
+}
+
+// LineStart returns the byte offset of the start of line, clamped to the
+// document.
+func (index *LineIndex) LineStart(line int) int {
+	switch {
+	case line < 0:
+		return 0
+	case line >= len(index.lineStarts):
+		return len(index.text)
+	}
+	return index.lineStarts[line]
+}
+
+// LineEnd returns the byte offset of the end of line, before any `\r\n` or
+// `\n` terminator.
+func (index *LineIndex) LineEnd(line int) int {
+	switch {
+	case line < 0:
+		return index.LineEnd(0)
+	case line >= len(index.lineStarts):
+		return len(index.text)
+	}
+
+	if line+1 == len(index.lineStarts) {
+		return len(index.text)
+	}
+	end := index.lineStarts[line+1] - 1
+	if end > index.lineStarts[line] && index.text[end-1] == '\r' {
+		end--
+	}
+	return end
+}
+
+// Line returns the zero-based line containing offset. Offsets are clamped to
+// the document.
+func (index *LineIndex) Line(offset int) int {
+	offset = index.clamp(offset)
+	return sort.Search(len(index.lineStarts), func(i int) bool {
+		return index.lineStarts[i] > offset
+	}) - 1
+}
+
+// Point converts a byte offset into a tree-sitter point (byte column).
+func (index *LineIndex) Point(offset int) tree_sitter.Point {
+	position := index.BytePosition(offset)
+	return tree_sitter.Point{Row: uint(position.Line), Column: uint(position.Column)}
+}
+
+// PointOffset converts a tree-sitter point into a byte offset.
+func (index *LineIndex) PointOffset(point tree_sitter.Point) int {
+	return index.ByteOffset(Position{Line: int(point.Row), Column: int(point.Column)})
+}
+
+// BytePosition converts a byte offset into a position with a byte column.
+// Offsets inside a line terminator map to the end of the line.
+func (index *LineIndex) BytePosition(offset int) Position {
+	line := index.Line(offset)
+	offset = min(index.clamp(offset), index.LineEnd(line))
+	return Position{Line: line, Column: offset - index.lineStarts[line]}
+}
+
+// ByteOffset converts a position with a byte column into a byte offset.
+// Columns past the end of the line clamp to the line end.
+func (index *LineIndex) ByteOffset(position Position) int {
+	start, end := index.LineStart(position.Line), index.LineEnd(position.Line)
+	return max(start, min(start+position.Column, end))
+}
+
+// UTF16Position converts a byte offset into a position whose column counts
+// UTF-16 code units, as used by the Language Server Protocol. Offsets in the
+// middle of a multi-byte character snap to the start of that character.
+func (index *LineIndex) UTF16Position(offset int) Position {
+	return index.unitPosition(offset, true)
+}
+
+// UTF16Offset converts a position with a UTF-16 column into a byte offset.
+// Columns past the end of the line clamp to the line end; a column that falls
+// inside a surrogate pair maps to the start of that character.
+func (index *LineIndex) UTF16Offset(position Position) int {
+	return index.unitOffset(position, true)
+}
+
+// RunePosition converts a byte offset into a position whose column counts
+// Unicode code points.
+func (index *LineIndex) RunePosition(offset int) Position {
+	return index.unitPosition(offset, false)
+}
+
+// RuneOffset converts a position with a rune column into a byte offset.
+func (index *LineIndex) RuneOffset(position Position) int {
+	return index.unitOffset(position, false)
+}
+
+// UTF16Range converts a byte span into an LSP-style range.
+func (index *LineIndex) UTF16Range(start, end int) Range {
+	return Range{Start: index.UTF16Position(start), End: index.UTF16Position(end)}
+}
+
+// NodeUTF16Range converts the span of a syntax node into an LSP-style range.
+func (index *LineIndex) NodeUTF16Range(node *tree_sitter.Node) Range {
+	return index.UTF16Range(int(node.StartByte()), int(node.EndByte()))
+}
+
+// SpanUTF16Range converts a span into an LSP-style range.
+func (index *LineIndex) SpanUTF16Range(span Span) Range {
+	return index.UTF16Range(span.StartByte, span.EndByte)
+}
+
+func (index *LineIndex) clamp(offset int) int {
+	return max(0, min(offset, len(index.text)))
+}
+
+func utf16Len(r rune) int {
+	if r >= 0x10000 && r <= utf8.MaxRune {
+		return 2
+	}
+	return 1
+}
+
+// unitPosition finds the character of its line that offset falls in and
+// returns the column of its start, in UTF-16 code units or runes.
+func (index *LineIndex) unitPosition(offset int, utf16 bool) Position {
+	position := index.BytePosition(offset)
+	u := index.lineUnits[position.Line]
+	if u == nil {
+		return position
+	}
+
+	i := sort.Search(len(u.offsets), func(i int) bool {
+		return u.offsets[i] > position.Column
+	}) - 1
+	if utf16 {
+		return Position{Line: position.Line, Column: u.utf16[i]}
+	}
+	return Position{Line: position.Line, Column: i}
+}
+
+// unitOffset returns the start of the last character of the line whose
+// column, in UTF-16 code units or runes, is at most position's.
+func (index *LineIndex) unitOffset(position Position, utf16 bool) int {
+	if position.Line < 0 {
+		return 0
+	}
+	if position.Line >= len(index.lineStarts) {
+		return len(index.text)
+	}
+	u := index.lineUnits[position.Line]
+	if u == nil {
+		return index.ByteOffset(position)
+	}
+
+	i := min(position.Column, len(u.offsets)-1)
+	if utf16 {
+		i = sort.Search(len(u.utf16), func(i int) bool {
+			return u.utf16[i] > position.Column
+		}) - 1
+	}
+	return index.lineStarts[position.Line] + u.offsets[max(0, i)]
+}
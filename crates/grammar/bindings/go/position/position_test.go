@@ -0,0 +1,201 @@
+package position_test
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/larner-dev/cdm/bindings/go/position"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+func TestASCII(t *testing.T) {
+	index := position.NewLineIndex([]byte("Hello\nWorld"))
+
+	cases := []struct {
+		offset int
+		want   position.Position
+	}{
+		{0, position.Position{Line: 0, Column: 0}},
+		{5, position.Position{Line: 0, Column: 5}},
+		{6, position.Position{Line: 1, Column: 0}},
+		{11, position.Position{Line: 1, Column: 5}},
+	}
+	for _, c := range cases {
+		if got := index.UTF16Position(c.offset); got != c.want {
+			t.Errorf("UTF16Position(%d) = %+v, want %+v", c.offset, got, c.want)
+		}
+		if got := index.UTF16Offset(c.want); got != c.offset {
+			t.Errorf("UTF16Offset(%+v) = %d, want %d", c.want, got, c.offset)
+		}
+	}
+}
+
+func TestEmoji(t *testing.T) {
+	// "😀" is 4 bytes in UTF-8, 2 UTF-16 code units and 1 rune.
+	index := position.NewLineIndex([]byte("Hi 😀 there"))
+
+	if got := index.UTF16Position(7); got != (position.Position{Line: 0, Column: 5}) {
+		t.Errorf("UTF16Position(7) = %+v, want column 5", got)
+	}
+	if got := index.RunePosition(7); got != (position.Position{Line: 0, Column: 4}) {
+		t.Errorf("RunePosition(7) = %+v, want column 4", got)
+	}
+	if got := index.UTF16Offset(position.Position{Line: 0, Column: 6}); got != 8 {
+		t.Errorf("UTF16Offset(column 6) = %d, want 8", got)
+	}
+	if got := index.RuneOffset(position.Position{Line: 0, Column: 5}); got != 8 {
+		t.Errorf("RuneOffset(column 5) = %d, want 8", got)
+	}
+
+	// A byte offset inside the emoji snaps to its start.
+	if got := index.UTF16Position(5); got != (position.Position{Line: 0, Column: 3}) {
+		t.Errorf("UTF16Position(5) = %+v, want column 3", got)
+	}
+	// A UTF-16 column between the two surrogates maps to the start of the emoji.
+	if got := index.UTF16Offset(position.Position{Line: 0, Column: 4}); got != 3 {
+		t.Errorf("UTF16Offset(column 4) = %d, want 3", got)
+	}
+}
+
+func TestNonASCIIStringLiteral(t *testing.T) {
+	// Escapes are plain ASCII in the source; only raw characters change the
+	// column arithmetic.
+	source := "Greeting: \"h\\u00e9llo\" | \"héllo\" #1\n"
+	index := position.NewLineIndex([]byte(source))
+
+	idOffset := len(source) - len("#1\n")
+	if got := index.UTF16Position(idOffset); got.Column != idOffset-1 {
+		t.Errorf("UTF16Position(%d) column = %d, want %d", idOffset, got.Column, idOffset-1)
+	}
+	if got := index.BytePosition(idOffset); got.Column != idOffset {
+		t.Errorf("BytePosition(%d) column = %d, want %d", idOffset, got.Column, idOffset)
+	}
+}
+
+func TestCRLF(t *testing.T) {
+	source := []byte("User {\r\n  name: string\r\n}\r\n")
+	index := position.NewLineIndex(source)
+
+	if got := index.LineCount(); got != 4 {
+		t.Errorf("LineCount() = %d, want 4", got)
+	}
+	if got := index.LineEnd(0); got != 6 {
+		t.Errorf("LineEnd(0) = %d, want 6", got)
+	}
+	if got := index.LineStart(1); got != 8 {
+		t.Errorf("LineStart(1) = %d, want 8", got)
+	}
+
+	// Offsets on the `\r` or `\n` map to the end of the line.
+	for _, offset := range []int{6, 7} {
+		if got := index.UTF16Position(offset); got != (position.Position{Line: 0, Column: 6}) {
+			t.Errorf("UTF16Position(%d) = %+v, want {0 6}", offset, got)
+		}
+	}
+	// Columns past the end of the line stop before the terminator.
+	if got := index.UTF16Offset(position.Position{Line: 1, Column: 100}); got != 22 {
+		t.Errorf("UTF16Offset past line end = %d, want 22", got)
+	}
+}
+
+func TestLoneCarriageReturnIsText(t *testing.T) {
+	index := position.NewLineIndex([]byte("a\rb\nc"))
+
+	if got := index.LineCount(); got != 2 {
+		t.Errorf("LineCount() = %d, want 2", got)
+	}
+	if got := index.BytePosition(2); got != (position.Position{Line: 0, Column: 2}) {
+		t.Errorf("BytePosition(2) = %+v, want {0 2}", got)
+	}
+}
+
+func TestClamping(t *testing.T) {
+	index := position.NewLineIndex([]byte("ab\ncd"))
+
+	if got := index.UTF16Position(-3); got != (position.Position{}) {
+		t.Errorf("UTF16Position(-3) = %+v, want {0 0}", got)
+	}
+	if got := index.UTF16Position(100); got != (position.Position{Line: 1, Column: 2}) {
+		t.Errorf("UTF16Position(100) = %+v, want {1 2}", got)
+	}
+	if got := index.UTF16Offset(position.Position{Line: 9, Column: 0}); got != 5 {
+		t.Errorf("UTF16Offset(line 9) = %d, want 5", got)
+	}
+}
+
+func TestPoints(t *testing.T) {
+	index := position.NewLineIndex([]byte("Name: \"é\"\nAge: number"))
+
+	point := index.Point(12)
+	if point != (tree_sitter.Point{Row: 1, Column: 1}) {
+		t.Errorf("Point(12) = %+v, want {1 1}", point)
+	}
+	if got := index.PointOffset(point); got != 12 {
+		t.Errorf("PointOffset(%+v) = %d, want 12", point, got)
+	}
+	// Byte column 9 on line 0 is after the closing quote.
+	if got := index.PointOffset(tree_sitter.Point{Row: 0, Column: 9}); got != 9 {
+		t.Errorf("PointOffset({0 9}) = %d, want 9", got)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	source := []byte("// ✓ comment\r\nUser {\n  name: \"日本語😀\" #1\n}\n")
+	index := position.NewLineIndex(source)
+
+	for offset := 0; offset <= len(source); offset++ {
+		line := index.Line(offset)
+		if offset > index.LineEnd(line) {
+			continue
+		}
+		if offset < len(source) && source[offset]&0xC0 == 0x80 {
+			continue
+		}
+		if got := index.UTF16Offset(index.UTF16Position(offset)); got != offset {
+			t.Errorf("UTF-16 round trip of %d = %d", offset, got)
+		}
+		if got := index.RuneOffset(index.RunePosition(offset)); got != offset {
+			t.Errorf("rune round trip of %d = %d", offset, got)
+		}
+		if got := index.PointOffset(index.Point(offset)); got != offset {
+			t.Errorf("point round trip of %d = %d", offset, got)
+		}
+	}
+}
+
+func TestLongNonASCIILine(t *testing.T) {
+	line := strings.Repeat("é😀a", 1000)
+	source := []byte(line + "\r\nend")
+	index := position.NewLineIndex(source)
+
+	for offset := 0; offset <= len(line); offset++ {
+		if !utf8.RuneStart(source[offset]) {
+			continue
+		}
+		prefix := []rune(line[:offset])
+		want := position.Position{Column: len(utf16.Encode(prefix))}
+		if got := index.UTF16Position(offset); got != want {
+			t.Fatalf("UTF16Position(%d) = %+v, want %+v", offset, got, want)
+		}
+		if got := index.UTF16Offset(want); got != offset {
+			t.Fatalf("UTF16Offset(%+v) = %d, want %d", want, got, offset)
+		}
+		want.Column = len(prefix)
+		if got := index.RunePosition(offset); got != want {
+			t.Fatalf("RunePosition(%d) = %+v, want %+v", offset, got, want)
+		}
+		if got := index.RuneOffset(want); got != offset {
+			t.Fatalf("RuneOffset(%+v) = %d, want %d", want, got, offset)
+		}
+	}
+
+	// Columns past the end of the line clamp to its end, before the `\r\n`.
+	if got := index.UTF16Offset(position.Position{Column: 1 << 20}); got != len(line) {
+		t.Errorf("UTF16Offset(past the end) = %d, want %d", got, len(line))
+	}
+	if got := index.RuneOffset(position.Position{Column: 1 << 20}); got != len(line) {
+		t.Errorf("RuneOffset(past the end) = %d, want %d", got, len(line))
+	}
+}
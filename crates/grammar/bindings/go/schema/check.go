@@ -0,0 +1,26 @@
+package schema
+
+// Check runs the file-level semantic checks on s and returns their
+// diagnostics. It records what it infers, such as union discriminators,
+// nullable fields, computed fields and the relation graph, on the schema, so
+// generators should be given a checked schema. Check also generates a
+// concrete model for each instantiation of a generic model and each inline
+// object type, and links projected models to their sources.
+func (s *Schema) Check() []Diagnostic {
+	diagnostics := s.instantiate()
+	diagnostics = append(diagnostics, s.extractInlineObjects()...)
+	diagnostics = append(diagnostics, s.resolveProjections()...)
+	diagnostics = append(diagnostics, s.checkCycles()...)
+	for _, alias := range s.TypeAliases {
+		diagnostics = append(diagnostics, s.checkUnion(alias)...)
+	}
+	diagnostics = append(diagnostics, s.checkMapKeys()...)
+	s.resolveNullability()
+	diagnostics = append(diagnostics, s.checkDefaults()...)
+	diagnostics = append(diagnostics, s.checkDeprecations()...)
+	diagnostics = append(diagnostics, s.checkRelations()...)
+	diagnostics = append(diagnostics, s.checkIndexes()...)
+	diagnostics = append(diagnostics, s.checkComputed()...)
+	diagnostics = append(diagnostics, s.checkEntityIDs()...)
+	return diagnostics
+}
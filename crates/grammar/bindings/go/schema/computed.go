@@ -0,0 +1,212 @@
+package schema
+
+import (
+	"strings"
+
+	"github.com/larner-dev/cdm/bindings/go/expr"
+	"github.com/larner-dev/cdm/bindings/go/position"
+	"github.com/larner-dev/cdm/bindings/go/value"
+)
+
+// Computation is the `@computed { from: "..." }` config of a field whose
+// value is derived from other fields rather than written, such as
+// `average_rating: number { @computed { from: "AVG(reviews.rating)" } }`.
+// Computed fields are read-only: generators emit them as view columns,
+// generated columns or getters and leave them out of input types.
+type Computation struct {
+	// Expression as written; see package expr for the language
+	From string
+	// Parsed expression; nil when From does not parse
+	Expr expr.Expr
+	// Field paths the expression reads, in the order they first appear;
+	// set by Check
+	Dependencies []*Dependency
+	Span         position.Span
+}
+
+// Dependency is a field path a computed field reads, such as `rating`,
+// `author.name` or `reviews.rating`.
+type Dependency struct {
+	Path []string
+	// Model and name of the field the path ends at
+	Model string
+	Field string
+	// Aggregate applied to the path, such as "AVG"; empty when there is none
+	Aggregate string
+}
+
+func (d *Dependency) String() string { return strings.Join(d.Path, ".") }
+
+// Equal reports whether c and other compute the same expression, ignoring
+// differences of spelling such as keyword case and redundant parentheses.
+func (c *Computation) Equal(other *Computation) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	if c.Expr == nil || other.Expr == nil {
+		return c.From == other.From
+	}
+	return c.Expr.String() == other.Expr.String()
+}
+
+// InputFields returns the effective fields of a model that input types,
+// such as create and update DTOs, accept: all of them but the computed
+// ones. It relies on Check having recorded computations.
+func (s *Schema) InputFields(model *Model) []*Field {
+	var fields []*Field
+	for _, field := range s.Fields(model) {
+		if field.Computed == nil {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// checkComputed records the computation of every computed field and checks
+// its expression: it must parse, each path must name fields of the model,
+// going through relations for all steps but the last, and a path through a
+// relation that holds several records must be aggregated. Computed fields
+// cannot have defaults or depend on themselves.
+func (s *Schema) checkComputed() []Diagnostic {
+	var diagnostics []Diagnostic
+	for _, model := range s.Models {
+		for _, field := range model.Fields {
+			field.Computed = nil
+			config := findConfig(field.Configs, "computed")
+			if config == nil {
+				continue
+			}
+			name := model.Name + "." + field.Name
+			c, problems := decodeComputation(config, name)
+			diagnostics = append(diagnostics, problems...)
+			field.Computed = c
+			if field.Default != nil {
+				diagnostics = append(diagnostics, Errorf(CodeInvalidComputed, field.Default.Span,
+					"Computed field '%s' cannot have a default", name))
+			}
+			if c.Expr != nil && s.resolvesFields(model) {
+				diagnostics = append(diagnostics, s.resolveDependencies(model, c, name)...)
+			}
+		}
+	}
+
+	for _, model := range s.Models {
+		for _, field := range model.Fields {
+			if field.Computed != nil && s.dependsOn(model, field.Computed, field, map[*Field]bool{}) {
+				diagnostics = append(diagnostics, Errorf(CodeInvalidComputed, field.Computed.Span,
+					"Computed field '%s.%s' depends on itself", model.Name, field.Name))
+			}
+		}
+	}
+	return diagnostics
+}
+
+// decodeComputation reads a @computed config and parses its expression.
+func decodeComputation(config *Config, name string) (*Computation, []Diagnostic) {
+	c := &Computation{Span: config.Span}
+	var diagnostics []Diagnostic
+	found := false
+	for _, entry := range config.Value.Entries {
+		if entry.Key != "from" {
+			diagnostics = append(diagnostics, Errorf(CodeInvalidPluginConfig, entry.KeySpan,
+				"Invalid @computed config: unknown key '%s'; expected from", entry.Key))
+			continue
+		}
+		if entry.Value.Kind != value.String {
+			diagnostics = append(diagnostics, Errorf(CodeInvalidPluginConfig, entry.Value.Span,
+				"Invalid @computed config: from must be a string, found %s", entry.Value.Kind))
+			continue
+		}
+		found = true
+		c.From = entry.Value.Text
+		e, err := expr.Parse(c.From)
+		if err != nil {
+			diagnostics = append(diagnostics, Errorf(CodeInvalidComputed, entry.Value.Span,
+				"Invalid expression for computed field '%s': %v", name, err))
+			continue
+		}
+		c.Expr = e
+	}
+	if !found && len(diagnostics) == 0 {
+		diagnostics = append(diagnostics, Errorf(CodeInvalidPluginConfig, config.Span,
+			"Invalid @computed config: from is required"))
+	}
+	return c, diagnostics
+}
+
+// resolveDependencies resolves the paths of c against model, recording them
+// as its dependencies.
+func (s *Schema) resolveDependencies(model *Model, c *Computation, name string) []Diagnostic {
+	var diagnostics []Diagnostic
+	errorf := func(format string, args ...any) {
+		diagnostics = append(diagnostics, Errorf(CodeInvalidComputed, c.Span, format, args...))
+	}
+	seen := map[string]bool{}
+	expr.Walk(c.Expr, func(e expr.Expr, aggregate *expr.Call) {
+		path, ok := e.(*expr.Path)
+		if !ok {
+			return
+		}
+		current := model
+		for i, step := range path.Names {
+			field := s.effectiveField(current, step)
+			if field == nil {
+				errorf("'%s' in computed field '%s' is not a field of '%s'", path, name, current.Name)
+				return
+			}
+			target, many := s.relationTarget(field.FieldType())
+			if many && aggregate == nil {
+				errorf("'%s' in computed field '%s' goes through '%s.%s', which holds several records; use an aggregate such as %s",
+					path, name, current.Name, step, strings.Join(expr.Aggregates, ", "))
+				return
+			}
+			if i == len(path.Names)-1 {
+				key := path.String()
+				if aggregate != nil {
+					key = aggregate.Name + " " + key
+				}
+				if !seen[key] {
+					seen[key] = true
+					d := &Dependency{Path: path.Names, Model: current.Name, Field: field.Name}
+					if aggregate != nil {
+						d.Aggregate = aggregate.Name
+					}
+					c.Dependencies = append(c.Dependencies, d)
+				}
+				return
+			}
+			if target == nil {
+				errorf("'%s' in computed field '%s' goes through '%s.%s', which is not a relation", path, name, current.Name, step)
+				return
+			}
+			if !s.resolvesFields(target) {
+				return
+			}
+			current = target
+		}
+	})
+	return diagnostics
+}
+
+// dependsOn reports whether computation c, of a field of model, reads
+// field, directly or through other computed fields.
+func (s *Schema) dependsOn(model *Model, c *Computation, field *Field, visiting map[*Field]bool) bool {
+	for _, d := range c.Dependencies {
+		owner := s.Model(d.Model)
+		if owner == nil {
+			continue
+		}
+		dependency := s.effectiveField(owner, d.Field)
+		if dependency == field {
+			return true
+		}
+		if dependency == nil || dependency.Computed == nil || visiting[dependency] {
+			continue
+		}
+		visiting[dependency] = true
+		if s.dependsOn(owner, dependency.Computed, field, visiting) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package schema
+
+import "strings"
+
+// checkCycles reports models that extend themselves and type aliases that
+// refer to themselves, directly or through others (E102). Each cycle is
+// reported once, at the first of its definitions in declaration order.
+func (s *Schema) checkCycles() []Diagnostic {
+	models := map[string]*Model{}
+	for _, model := range s.Models {
+		if _, ok := models[model.Name]; !ok {
+			models[model.Name] = model
+		}
+	}
+	aliases := map[string]*TypeAlias{}
+	for _, alias := range s.TypeAliases {
+		if _, ok := aliases[alias.Name]; !ok {
+			aliases[alias.Name] = alias
+		}
+	}
+
+	var diagnostics []Diagnostic
+	inheritance := cycleFinder{
+		edges: func(name string) []string {
+			var parents []string
+			if model := models[name]; model != nil {
+				for _, parent := range model.Parents {
+					parents = append(parents, parent.Name)
+				}
+			}
+			return parents
+		},
+		report: func(cycle []string) {
+			diagnostics = append(diagnostics, Errorf(CodeCircularInheritance, models[cycle[0]].NameSpan,
+				"Circular inheritance detected: %s", strings.Join(cycle, " -> ")))
+		},
+	}
+	for _, model := range s.Models {
+		inheritance.visit(model.Name)
+	}
+
+	references := cycleFinder{
+		edges: func(name string) []string {
+			var names []string
+			if alias := aliases[name]; alias != nil && alias.Type != nil {
+				alias.Type.Walk(func(t *TypeExpr) {
+					if t.Kind == Identifier && !t.Inline && aliases[t.Name] != nil {
+						names = append(names, t.Name)
+					}
+				})
+			}
+			return names
+		},
+		report: func(cycle []string) {
+			diagnostics = append(diagnostics, Errorf(CodeCircularInheritance, aliases[cycle[0]].NameSpan,
+				"Circular type reference detected: %s", strings.Join(cycle, " -> ")))
+		},
+	}
+	for _, alias := range s.TypeAliases {
+		references.visit(alias.Name)
+	}
+	return diagnostics
+}
+
+// cycleFinder walks a graph of named definitions depth first and reports
+// each cycle it closes, as the path from the definition it returns to back
+// to that definition.
+type cycleFinder struct {
+	edges  func(name string) []string
+	report func(cycle []string)
+	path   []string
+	// 1 while a definition is on the path, 2 once it is done
+	state map[string]int
+}
+
+func (f *cycleFinder) visit(name string) {
+	if f.state == nil {
+		f.state = map[string]int{}
+	}
+	switch f.state[name] {
+	case 1:
+		for i, n := range f.path {
+			if n == name {
+				f.report(append(append([]string(nil), f.path[i:]...), name))
+				return
+			}
+		}
+	case 2:
+		return
+	}
+	f.state[name] = 1
+	f.path = append(f.path, name)
+	for _, next := range f.edges(name) {
+		f.visit(next)
+	}
+	f.path = f.path[:len(f.path)-1]
+	f.state[name] = 2
+}
@@ -0,0 +1,303 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/larner-dev/cdm/bindings/go/position"
+	"github.com/larner-dev/cdm/bindings/go/value"
+)
+
+// Mismatch describes where a value fails to match a type.
+type Mismatch struct {
+	// Span of the offending part of the value
+	Span position.Span
+	// Path to the offending part, such as `[2]` or `.address.city`; empty
+	// when the whole value is wrong
+	Path     string
+	Expected string
+	Found    string
+}
+
+func (m *Mismatch) Error() string {
+	if m.Path == "" {
+		return fmt.Sprintf("expected %s, found %s", m.Expected, m.Found)
+	}
+	return fmt.Sprintf("%s: expected %s, found %s", m.Path, m.Expected, m.Found)
+}
+
+// checkDefaults type-checks every field default against its field type. A
+// null default is only valid for nullable types, optional fields included.
+func (s *Schema) checkDefaults() []Diagnostic {
+	var diagnostics []Diagnostic
+	for _, model := range s.Models {
+		for _, field := range model.Fields {
+			if field.Default == nil {
+				continue
+			}
+			if call := field.Default; call.Kind == value.Call {
+				if _, ok := s.functions().Lookup(call.Text); !ok {
+					diagnostics = append(diagnostics, Errorf(CodeUnknownFunction, call.Span,
+						"Unknown default function '%s()' for field '%s.%s'; available functions: %s",
+						call.Text, model.Name, field.Name, strings.Join(s.functions().Names(), ", ")))
+					continue
+				}
+			}
+			if mismatch := s.Match(*field.Default, field.FieldType()); mismatch != nil {
+				diagnostics = append(diagnostics, Errorf(CodeInvalidDefault, mismatch.Span,
+					"Invalid default for field '%s.%s': %s", model.Name, field.Name, mismatch))
+			}
+		}
+	}
+	return diagnostics
+}
+
+// Match checks v against t, following type aliases and models defined in
+// s. It returns nil when v is a valid value of t. Types s cannot resolve,
+// such as template imports, and `JSON` accept any value.
+func (s *Schema) Match(v value.Value, t *TypeExpr) *Mismatch {
+	return s.match(v, t, "", map[string]bool{})
+}
+
+func (s *Schema) match(v value.Value, t *TypeExpr, path string, aliases map[string]bool) *Mismatch {
+	mismatch := func(expected string) *Mismatch {
+		return &Mismatch{Span: v.Span, Path: path, Expected: expected, Found: describe(v)}
+	}
+
+	if v.Kind == value.Call {
+		f, ok := s.functions().Lookup(v.Text)
+		if !ok {
+			return mismatch("a registered default function")
+		}
+		if !s.produces(t, f.Returns.Name, map[string]bool{}) {
+			return &Mismatch{Span: v.Span, Path: path, Expected: t.String(), Found: fmt.Sprintf("%s (%s)", describe(v), f.Returns)}
+		}
+		return nil
+	}
+
+	switch t.Kind {
+	case StringLiteral, NumberLiteral, BooleanLiteral:
+		if !value.Equal(v, t.Literal) {
+			return mismatch(t.String())
+		}
+		return nil
+
+	case Null:
+		if v.Kind != value.Null {
+			return mismatch("null")
+		}
+		return nil
+
+	case Array:
+		if v.Kind != value.Array {
+			return mismatch(t.String())
+		}
+		for i, item := range v.Items {
+			if m := s.match(item, t.Element, fmt.Sprintf("%s[%d]", path, i), aliases); m != nil {
+				return m
+			}
+		}
+		return nil
+
+	case Map:
+		if v.Kind != value.Object {
+			return mismatch(t.String())
+		}
+		for _, entry := range v.Entries {
+			if !s.matchKey(entry.Key, t.Key, map[string]bool{}) {
+				return &Mismatch{
+					Span:     entry.KeySpan,
+					Path:     path,
+					Expected: "key of type " + t.Key.String(),
+					Found:    "key " + describeKey(entry.Key),
+				}
+			}
+			if m := s.match(entry.Value, t.Element, path+memberPath(entry.Key), aliases); m != nil {
+				return m
+			}
+		}
+		return nil
+
+	case Union:
+		for _, member := range t.Members {
+			if s.match(v, member, path, aliases) == nil {
+				return nil
+			}
+		}
+		return mismatch(t.String())
+	}
+
+	switch t.Name {
+	case "string":
+		if v.Kind != value.String {
+			return mismatch("string")
+		}
+		return nil
+	case "number":
+		if v.Kind != value.Number {
+			return mismatch("number")
+		}
+		return nil
+	case "boolean":
+		if v.Kind != value.Bool {
+			return mismatch("boolean")
+		}
+		return nil
+	case "JSON":
+		return nil
+	case "Model", "Type":
+		if v.Kind != value.Reference && v.Kind != value.String {
+			return mismatch("a " + strings.ToLower(t.Name) + " name")
+		}
+		return nil
+	}
+
+	if alias := s.TypeAlias(t.Name); alias != nil {
+		if aliases[alias.Name] {
+			return nil
+		}
+		aliases[alias.Name] = true
+		defer delete(aliases, alias.Name)
+		if m := s.match(v, alias.Type, path, aliases); m != nil {
+			if m.Path == path && m.Span == v.Span {
+				m.Expected = fmt.Sprintf("%s (%s)", alias.Name, m.Expected)
+			}
+			return m
+		}
+		return nil
+	}
+
+	if model := s.Model(t.Name); model != nil {
+		return s.matchModel(v, model, path, aliases)
+	}
+	return nil
+}
+
+// produces reports whether every value of the built-in type named builtin
+// is a value of t. It decides whether a function call default, which can
+// return any value of its declared type, fits the field it initializes.
+func (s *Schema) produces(t *TypeExpr, builtin string, aliases map[string]bool) bool {
+	switch t.Kind {
+	case Union:
+		for _, member := range t.Members {
+			if s.produces(member, builtin, aliases) {
+				return true
+			}
+		}
+		return false
+	case Identifier:
+		if t.Name == builtin || t.Name == "JSON" {
+			return true
+		}
+		if alias := s.TypeAlias(t.Name); alias != nil {
+			if aliases[alias.Name] {
+				return true
+			}
+			aliases[alias.Name] = true
+			return s.produces(alias.Type, builtin, aliases)
+		}
+		// Other built-ins and models are different types; names defined
+		// elsewhere are accepted.
+		return !builtins[t.Name] && s.Model(t.Name) == nil
+	}
+	return false
+}
+
+// matchModel checks an object literal against the effective fields of a
+// model. Unknown keys and missing required fields without defaults are
+// mismatches. Optional fields may be left out, but only nullable ones may be
+// null.
+func (s *Schema) matchModel(v value.Value, model *Model, path string, aliases map[string]bool) *Mismatch {
+	if v.Kind != value.Object {
+		return &Mismatch{Span: v.Span, Path: path, Expected: model.Name, Found: describe(v)}
+	}
+
+	fields := s.Fields(model)
+	for _, entry := range v.Entries {
+		var field *Field
+		for _, candidate := range fields {
+			if candidate.Name == entry.Key {
+				field = candidate
+				break
+			}
+		}
+		if field == nil {
+			return &Mismatch{Span: entry.KeySpan, Path: path, Expected: "a field of " + model.Name, Found: "unknown field " + describeKey(entry.Key)}
+		}
+		if m := s.match(entry.Value, field.FieldType(), path+memberPath(entry.Key), aliases); m != nil {
+			return m
+		}
+	}
+	for _, field := range fields {
+		if _, ok := v.Get(field.Name); !ok && !field.Optional && field.Default == nil {
+			return &Mismatch{Span: v.Span, Path: path, Expected: fmt.Sprintf("field '%s' of %s", field.Name, model.Name), Found: "no value"}
+		}
+	}
+	return nil
+}
+
+// matchKey checks an object key against a map key type. Keys are always
+// strings in source, so number key types accept keys that spell a number.
+func (s *Schema) matchKey(key string, t *TypeExpr, aliases map[string]bool) bool {
+	switch t.Kind {
+	case StringLiteral:
+		return key == t.Literal.Text
+	case NumberLiteral:
+		_, ok := value.Decimal(key).Rat()
+		return ok && value.Decimal(key).Cmp(t.Literal.Number) == 0
+	case Union:
+		for _, member := range t.Members {
+			if s.matchKey(key, member, aliases) {
+				return true
+			}
+		}
+		return false
+	case Identifier:
+		switch t.Name {
+		case "string":
+			return true
+		case "number":
+			_, ok := value.Decimal(key).Rat()
+			return ok
+		}
+		if alias := s.TypeAlias(t.Name); alias != nil && !aliases[alias.Name] {
+			aliases[alias.Name] = true
+			return s.matchKey(key, alias.Type, aliases)
+		}
+		// Models cannot be keys; types defined elsewhere are accepted.
+		return s.Model(t.Name) == nil
+	}
+	return false
+}
+
+// describe renders a value for a diagnostic: scalars are shown as written,
+// containers by kind.
+func describe(v value.Value) string {
+	switch v.Kind {
+	case value.String, value.Number, value.Bool:
+		return v.String()
+	case value.Null:
+		return "null"
+	case value.Reference:
+		return "reference " + v.Text
+	case value.Call:
+		return v.String()
+	}
+	return v.Kind.String()
+}
+
+func describeKey(key string) string {
+	return value.Value{Kind: value.String, Text: key}.String()
+}
+
+func memberPath(key string) string {
+	if key == "" {
+		return `[""]`
+	}
+	for i, c := range key {
+		if !(c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || i > 0 && c >= '0' && c <= '9') {
+			return "[" + describeKey(key) + "]"
+		}
+	}
+	return "." + key
+}
@@ -0,0 +1,294 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/larner-dev/cdm/bindings/go/position"
+)
+
+// Deprecation is a `@deprecated { reason, since, replacement }` config on a
+// type alias, model or field. Every key is optional, so `@deprecated {}`
+// simply marks the definition deprecated.
+type Deprecation struct {
+	// Why the definition is deprecated
+	Reason string
+	// Version the definition was deprecated in, such as "2.3"
+	Since string
+	// Name of the field, model or type alias to use instead
+	Replacement string
+	Span        position.Span
+}
+
+// Equal reports whether d and other describe the same deprecation. Either
+// may be nil.
+func (d *Deprecation) Equal(other *Deprecation) bool {
+	if d == nil || other == nil {
+		return d == other
+	}
+	return d.Reason == other.Reason && d.Since == other.Since && d.Replacement == other.Replacement
+}
+
+// Describe renders the deprecation of the named definition as a sentence,
+// such as `'Email' is deprecated since 2.3: too loose (use 'EmailAddress'
+// instead)`.
+func (d *Deprecation) Describe(name string) string {
+	return fmt.Sprintf("'%s' is deprecated%s", name, d.Details())
+}
+
+// Details renders the optional parts of the deprecation, such as
+// ` since 2.3: too loose (use 'EmailAddress' instead)`, with a leading space
+// or colon as needed.
+func (d *Deprecation) Details() string {
+	var b strings.Builder
+	if d.Since != "" {
+		fmt.Fprintf(&b, " since %s", d.Since)
+	}
+	if d.Reason != "" {
+		fmt.Fprintf(&b, ": %s", d.Reason)
+	}
+	if d.Replacement != "" {
+		fmt.Fprintf(&b, " (use '%s' instead)", d.Replacement)
+	}
+	return b.String()
+}
+
+// Deprecation returns the alias's @deprecated config, or nil.
+func (a *TypeAlias) Deprecation() *Deprecation { return deprecationOf(a.Configs) }
+
+// Deprecation returns the model's @deprecated config, or nil.
+func (m *Model) Deprecation() *Deprecation { return deprecationOf(m.Configs) }
+
+// Deprecation returns the field's own @deprecated config, or nil. Use
+// Schema.FieldDeprecation to include field overrides in child models.
+func (f *Field) Deprecation() *Deprecation { return deprecationOf(f.Configs) }
+
+// FieldDeprecation returns the deprecation of the named field as seen from
+// model: an override in model or its nearest ancestor wins over the config
+// on the field itself. It returns nil when the field is not deprecated.
+func (s *Schema) FieldDeprecation(model *Model, name string) *Deprecation {
+	if d := s.overrideDeprecation(model, name, s.Model, map[string]bool{}); d != nil {
+		return d
+	}
+	for _, field := range s.Fields(model) {
+		if field.Name == name {
+			return field.Deprecation()
+		}
+	}
+	return nil
+}
+
+// FieldDeprecations returns FieldDeprecation for each deprecated field of
+// model, by field name. For a model deep in an extends chain it is much
+// cheaper than calling FieldDeprecation field by field.
+func (s *Schema) FieldDeprecations(model *Model) map[string]*Deprecation {
+	var models map[string]*Model
+	lookup := func(name string) *Model {
+		if models == nil {
+			models = make(map[string]*Model, len(s.Models))
+			for i := len(s.Models) - 1; i >= 0; i-- {
+				models[s.Models[i].Name] = s.Models[i]
+			}
+		}
+		return models[name]
+	}
+
+	deprecations := map[string]*Deprecation{}
+	for _, field := range s.Fields(model) {
+		d := s.overrideDeprecation(model, field.Name, lookup, map[string]bool{})
+		if d == nil {
+			d = field.Deprecation()
+		}
+		if d != nil {
+			deprecations[field.Name] = d
+		}
+	}
+	return deprecations
+}
+
+// overrideDeprecation looks parents up with lookup, which returns nil for
+// models not defined in s.
+func (s *Schema) overrideDeprecation(model *Model, name string, lookup func(string) *Model, visiting map[string]bool) *Deprecation {
+	if visiting[model.Name] {
+		return nil
+	}
+	visiting[model.Name] = true
+
+	if own := model.Field(name); own != nil {
+		return nil
+	}
+	for _, override := range model.Overrides {
+		if override.Name == name {
+			if d := deprecationOf(override.Configs); d != nil {
+				return d
+			}
+		}
+	}
+	for _, parent := range model.Parents {
+		if parentModel := lookup(parent.Name); parentModel != nil {
+			if d := s.overrideDeprecation(parentModel, name, lookup, visiting); d != nil {
+				return d
+			}
+		}
+	}
+	return nil
+}
+
+func deprecationOf(configs []*Config) *Deprecation {
+	config := findConfig(configs, "deprecated")
+	if config == nil {
+		return nil
+	}
+	d, _ := decodeDeprecation(config)
+	return d
+}
+
+// decodeDeprecation reads a @deprecated config, skipping and reporting
+// entries that are not one of the three string keys.
+func decodeDeprecation(config *Config) (*Deprecation, []Diagnostic) {
+	d := &Deprecation{Span: config.Span}
+	diagnostics := decodeStrings(config,
+		stringKey{name: "reason", target: &d.Reason},
+		stringKey{name: "since", target: &d.Since},
+		stringKey{name: "replacement", target: &d.Replacement})
+	return d, diagnostics
+}
+
+// checkDeprecations reports malformed @deprecated configs and warns about
+// every reference to a deprecated type alias or model.
+func (s *Schema) checkDeprecations() []Diagnostic {
+	var diagnostics []Diagnostic
+	decode := func(configs []*Config) {
+		if config := findConfig(configs, "deprecated"); config != nil {
+			_, problems := decodeDeprecation(config)
+			diagnostics = append(diagnostics, problems...)
+		}
+	}
+
+	for _, alias := range s.TypeAliases {
+		decode(alias.Configs)
+	}
+	for _, model := range s.declaredModels() {
+		decode(model.Configs)
+		for _, field := range model.Fields {
+			decode(field.Configs)
+			if d := field.Deprecation(); d != nil && d.Replacement != "" && s.resolvesFields(model) {
+				if !hasField(s.Fields(model), d.Replacement) {
+					diagnostics = append(diagnostics, Errorf(CodeInvalidPluginConfig, d.Span,
+						"Invalid @deprecated config: replacement '%s' is not a field of '%s'", d.Replacement, model.Name))
+				}
+			}
+		}
+		for _, override := range model.Overrides {
+			decode(override.Configs)
+		}
+	}
+	return append(diagnostics, s.deprecatedReferences(s, nil)...)
+}
+
+// InheritedDeprecations warns about the references in file to type aliases
+// and models that the files it extends deprecate, where s is the schema
+// file resolves to as a context. Check warns about references to the
+// definitions file deprecates itself, so those are left out.
+func (s *Schema) InheritedDeprecations(file *Schema) []Diagnostic {
+	return s.deprecatedReferences(file, func(name string) bool {
+		return file.deprecatedReference(name, position.Span{}) != nil
+	})
+}
+
+// deprecatedReferences warns about every reference in the definitions of
+// file to a type alias or model s deprecates, except names skip reports.
+// References made from inside a definition s deprecates are not reported,
+// so a deprecated model may keep using other deprecated types.
+func (s *Schema) deprecatedReferences(file *Schema, skip func(name string) bool) []Diagnostic {
+	var diagnostics []Diagnostic
+	warn := func(name string, span position.Span) {
+		if skip == nil || !skip(name) {
+			diagnostics = append(diagnostics, s.deprecatedReference(name, span)...)
+		}
+	}
+	warnType := func(t *TypeExpr) {
+		t.Walk(func(t *TypeExpr) {
+			if t.Kind == Identifier {
+				warn(t.genericName(), t.Span)
+			}
+		})
+	}
+
+	for _, alias := range file.TypeAliases {
+		if s.deprecatedReference(alias.Name, position.Span{}) == nil && alias.Deprecation() == nil {
+			warnType(alias.Type)
+		}
+	}
+	for _, model := range file.declaredModels() {
+		if s.deprecatedReference(model.Name, position.Span{}) != nil || model.Deprecation() != nil {
+			continue
+		}
+		for _, parent := range model.Parents {
+			warn(parent.Name, parent.Span)
+		}
+		for _, field := range model.Fields {
+			if field.Deprecation() == nil && field.Type != nil {
+				warnType(field.Type)
+			}
+		}
+	}
+	return diagnostics
+}
+
+// declaredModels returns the models and generic models s declares. Generated
+// models repeat their generic model's fields and configs, which are checked
+// once on the generic model.
+func (s *Schema) declaredModels() []*Model {
+	var models []*Model
+	for _, model := range s.Models {
+		if model.Instance == nil {
+			models = append(models, model)
+		}
+	}
+	return append(models, s.Generics...)
+}
+
+func (s *Schema) deprecatedReference(name string, span position.Span) []Diagnostic {
+	var d *Deprecation
+	if alias := s.TypeAlias(name); alias != nil {
+		d = alias.Deprecation()
+	} else if model := s.Model(name); model != nil {
+		d = model.Deprecation()
+	} else if generic := s.Generic(name); generic != nil {
+		d = generic.Deprecation()
+	}
+	if d == nil {
+		return nil
+	}
+	return []Diagnostic{Warnf(CodeDeprecatedReference, span, "%s", d.Describe(name))}
+}
+
+// resolvesFields reports whether every ancestor of model is defined in this
+// file, so that Fields(model) is complete.
+func (s *Schema) resolvesFields(model *Model) bool {
+	return s.resolves(model, map[string]bool{})
+}
+
+func (s *Schema) resolves(model *Model, visiting map[string]bool) bool {
+	if visiting[model.Name] {
+		return true
+	}
+	visiting[model.Name] = true
+	for _, parent := range model.Parents {
+		parentModel := s.Model(parent.Name)
+		if parentModel == nil || !s.resolves(parentModel, visiting) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasField(fields []*Field, name string) bool {
+	for _, field := range fields {
+		if field.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/larner-dev/cdm/bindings/go/position"
+)
+
+// Severity is the level of a Diagnostic.
+type Severity int
+
+const (
+	Error Severity = iota
+	Warning
+)
+
+func (s Severity) String() string {
+	if s == Warning {
+		return "warning"
+	}
+	return "error"
+}
+
+// Error and warning codes reported by this package and the packages built on
+// it. They extend the catalog in Appendix B of the specification; codes the
+// Rust validator already assigns keep their meaning.
+const (
+	// E402: a plugin config does not match the plugin's settings schema.
+	CodeInvalidPluginConfig = "E402"
+
+	// E102: a model extends itself or a type alias refers to itself,
+	// directly or through other definitions.
+	CodeCircularInheritance = "E102"
+	// E105: a union of models has no usable discriminator field.
+	CodeMissingDiscriminator = "E105"
+	// E106: two members of a discriminated union share a discriminator value.
+	CodeDuplicateDiscriminator = "E106"
+	// E107: a field default does not match the field's type.
+	CodeInvalidDefault = "E107"
+	// E108: a field default calls a function that is not registered.
+	CodeUnknownFunction = "E108"
+	// E109: a map key type is not string, number or a union of literals.
+	CodeInvalidMapKey = "E109"
+	// E110: a @validation constraint is malformed or cannot apply to the
+	// type it is attached to.
+	CodeInvalidConstraint = "E110"
+	// E111: a classified field lacks a tag its classification policy
+	// requires, such as a retention period for personal data.
+	CodeMissingRetention = "E111"
+	// E112: a generic model is declared or instantiated incorrectly, such as
+	// with the wrong number of type arguments.
+	CodeInvalidGeneric = "E112"
+	// E113: a projection names a source that cannot be projected or a field
+	// its source does not have.
+	CodeInvalidProjection = "E113"
+	// E114: the model name generated for an inline object type is already
+	// taken.
+	CodeInvalidInlineObject = "E114"
+	// E115: a @relation config does not fit its field, or its inverse does not
+	// agree with it.
+	CodeInvalidRelation = "E115"
+	// E116: an index is declared twice or lists a field its model does not
+	// have.
+	CodeInvalidIndex = "E116"
+	// E117: a computed field's expression does not parse or reads a field
+	// its model cannot reach.
+	CodeInvalidComputed = "E117"
+
+	// E301: a context extends itself, directly or through other contexts.
+	CodeCircularExtends = "E301"
+	// E304: a file named by an extends directive cannot be loaded.
+	CodeExtendsNotFound = "E304"
+
+	// E501: two type aliases or models share an entity ID within its scope.
+	CodeDuplicateEntityID = "E501"
+	// E502: two fields of a model share an entity ID within its scope.
+	CodeDuplicateFieldID = "E502"
+	// E503: an entity takes an ID the ID ledger records as deleted.
+	CodeReusedEntityID = "E503"
+	// E505: the ID ledger does not record an entity ID a file uses, records
+	// one no file uses, reserves overlapping ranges, or cannot allocate one.
+	CodeUnrecordedEntityID = "E505"
+
+	// E601: a template named by an import cannot be loaded.
+	CodeTemplateNotFound = "E601"
+	// E604: templates re-export a name from one another in a cycle.
+	CodeCircularTemplate = "E604"
+	// E605: a name is selectively imported twice, or is imported and also
+	// defined in the importing file.
+	CodeDuplicateImport = "E605"
+	// E609: a selective import lists a name its template does not export.
+	CodeUnknownImport = "E609"
+
+	// W007: a deprecated type alias or model is referenced.
+	CodeDeprecatedReference = "W007"
+	// W103: a selectively imported name is never referenced.
+	CodeUnusedImport = "W103"
+)
+
+// Diagnostic is an error or warning tied to a span of the source.
+type Diagnostic struct {
+	// Code is the catalog code, such as "E105". Syntax errors have no code.
+	Code     string
+	Severity Severity
+	Message  string
+	Span     position.Span
+}
+
+// String formats the diagnostic the way the cdm CLI does:
+// `error[3:5]: E105: message`, with 1-based line and column.
+func (d Diagnostic) String() string {
+	message := d.Message
+	if d.Code != "" {
+		message = d.Code + ": " + message
+	}
+	return fmt.Sprintf("%s[%d:%d]: %s", d.Severity, d.Span.Start.Row+1, d.Span.Start.Column+1, message)
+}
+
+// HasErrors reports whether any diagnostic is an error.
+func HasErrors(diagnostics []Diagnostic) bool {
+	for _, d := range diagnostics {
+		if d.Severity == Error {
+			return true
+		}
+	}
+	return false
+}
+
+// Errorf returns an error diagnostic with a formatted message.
+func Errorf(code string, span position.Span, format string, args ...any) Diagnostic {
+	return Diagnostic{Code: code, Severity: Error, Message: fmt.Sprintf(format, args...), Span: span}
+}
+
+// Warnf returns a warning diagnostic with a formatted message.
+func Warnf(code string, span position.Span, format string, args ...any) Diagnostic {
+	return Diagnostic{Code: code, Severity: Warning, Message: fmt.Sprintf(format, args...), Span: span}
+}
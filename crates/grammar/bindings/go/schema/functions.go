@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Function is a function that may be called in a field default, such as
+// `created_at: string = now()`. Calls take no arguments and are evaluated
+// by the database or application when a record is created.
+type Function struct {
+	Name string
+	// Type of the value the function produces
+	Returns *TypeExpr
+	// Plugin that contributed the function; empty for built-ins
+	Plugin string
+}
+
+// Functions is a registry of default functions.
+type Functions struct {
+	byName map[string]Function
+}
+
+// BuiltinFunctions returns a registry holding the functions every CDM
+// schema may call: `now()` for the current timestamp as an ISO 8601
+// string, `uuid()` for a random UUID string, and `autoincrement()` for the
+// next integer in a sequence.
+func BuiltinFunctions() *Functions {
+	r := &Functions{byName: map[string]Function{}}
+	for _, f := range []Function{
+		{Name: "now", Returns: Named("string")},
+		{Name: "uuid", Returns: Named("string")},
+		{Name: "autoincrement", Returns: Named("number")},
+	} {
+		r.byName[f.Name] = f
+	}
+	return r
+}
+
+// Register adds a plugin-contributed function. It is an error to register a
+// name that is already taken, whether by a built-in or by another plugin.
+func (r *Functions) Register(f Function) error {
+	if f.Name == "" || f.Returns == nil {
+		return fmt.Errorf("function must have a name and a return type")
+	}
+	if existing, ok := r.byName[f.Name]; ok {
+		owner := "a built-in function"
+		if existing.Plugin != "" {
+			owner = fmt.Sprintf("plugin '%s'", existing.Plugin)
+		}
+		return fmt.Errorf("function '%s()' is already provided by %s", f.Name, owner)
+	}
+	r.byName[f.Name] = f
+	return nil
+}
+
+// Lookup returns the function named name.
+func (r *Functions) Lookup(name string) (Function, bool) {
+	f, ok := r.byName[name]
+	return f, ok
+}
+
+// Names returns the registered function names in sorted order.
+func (r *Functions) Names() []string {
+	names := make([]string, 0, len(r.byName))
+	for name := range r.byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *Schema) functions() *Functions {
+	if s.Functions == nil {
+		s.Functions = BuiltinFunctions()
+	}
+	return s.Functions
+}
@@ -0,0 +1,420 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/larner-dev/cdm/bindings/go/position"
+)
+
+// maxInstanceDepth bounds how deeply instantiations may generate further
+// instantiations, so that a generic model whose fields instantiate it with
+// ever larger arguments, such as `Nest<T> { inner: Nest<T[]> }`, is reported
+// instead of expanding forever.
+const maxInstanceDepth = 16
+
+// Instance records what a generated model was instantiated from.
+type Instance struct {
+	// Name of the generic model
+	Generic string
+	// Type arguments, in parameter order
+	Args []*TypeExpr
+	// Key identifies the instantiation across versions of a schema. It is
+	// built from the entity IDs of the generic model and of its arguments,
+	// such as `#12<#10>` for `Page<User>` with `Page #12` and `User #10`.
+	// Definitions without IDs and built-in types contribute their names.
+	Key string
+}
+
+// String returns the instantiation, such as `Page<User[]>`.
+func (i *Instance) String() string {
+	args := make([]string, len(i.Args))
+	for j, arg := range i.Args {
+		args[j] = arg.String()
+	}
+	return i.Generic + "<" + strings.Join(args, ", ") + ">"
+}
+
+// IsGeneric reports whether m declares type parameters.
+func (m *Model) IsGeneric() bool {
+	return len(m.Params) > 0
+}
+
+// Generic returns the generic model named name, or nil.
+func (s *Schema) Generic(name string) *Model {
+	for _, model := range s.Generics {
+		if model.Name == name {
+			return model
+		}
+	}
+	return nil
+}
+
+// genericName returns the name of the generic model t instantiates, or its
+// plain name when Check has not resolved it yet.
+func (t *TypeExpr) genericName() string {
+	if t.Generic != "" {
+		return t.Generic
+	}
+	return t.Name
+}
+
+// instantiate generates a concrete model for every distinct instantiation
+// of a generic model and points the type expressions that instantiate it at
+// the generated model. Generated models are appended to Models in the order
+// they are first used, and models they need in turn follow them. Models
+// generated by an earlier Check are dropped first, so checking twice is
+// harmless.
+//
+// A generated model is named after the generic model and its arguments
+// (`Page<User>` becomes `Page_User`, `Page<User[]>` becomes `Page_UserList`),
+// shares the generic model's parents, configs and field IDs, and has no
+// entity ID of its own; Instance.Key identifies it instead. Two different
+// instantiations that would get the same name, such as `Page<User[]>` and
+// `Page<UserList>`, are reported rather than merged.
+func (s *Schema) instantiate() []Diagnostic {
+	concrete := s.Models[:0:0]
+	for _, model := range s.Models {
+		if model.Instance == nil {
+			concrete = append(concrete, model)
+		}
+	}
+	s.Models = concrete
+
+	in := &instantiator{schema: s, depth: map[string]int{}}
+	for _, generic := range s.Generics {
+		in.checkParams(generic)
+		for _, field := range generic.Fields {
+			in.validate(field.Type)
+		}
+	}
+	for _, model := range concrete {
+		for _, parent := range model.Parents {
+			if s.Generic(parent.Name) != nil {
+				in.errorf(parent.Span, "Model '%s' cannot extend generic model '%s'", model.Name, parent.Name)
+			}
+		}
+	}
+
+	for _, alias := range s.TypeAliases {
+		in.resolve(alias.Type, 0, true)
+	}
+	for _, model := range concrete {
+		for _, field := range model.Fields {
+			in.resolve(field.Type, 0, true)
+		}
+	}
+	// Generated fields are substituted copies of generic fields that have
+	// already been validated, so only problems new to them are reported.
+	for i := len(concrete); i < len(s.Models); i++ {
+		model := s.Models[i]
+		for _, field := range model.Fields {
+			in.resolve(field.Type, in.depth[model.Name], false)
+		}
+	}
+	return in.diagnostics
+}
+
+type instantiator struct {
+	schema *Schema
+	// How many instantiations led to each generated model
+	depth       map[string]int
+	diagnostics []Diagnostic
+}
+
+func (in *instantiator) errorf(span position.Span, format string, args ...any) {
+	in.diagnostics = append(in.diagnostics, Errorf(CodeInvalidGeneric, span, format, args...))
+}
+
+// checkParams reports duplicate type parameters, parameters that hide a
+// type the generic model's fields could otherwise refer to, and generic
+// parents.
+func (in *instantiator) checkParams(generic *Model) {
+	s := in.schema
+	seen := map[string]bool{}
+	for _, param := range generic.Params {
+		switch {
+		case seen[param.Name]:
+			in.errorf(param.Span, "Duplicate type parameter '%s' in '%s'", param.Name, generic.Name)
+		case builtins[param.Name], s.TypeAlias(param.Name) != nil, s.Model(param.Name) != nil, s.Generic(param.Name) != nil:
+			in.errorf(param.Span, "Type parameter '%s' of '%s' hides the type '%s'", param.Name, generic.Name, param.Name)
+		}
+		seen[param.Name] = true
+	}
+	for _, parent := range generic.Parents {
+		if s.Generic(parent.Name) != nil {
+			in.errorf(parent.Span, "Model '%s' cannot extend generic model '%s'", generic.Name, parent.Name)
+		}
+	}
+}
+
+// validate reports invalid instantiations in a field of a generic model
+// without instantiating them, since their arguments may be type parameters.
+func (in *instantiator) validate(t *TypeExpr) {
+	if t == nil {
+		return
+	}
+	t.Walk(func(t *TypeExpr) {
+		_, problems := in.schema.instantiation(t)
+		in.diagnostics = append(in.diagnostics, problems...)
+		for _, field := range t.Fields {
+			in.validate(field.Type)
+		}
+	})
+}
+
+// resolve instantiates every generic model t refers to, including in the
+// fields of inline object types, which are extracted into models only
+// after instantiation. depth is the number of instantiations that led to t.
+func (in *instantiator) resolve(t *TypeExpr, depth int, report bool) {
+	if t == nil {
+		return
+	}
+	s := in.schema
+	t.Walk(func(t *TypeExpr) {
+		for _, field := range t.Fields {
+			in.resolve(field.Type, depth, report)
+		}
+		generic, problems := s.instantiation(t)
+		if report {
+			in.diagnostics = append(in.diagnostics, problems...)
+		}
+		if generic == nil {
+			return
+		}
+
+		name := instanceName(t)
+		if model := s.Model(name); model != nil && model.Instance != nil {
+			if model.Instance.Key != s.instanceKey(t) {
+				in.errorf(t.Span, "'%s' and '%s' both generate the model '%s'; declare a type alias for one of the arguments", t, model.Instance, name)
+				return
+			}
+			t.Generic, t.Name = generic.Name, name
+			return
+		}
+		if s.Model(name) != nil || s.TypeAlias(name) != nil {
+			in.errorf(t.Span, "'%s' generates the model '%s', which is already defined", t, name)
+			return
+		}
+		if depth >= maxInstanceDepth {
+			in.errorf(t.Span, "Instantiating '%s' does not terminate: '%s' is instantiated with ever larger type arguments", t, generic.Name)
+			return
+		}
+
+		s.Models = append(s.Models, generic.instance(name, t.Args, s.instanceKey(t)))
+		in.depth[name] = depth + 1
+		t.Generic, t.Name = generic.Name, name
+	})
+}
+
+// instantiation returns the generic model t instantiates, or the problems
+// that prevent instantiating it. Types that name a generic model without
+// arguments, or pass arguments to a type that takes none, are reported.
+// Generic models that are not defined in this file, such as template
+// imports, are left alone.
+func (s *Schema) instantiation(t *TypeExpr) (*Model, []Diagnostic) {
+	if t.Kind != Identifier {
+		return nil, nil
+	}
+	if len(t.Args) == 0 {
+		if generic := s.Generic(t.Name); generic != nil {
+			return nil, []Diagnostic{Errorf(CodeInvalidGeneric, t.Span,
+				"Generic model '%s' needs %s, as in '%s<%s>'", generic.Name, typeArguments(len(generic.Params)), generic.Name, paramList(generic))}
+		}
+		return nil, nil
+	}
+
+	name := t.genericName()
+	generic := s.Generic(name)
+	if generic == nil {
+		if builtins[name] || s.TypeAlias(name) != nil || s.Model(name) != nil {
+			return nil, []Diagnostic{Errorf(CodeInvalidGeneric, t.Span, "'%s' is not a generic model and takes no type arguments", name)}
+		}
+		return nil, nil
+	}
+	if len(t.Args) != len(generic.Params) {
+		return nil, []Diagnostic{Errorf(CodeInvalidGeneric, t.Span,
+			"Generic model '%s<%s>' takes %s, found %d", generic.Name, paramList(generic), typeArguments(len(generic.Params)), len(t.Args))}
+	}
+
+	var problems []Diagnostic
+	for _, arg := range t.Args {
+		if part := unnamedPart(arg); part != nil {
+			problems = append(problems, Errorf(CodeInvalidGeneric, part.Span,
+				"Type argument '%s' of '%s' must be a named type, array, map or instantiation; declare a type alias for '%s'", arg, generic.Name, part))
+		}
+	}
+	if len(problems) > 0 {
+		return nil, problems
+	}
+	return generic, nil
+}
+
+// unnamedPart returns the first union, literal or inline object in a type
+// argument. Those have no name to generate a model name from.
+func unnamedPart(t *TypeExpr) *TypeExpr {
+	if t.Kind == Union || t.IsLiteral() || t.Inline {
+		return t
+	}
+	for _, part := range append([]*TypeExpr{t.Element, t.Key}, t.Args...) {
+		if part == nil {
+			continue
+		}
+		if found := unnamedPart(part); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// instance returns the model generated for m instantiated with args: m's
+// fields with every type parameter replaced by its argument.
+func (m *Model) instance(name string, args []*TypeExpr, key string) *Model {
+	bindings := map[string]*TypeExpr{}
+	for i, param := range m.Params {
+		bindings[param.Name] = args[i]
+	}
+
+	model := &Model{
+		Name:      name,
+		NameSpan:  m.NameSpan,
+		Parents:   m.Parents,
+		Removals:  m.Removals,
+		Overrides: m.Overrides,
+		Configs:   m.Configs,
+		Instance:  &Instance{Generic: m.Name, Args: args, Key: key},
+		Span:      m.Span,
+	}
+	for _, field := range m.Fields {
+		model.Fields = append(model.Fields, substituteField(field, bindings))
+	}
+	return model
+}
+
+func substituteField(field *Field, bindings map[string]*TypeExpr) *Field {
+	out := *field
+	if field.Type != nil {
+		out.Type = substitute(field.Type, bindings)
+	}
+	return &out
+}
+
+// substitute returns a copy of t with type parameters replaced by copies of
+// their arguments. Replaced parameters keep their own span, so problems in
+// a generated field point into the generic model.
+func substitute(t *TypeExpr, bindings map[string]*TypeExpr) *TypeExpr {
+	if t.Kind == Identifier && len(t.Args) == 0 {
+		if arg, ok := bindings[t.Name]; ok {
+			out := substitute(arg, nil)
+			out.Span = t.Span
+			return out
+		}
+	}
+
+	out := *t
+	if t.Element != nil {
+		out.Element = substitute(t.Element, bindings)
+	}
+	if t.Key != nil {
+		out.Key = substitute(t.Key, bindings)
+	}
+	out.Members = substituteAll(t.Members, bindings)
+	out.Args = substituteAll(t.Args, bindings)
+	if t.Fields != nil {
+		out.Fields = make([]*Field, len(t.Fields))
+		for i, field := range t.Fields {
+			out.Fields[i] = substituteField(field, bindings)
+		}
+	}
+	return &out
+}
+
+func substituteAll(list []*TypeExpr, bindings map[string]*TypeExpr) []*TypeExpr {
+	if list == nil {
+		return nil
+	}
+	out := make([]*TypeExpr, len(list))
+	for i, t := range list {
+		out[i] = substitute(t, bindings)
+	}
+	return out
+}
+
+// instanceName returns the name of the model generated for an
+// instantiation: the generic model's name and each argument's name joined
+// by underscores. Arrays add `List` and maps add `By` and the key type, so
+// `Page<User[]>` is `Page_UserList` and `Page<string[Locale]>` is
+// `Page_stringByLocale`.
+func instanceName(t *TypeExpr) string {
+	switch t.Kind {
+	case Array:
+		return instanceName(t.Element) + "List"
+	case Map:
+		return instanceName(t.Element) + "By" + instanceName(t.Key)
+	}
+	if len(t.Args) == 0 {
+		return strings.ReplaceAll(t.Name, ".", "_")
+	}
+	parts := []string{t.genericName()}
+	for _, arg := range t.Args {
+		parts = append(parts, instanceName(arg))
+	}
+	return strings.Join(parts, "_")
+}
+
+// instanceKey returns the Instance.Key of an instantiation, or the part of
+// a key that stands for a type argument.
+func (s *Schema) instanceKey(t *TypeExpr) string {
+	switch t.Kind {
+	case Array:
+		return s.instanceKey(t.Element) + "[]"
+	case Map:
+		return s.instanceKey(t.Element) + "[" + s.instanceKey(t.Key) + "]"
+	}
+	if len(t.Args) == 0 {
+		return s.entityKey(t.Name)
+	}
+	args := make([]string, len(t.Args))
+	for i, arg := range t.Args {
+		args[i] = s.instanceKey(arg)
+	}
+	return s.entityKey(t.genericName()) + "<" + strings.Join(args, ", ") + ">"
+}
+
+// entityKey returns the scoped entity ID of a definition, such as `#N`,
+// and the name itself when it has none.
+func (s *Schema) entityKey(name string) string {
+	var id ScopedID
+	if alias := s.TypeAlias(name); alias != nil {
+		id = alias.ScopedID()
+	} else if generic := s.Generic(name); generic != nil {
+		id = generic.ScopedID()
+	} else if model := s.Model(name); model != nil {
+		if model.Instance != nil {
+			return model.Instance.Key
+		}
+		if model.InlineOf != nil {
+			return model.InlineOf.Key
+		}
+		id = model.ScopedID()
+	}
+	if id.IsZero() {
+		return name
+	}
+	return id.String()
+}
+
+func paramList(generic *Model) string {
+	names := make([]string, len(generic.Params))
+	for i, param := range generic.Params {
+		names[i] = param.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+func typeArguments(n int) string {
+	if n == 1 {
+		return "1 type argument"
+	}
+	return fmt.Sprintf("%d type arguments", n)
+}
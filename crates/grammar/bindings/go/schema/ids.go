@@ -0,0 +1,201 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/larner-dev/cdm/bindings/go/position"
+	"github.com/larner-dev/cdm/bindings/go/value"
+)
+
+// IDSourceKind is the kind of source that assigns entity IDs.
+type IDSourceKind int
+
+const (
+	// The schema being compiled, including files it extends that are not
+	// templates
+	LocalSource IDSourceKind = iota
+	// A registry template, such as "cdm/auth"
+	RegistrySource
+	// A template in a git repository
+	GitSource
+	// A template in a local directory with a cdm-template.json manifest
+	LocalTemplateSource
+)
+
+func (k IDSourceKind) String() string {
+	switch k {
+	case LocalSource:
+		return "local"
+	case RegistrySource:
+		return "registry"
+	case GitSource:
+		return "git"
+	case LocalTemplateSource:
+		return "local_template"
+	}
+	return fmt.Sprintf("IDSourceKind(%d)", int(k))
+}
+
+// EntityIDSource identifies who assigned an entity ID. Templates number
+// their definitions independently, so IDs are scoped by their source: `#10`
+// of the local schema and `#10` of the "cdm/auth" template are different
+// entities. The zero EntityIDSource is the local source.
+type EntityIDSource struct {
+	Kind IDSourceKind
+	// Template name; registry sources only
+	Name string
+	// Repository URL; git sources only
+	URL string
+	// Path of the template within its repository for git sources, which may
+	// be empty, and the template directory for local template sources
+	Path string
+}
+
+// String formats s as the Rust compiler does: "local", the registry name,
+// "git:<url>" with "#<path>" when there is one, or the template path.
+func (s EntityIDSource) String() string {
+	switch s.Kind {
+	case RegistrySource:
+		return s.Name
+	case GitSource:
+		if s.Path != "" {
+			return "git:" + s.URL + "#" + s.Path
+		}
+		return "git:" + s.URL
+	case LocalTemplateSource:
+		return s.Path
+	}
+	return "local"
+}
+
+// TemplateIDSource returns the source of the IDs of a template, given the
+// source string of the extends or import directive that names it and the
+// directive's config. Git sources take their path from the config's
+// git_path. A local path ending in .cdm names a plain file rather than a
+// template, so its IDs are local.
+func TemplateIDSource(source string, config *value.Value) EntityIDSource {
+	switch {
+	case strings.HasPrefix(source, "git:"):
+		s := EntityIDSource{Kind: GitSource, URL: strings.TrimPrefix(source, "git:")}
+		if config != nil {
+			if path, ok := config.Get("git_path"); ok && path.Kind == value.String {
+				s.Path = path.Text
+			}
+		}
+		return s
+	case strings.HasPrefix(source, "./") || strings.HasPrefix(source, "../"):
+		if strings.HasSuffix(source, ".cdm") {
+			return EntityIDSource{}
+		}
+		return EntityIDSource{Kind: LocalTemplateSource, Path: source}
+	}
+	return EntityIDSource{Kind: RegistrySource, Name: source}
+}
+
+// ScopedID is an entity ID together with the source that assigned it and,
+// for a field, the model the field belongs to. Two definitions are the same
+// entity only when their scoped IDs are equal.
+type ScopedID struct {
+	Source EntityIDSource
+	// For a field, the scoped ID of its model; nil for type aliases and
+	// models, and for fields of models without an ID
+	Model *ScopedID
+	ID    EntityID
+}
+
+// IsZero reports whether no ID was assigned.
+func (id ScopedID) IsZero() bool { return id.ID == 0 }
+
+// String formats id as `#N`, prefixed with its source for template IDs,
+// such as `cdm/auth:#10`. A field ID follows its model's, as in `#10.#2`;
+// when the field's source differs from the model's, the field part names
+// its own, as in `cdm/auth:#10.local:#5` for a field a schema adds to a
+// template's model. String is empty for a zero ID and otherwise unique to
+// the ID, so it can serve as a map key.
+func (id ScopedID) String() string {
+	if id.IsZero() {
+		return ""
+	}
+	if id.Model == nil {
+		return qualify(id.Source, id.ID)
+	}
+	if id.Source == id.Model.Source {
+		return fmt.Sprintf("%s.#%d", id.Model, id.ID)
+	}
+	return fmt.Sprintf("%s.%s:#%d", id.Model, id.Source, id.ID)
+}
+
+func qualify(source EntityIDSource, id EntityID) string {
+	if source.Kind == LocalSource {
+		return fmt.Sprintf("#%d", id)
+	}
+	return fmt.Sprintf("%s:#%d", source, id)
+}
+
+// ScopedID returns the scoped entity ID of a.
+func (a *TypeAlias) ScopedID() ScopedID { return ScopedID{Source: a.IDSource, ID: a.ID} }
+
+// ScopedID returns the scoped entity ID of m.
+func (m *Model) ScopedID() ScopedID { return ScopedID{Source: m.IDSource, ID: m.ID} }
+
+// ScopedID returns the scoped entity ID of f as a field of model, which may
+// be nil to leave the model out.
+func (f *Field) ScopedID(model *Model) ScopedID {
+	id := ScopedID{Source: f.IDSource, ID: f.ID}
+	if model != nil && model.ID != 0 {
+		scope := model.ScopedID()
+		id.Model = &scope
+	}
+	return id
+}
+
+// checkEntityIDs reports definitions that share an entity ID within its
+// scope: type aliases, models and generic models with the same source
+// (E501), and fields of one model with the same source (E502). Models Check
+// generates have no IDs of their own; fields of an inline object are scoped
+// to the object.
+func (s *Schema) checkEntityIDs() []Diagnostic {
+	var diagnostics []Diagnostic
+	definitions := map[string]string{}
+	define := func(name string, id ScopedID, span position.Span) {
+		if id.IsZero() {
+			return
+		}
+		key := id.String()
+		if existing, ok := definitions[key]; ok {
+			diagnostics = append(diagnostics, Errorf(CodeDuplicateEntityID, span,
+				"Duplicate entity ID %s: already used by '%s'", key, existing))
+			return
+		}
+		definitions[key] = name
+	}
+	models := append(append([]*Model{}, s.Models...), s.Generics...)
+	for _, alias := range s.TypeAliases {
+		define(alias.Name, alias.ScopedID(), alias.Span)
+	}
+	for _, model := range models {
+		define(model.Name, model.ScopedID(), model.Span)
+	}
+
+	for _, model := range models {
+		if model.Instance != nil {
+			// Instances share the fields of their generic model.
+			continue
+		}
+		fields := map[string]string{}
+		for _, field := range model.Fields {
+			id := field.ScopedID(nil)
+			if id.IsZero() {
+				continue
+			}
+			if existing, ok := fields[id.String()]; ok {
+				diagnostics = append(diagnostics, Errorf(CodeDuplicateFieldID, field.Span,
+					"Duplicate field ID %s in model '%s': already used by '%s'", id, model.Name, existing))
+				continue
+			}
+			fields[id.String()] = field.Name
+		}
+	}
+	return diagnostics
+}
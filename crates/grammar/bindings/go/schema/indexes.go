@@ -0,0 +1,256 @@
+package schema
+
+import (
+	"strings"
+
+	"github.com/larner-dev/cdm/bindings/go/position"
+	"github.com/larner-dev/cdm/bindings/go/value"
+)
+
+// IndexMethods are the values an index's `method` accepts.
+var IndexMethods = []string{"btree", "hash", "gin", "gist", "spgist", "brin"}
+
+// Index is an index, unique constraint or primary key of a model, declared
+// in the `indexes` map of its @sql config, where the key is the index name:
+//
+//	@sql { indexes: { email_unique: { fields: ["email"], unique: true } } }
+type Index struct {
+	Name    string
+	Fields  []string
+	Primary bool
+	Unique  bool
+	Method  string
+	// Condition of a partial index, such as "deleted_at IS NULL", kept as
+	// written for the target database to interpret
+	Where string
+	// Model whose @sql config last sets the index: the model that declares
+	// it, or a child that changes an inherited one
+	Model string
+	Span  position.Span
+}
+
+// Equal reports whether i and other define the same index. Where each is
+// declared does not matter.
+func (i *Index) Equal(other *Index) bool {
+	if i == nil || other == nil {
+		return i == other
+	}
+	return i.Name == other.Name && strings.Join(i.Fields, ",") == strings.Join(other.Fields, ",") &&
+		i.Primary == other.Primary && i.Unique == other.Unique && i.Method == other.Method && i.Where == other.Where
+}
+
+// Indexes returns the indexes of a model, inherited ones included, parents'
+// first and each in declaration order. Indexes inherit like other map
+// configs, by deep merge: a child's entry for an inherited index changes
+// only the keys it sets.
+func (s *Schema) Indexes(model *Model) []*Index {
+	var indexes []*Index
+	for _, entry := range s.indexEntries(model, map[string]bool{}) {
+		index, _ := decodeIndex(entry.name, entry.value)
+		index.Model, index.Span = entry.model, entry.value.Span
+		indexes = append(indexes, index)
+	}
+	return indexes
+}
+
+type indexEntry struct {
+	name  string
+	value value.Value
+	model string
+}
+
+func (s *Schema) indexEntries(model *Model, visiting map[string]bool) []*indexEntry {
+	if visiting[model.Name] {
+		return nil
+	}
+	visiting[model.Name] = true
+	defer delete(visiting, model.Name)
+
+	var entries []*indexEntry
+	add := func(e *indexEntry) {
+		for _, existing := range entries {
+			if existing.name == e.name {
+				existing.value, existing.model = value.Merge(existing.value, e.value), e.model
+				return
+			}
+		}
+		entries = append(entries, e)
+	}
+	for _, parent := range model.Parents {
+		if parentModel := s.Model(parent.Name); parentModel != nil {
+			for _, e := range s.indexEntries(parentModel, visiting) {
+				add(e)
+			}
+		}
+	}
+	for _, entry := range ownIndexes(model) {
+		add(&indexEntry{name: entry.Key, value: entry.Value, model: model.Name})
+	}
+	return entries
+}
+
+// ownIndexes returns the entries of the `indexes` map in the model's own
+// @sql config.
+func ownIndexes(model *Model) []value.Entry {
+	config := model.Config("sql")
+	if config == nil {
+		return nil
+	}
+	indexes, ok := config.Value.Get("indexes")
+	if !ok || indexes.Kind != value.Object {
+		return nil
+	}
+	return indexes.Entries
+}
+
+// decodeIndex reads an index entry, skipping and reporting keys that are not
+// part of an index or have the wrong type.
+func decodeIndex(name string, v value.Value) (*Index, []Diagnostic) {
+	index := &Index{Name: name}
+	if v.Kind != value.Object {
+		return index, []Diagnostic{Errorf(CodeInvalidPluginConfig, v.Span,
+			"Invalid @sql config: index '%s' must be an object, found %s", name, v.Kind)}
+	}
+	var diagnostics []Diagnostic
+	invalid := func(entry value.Entry, expected string) {
+		diagnostics = append(diagnostics, Errorf(CodeInvalidPluginConfig, entry.Value.Span,
+			"Invalid @sql config: %s of index '%s' must be %s, found %s", entry.Key, name, expected, describe(entry.Value)))
+	}
+	for _, entry := range v.Entries {
+		e := entry.Value
+		switch entry.Key {
+		case "fields":
+			if e.Kind != value.Array {
+				invalid(entry, "an array of field names")
+				continue
+			}
+			index.Fields = nil
+			for _, item := range e.Items {
+				if item.Kind != value.String && item.Kind != value.Reference {
+					invalid(entry, "an array of field names")
+					break
+				}
+				index.Fields = append(index.Fields, item.Text)
+			}
+		case "primary", "unique":
+			if e.Kind != value.Bool {
+				invalid(entry, "a boolean")
+				continue
+			}
+			if entry.Key == "primary" {
+				index.Primary = e.Bool
+			} else {
+				index.Unique = e.Bool
+			}
+		case "method":
+			if e.Kind != value.String || !contains(IndexMethods, e.Text) {
+				invalid(entry, "one of "+strings.Join(IndexMethods, ", "))
+				continue
+			}
+			index.Method = e.Text
+		case "where":
+			if e.Kind != value.String {
+				invalid(entry, "a string")
+				continue
+			}
+			index.Where = e.Text
+		default:
+			diagnostics = append(diagnostics, Errorf(CodeInvalidPluginConfig, entry.KeySpan,
+				"Invalid @sql config: unknown key '%s' in index '%s'; expected fields, primary, unique, method or where", entry.Key, name))
+		}
+	}
+	return index, diagnostics
+}
+
+// checkIndexes checks the indexes each model declares and inherits. Index
+// names must be unique within a model's map and across models that do not
+// inherit from one another, since they share one database namespace. The
+// fields an index lists must be fields of the model once inheritance and
+// removals are applied; an inherited index is reported in the model whose
+// removal breaks it. Instances of a generic model share its indexes, so
+// they are checked once on the generic model instead of on every instance.
+func (s *Schema) checkIndexes() []Diagnostic {
+	var diagnostics []Diagnostic
+	errorf := func(span position.Span, format string, args ...any) {
+		diagnostics = append(diagnostics, Errorf(CodeInvalidIndex, span, format, args...))
+	}
+
+	var models []*Model
+	for _, model := range s.Models {
+		if model.Instance == nil {
+			models = append(models, model)
+		}
+	}
+	models = append(models, s.Generics...)
+
+	declared := map[string]*Model{}
+	for _, model := range models {
+		seen := map[string]bool{}
+		for _, entry := range ownIndexes(model) {
+			if seen[entry.Key] {
+				errorf(entry.KeySpan, "Duplicate index '%s' in '%s'", entry.Key, model.Name)
+				continue
+			}
+			seen[entry.Key] = true
+			_, problems := decodeIndex(entry.Key, entry.Value)
+			diagnostics = append(diagnostics, problems...)
+			other := declared[entry.Key]
+			switch {
+			case other == nil:
+				declared[entry.Key] = model
+			case !s.extends(model, other) && !s.extends(other, model):
+				errorf(entry.KeySpan, "Index '%s' of '%s' is already declared by '%s'; index names must be unique", entry.Key, model.Name, other.Name)
+			}
+		}
+
+		if !s.resolvesFields(model) {
+			continue
+		}
+		fields := s.Fields(model)
+		for _, index := range s.Indexes(model) {
+			own := index.Model == model.Name
+			if own && len(index.Fields) == 0 {
+				errorf(index.Span, "Index '%s' of '%s' lists no fields", index.Name, model.Name)
+			}
+			for _, name := range index.Fields {
+				switch removal := removalOf(model, name); {
+				case hasField(fields, name):
+				case own:
+					errorf(index.Span, "Index '%s' of '%s' names '%s', which is not a field of '%s'", index.Name, model.Name, name, model.Name)
+				case removal != nil:
+					errorf(removal.Span, "'%s' removes '%s', which its inherited index '%s' uses", model.Name, name, index.Name)
+				}
+			}
+		}
+	}
+	return diagnostics
+}
+
+// extends reports whether model inherits from ancestor, directly or
+// through other parents defined in this file.
+func (s *Schema) extends(model, ancestor *Model) bool {
+	return s.inheritsFrom(model, ancestor, map[string]bool{})
+}
+
+func (s *Schema) inheritsFrom(model, ancestor *Model, visiting map[string]bool) bool {
+	if visiting[model.Name] {
+		return false
+	}
+	visiting[model.Name] = true
+	for _, parent := range model.Parents {
+		parentModel := s.Model(parent.Name)
+		if parentModel == ancestor || parentModel != nil && s.inheritsFrom(parentModel, ancestor, visiting) {
+			return true
+		}
+	}
+	return false
+}
+
+func removalOf(model *Model, name string) *Removal {
+	for _, removal := range model.Removals {
+		if removal.Name == name {
+			return removal
+		}
+	}
+	return nil
+}
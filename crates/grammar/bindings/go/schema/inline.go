@@ -0,0 +1,91 @@
+package schema
+
+import (
+	"strconv"
+)
+
+// InlineOf records where an inline object type was declared.
+type InlineOf struct {
+	// Model or type alias the object type appears in
+	Owner string
+	// Field of Owner whose type contains the object; empty for type aliases
+	Field string
+	// Key identifies the object across versions of a schema. It is the
+	// owner's key and the field's key joined by a dot, such as `#10.#3` for
+	// field #3 of model #10, so fields of the object are scoped to it.
+	// Owners and fields without IDs contribute their names.
+	Key string
+}
+
+// extractInlineObjects generates a model for every inline object type in a
+// field or type alias, and sets the object's Name to it. Objects in a
+// field are named after the model and field (`User_address`), and objects
+// in a type alias after the alias (`Address_object`). A type holding
+// several objects, such as a union, numbers them in source order
+// (`Result_object_1`). Objects nested in a generated model are named after
+// it in turn (`User_address_geo`). Models generated by an earlier Check are
+// dropped first.
+func (s *Schema) extractInlineObjects() []Diagnostic {
+	declared := s.Models[:0:0]
+	for _, model := range s.Models {
+		if model.InlineOf == nil {
+			declared = append(declared, model)
+		}
+	}
+	s.Models = declared
+
+	var diagnostics []Diagnostic
+	for _, alias := range s.TypeAliases {
+		diagnostics = append(diagnostics, s.extract(alias.Type, alias.Name+"_object", s.entityKey(alias.Name)+".object", alias.Name, "")...)
+	}
+	// Generated models are appended while looping, so nested objects are
+	// extracted from them as well.
+	for i := 0; i < len(s.Models); i++ {
+		model := s.Models[i]
+		for _, field := range model.Fields {
+			if field.Type != nil {
+				diagnostics = append(diagnostics, s.extract(field.Type, model.Name+"_"+field.Name, s.entityKey(model.Name)+"."+fieldKey(field), model.Name, field.Name)...)
+			}
+		}
+	}
+	return diagnostics
+}
+
+func (s *Schema) extract(t *TypeExpr, name, key, owner, field string) []Diagnostic {
+	var objects []*TypeExpr
+	t.Walk(func(t *TypeExpr) {
+		if t.Inline {
+			objects = append(objects, t)
+		}
+	})
+
+	var diagnostics []Diagnostic
+	for i, object := range objects {
+		objectName, objectKey := name, key
+		if len(objects) > 1 {
+			objectName += "_" + strconv.Itoa(i+1)
+			objectKey += "." + strconv.Itoa(i+1)
+		}
+		if s.Model(objectName) != nil || s.TypeAlias(objectName) != nil || s.Generic(objectName) != nil {
+			diagnostics = append(diagnostics, Errorf(CodeInvalidInlineObject, object.Span,
+				"Inline object type generates the model '%s', which is already defined", objectName))
+			continue
+		}
+		object.Name = objectName
+		s.Models = append(s.Models, &Model{
+			Name:     objectName,
+			NameSpan: object.Span,
+			Fields:   object.Fields,
+			InlineOf: &InlineOf{Owner: owner, Field: field, Key: objectKey},
+			Span:     object.Span,
+		})
+	}
+	return diagnostics
+}
+
+func fieldKey(field *Field) string {
+	if field.ID == 0 {
+		return field.Name
+	}
+	return field.ScopedID(nil).String()
+}
@@ -0,0 +1,318 @@
+package schema
+
+import (
+	"encoding/json"
+
+	"github.com/larner-dev/cdm/bindings/go/value"
+)
+
+// The JSON encoding follows Appendix D of the specification, the format
+// plugins receive and the one stored for diffing. Type expressions are tagged
+// with "kind"; maps, which Appendix D.2 adds for `Value[Key]` types, carry
+// "value_type" and "key_type".
+
+type typeJSON struct {
+	Kind      string       `json:"kind"`
+	Name      string       `json:"name,omitempty"`
+	Element   *TypeExpr    `json:"element,omitempty"`
+	ValueType *TypeExpr    `json:"value_type,omitempty"`
+	KeyType   *TypeExpr    `json:"key_type,omitempty"`
+	Members   []*TypeExpr  `json:"members,omitempty"`
+	Value     *value.Value `json:"value,omitempty"`
+	Inline    bool         `json:"inline,omitempty"`
+}
+
+// MarshalJSON encodes t in the Appendix D type expression format.
+func (t *TypeExpr) MarshalJSON() ([]byte, error) {
+	out := typeJSON{Kind: t.Kind.String()}
+	switch t.Kind {
+	case Identifier:
+		out.Name = t.Name
+		out.Inline = t.Inline
+	case Array:
+		out.Element = t.Element
+	case Map:
+		out.ValueType = t.Element
+		out.KeyType = t.Key
+	case Union:
+		out.Members = t.Members
+	case StringLiteral, NumberLiteral, BooleanLiteral:
+		out.Value = &t.Literal
+	}
+	return json.Marshal(out)
+}
+
+type schemaJSON struct {
+	TypeAliases []*TypeAlias `json:"type_aliases"`
+	Models      []*Model     `json:"models"`
+	Relations   []*Relation  `json:"relations,omitempty"`
+}
+
+type typeAliasJSON struct {
+	Name          string             `json:"name"`
+	ID            *EntityID          `json:"id"`
+	IDSource      *EntityIDSource    `json:"id_source,omitempty"`
+	AliasType     *TypeExpr          `json:"alias_type"`
+	Discriminator *discriminatorJSON `json:"discriminator,omitempty"`
+	Deprecated    *Deprecation       `json:"deprecated,omitempty"`
+	Config        value.Value        `json:"config"`
+}
+
+type discriminatorJSON struct {
+	Field    string        `json:"field"`
+	Variants []variantJSON `json:"variants"`
+}
+
+type variantJSON struct {
+	Value value.Value `json:"value"`
+	Model string      `json:"model"`
+}
+
+type modelJSON struct {
+	Name       string          `json:"name"`
+	ID         *EntityID       `json:"id"`
+	IDSource   *EntityIDSource `json:"id_source,omitempty"`
+	InstanceOf *instanceJSON   `json:"instance_of,omitempty"`
+	Projection *Projection     `json:"projection,omitempty"`
+	InlineOf   *inlineJSON     `json:"inline_of,omitempty"`
+	Parents    []string        `json:"parents"`
+	Fields     []*Field        `json:"fields"`
+	Deprecated *Deprecation    `json:"deprecated,omitempty"`
+	Config     value.Value     `json:"config"`
+}
+
+type instanceJSON struct {
+	Generic   string      `json:"generic"`
+	Arguments []*TypeExpr `json:"arguments"`
+	Key       string      `json:"key"`
+}
+
+type inlineJSON struct {
+	Owner string `json:"owner"`
+	Field string `json:"field,omitempty"`
+	Key   string `json:"key"`
+}
+
+type projectionJSON struct {
+	Operator string   `json:"operator"`
+	Source   string   `json:"source"`
+	Fields   []string `json:"fields"`
+}
+
+type fieldJSON struct {
+	Name       string          `json:"name"`
+	ID         *EntityID       `json:"id"`
+	IDSource   *EntityIDSource `json:"id_source,omitempty"`
+	FieldType  *TypeExpr       `json:"field_type"`
+	Optional   bool            `json:"optional"`
+	Nullable   bool            `json:"nullable"`
+	Default    *value.Value    `json:"default"`
+	Computed   *Computation    `json:"computed,omitempty"`
+	Deprecated *Deprecation    `json:"deprecated,omitempty"`
+	Config     value.Value     `json:"config"`
+}
+
+type entityIDSourceJSON struct {
+	Type string `json:"type"`
+	Name string `json:"name,omitempty"`
+	URL  string `json:"url,omitempty"`
+	Path string `json:"path,omitempty"`
+}
+
+type relationJSON struct {
+	Model       string      `json:"model"`
+	Field       string      `json:"field"`
+	Target      string      `json:"target"`
+	Cardinality Cardinality `json:"cardinality"`
+	Inverse     string      `json:"inverse,omitempty"`
+	OnDelete    string      `json:"on_delete,omitempty"`
+	Through     string      `json:"through,omitempty"`
+	ForeignKey  string      `json:"foreign_key,omitempty"`
+}
+
+type computationJSON struct {
+	From         string           `json:"from"`
+	Dependencies []dependencyJSON `json:"dependencies"`
+}
+
+type dependencyJSON struct {
+	Path      string `json:"path"`
+	Model     string `json:"model"`
+	Field     string `json:"field"`
+	Aggregate string `json:"aggregate,omitempty"`
+}
+
+type indexJSON struct {
+	Name    string   `json:"name"`
+	Fields  []string `json:"fields"`
+	Primary bool     `json:"primary,omitempty"`
+	Unique  bool     `json:"unique,omitempty"`
+	Method  string   `json:"method,omitempty"`
+	Where   string   `json:"where,omitempty"`
+}
+
+type deprecationJSON struct {
+	Reason      string `json:"reason,omitempty"`
+	Since       string `json:"since,omitempty"`
+	Replacement string `json:"replacement,omitempty"`
+}
+
+// MarshalJSON encodes s in the Appendix D schema format. Models list the
+// fields declared in their own body; inherited fields are found through
+// "parents". Generic models are left out; the models Check generated for
+// their instantiations are listed with "instance_of". Projections list their
+// source as their parent and carry a "projection" object naming the fields
+// they keep or leave out. Models generated for inline object types carry
+// "inline_of", and the types that declare the objects are identifiers
+// marked "inline". A checked schema lists its relation graph in
+// "relations".
+func (s *Schema) MarshalJSON() ([]byte, error) {
+	out := schemaJSON{TypeAliases: s.TypeAliases, Models: s.Models, Relations: s.Relations}
+	if out.TypeAliases == nil {
+		out.TypeAliases = []*TypeAlias{}
+	}
+	if out.Models == nil {
+		out.Models = []*Model{}
+	}
+	return json.Marshal(out)
+}
+
+// MarshalJSON encodes a in the Appendix D type alias format.
+func (a *TypeAlias) MarshalJSON() ([]byte, error) {
+	out := typeAliasJSON{
+		Name:       a.Name,
+		ID:         idJSON(a.ID),
+		IDSource:   idSourceJSON(a.ID, a.IDSource),
+		AliasType:  a.Type,
+		Deprecated: a.Deprecation(),
+		Config:     configJSON(a.Configs),
+	}
+	if d := a.Discriminator; d != nil {
+		out.Discriminator = &discriminatorJSON{Field: d.Field, Variants: []variantJSON{}}
+		for _, variant := range d.Variants {
+			out.Discriminator.Variants = append(out.Discriminator.Variants, variantJSON{Value: variant.Value, Model: variant.Model})
+		}
+	}
+	return json.Marshal(out)
+}
+
+// MarshalJSON encodes m in the Appendix D model format, listing the fields
+// declared in its own body.
+func (m *Model) MarshalJSON() ([]byte, error) {
+	out := modelJSON{
+		Name:       m.Name,
+		ID:         idJSON(m.ID),
+		IDSource:   idSourceJSON(m.ID, m.IDSource),
+		Parents:    []string{},
+		Fields:     m.Fields,
+		Projection: m.Projection,
+		Deprecated: m.Deprecation(),
+		Config:     configJSON(m.Configs),
+	}
+	if out.Fields == nil {
+		out.Fields = []*Field{}
+	}
+	if i := m.Instance; i != nil {
+		out.InstanceOf = &instanceJSON{Generic: i.Generic, Arguments: i.Args, Key: i.Key}
+	}
+	if i := m.InlineOf; i != nil {
+		out.InlineOf = &inlineJSON{Owner: i.Owner, Field: i.Field, Key: i.Key}
+	}
+	for _, parent := range m.Parents {
+		out.Parents = append(out.Parents, parent.Name)
+	}
+	return json.Marshal(out)
+}
+
+// MarshalJSON encodes f in the Appendix D field format. "nullable" is only
+// set on fields of a checked schema.
+func (f *Field) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fieldJSON{
+		Name:       f.Name,
+		ID:         idJSON(f.ID),
+		IDSource:   idSourceJSON(f.ID, f.IDSource),
+		FieldType:  f.FieldType(),
+		Optional:   f.Optional,
+		Nullable:   f.Nullable,
+		Default:    f.Default,
+		Computed:   f.Computed,
+		Deprecated: f.Deprecation(),
+		Config:     configJSON(f.Configs),
+	})
+}
+
+// MarshalJSON encodes p as the "projection" object of Appendix D, listing
+// the fields named in its braces.
+func (p *Projection) MarshalJSON() ([]byte, error) {
+	out := projectionJSON{Operator: p.Operator, Source: p.Source.Name, Fields: []string{}}
+	for _, field := range p.Fields {
+		out.Fields = append(out.Fields, field.Name)
+	}
+	return json.Marshal(out)
+}
+
+// MarshalJSON encodes d as the "deprecated" object of Appendix D, omitting
+// unset keys.
+func (d *Deprecation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(deprecationJSON{Reason: d.Reason, Since: d.Since, Replacement: d.Replacement})
+}
+
+// MarshalJSON encodes r as an entry of the "relations" list of Appendix D,
+// omitting unset keys.
+func (r *Relation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(relationJSON{
+		Model: r.Model, Field: r.Field, Target: r.Target, Cardinality: r.Cardinality,
+		Inverse: r.Inverse, OnDelete: r.OnDelete, Through: r.Through, ForeignKey: r.ForeignKey,
+	})
+}
+
+// MarshalJSON encodes c as the "computed" object of Appendix D.
+func (c *Computation) MarshalJSON() ([]byte, error) {
+	out := computationJSON{From: c.From, Dependencies: []dependencyJSON{}}
+	for _, d := range c.Dependencies {
+		out.Dependencies = append(out.Dependencies, dependencyJSON{Path: d.String(), Model: d.Model, Field: d.Field, Aggregate: d.Aggregate})
+	}
+	return json.Marshal(out)
+}
+
+// MarshalJSON encodes i as in the `indexes` map of the SQL plugin, with the
+// index name added and unset keys left out.
+func (i *Index) MarshalJSON() ([]byte, error) {
+	out := indexJSON{Name: i.Name, Fields: i.Fields, Primary: i.Primary, Unique: i.Unique, Method: i.Method, Where: i.Where}
+	if out.Fields == nil {
+		out.Fields = []string{}
+	}
+	return json.Marshal(out)
+}
+
+func idJSON(id EntityID) *EntityID {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
+
+// idSourceJSON returns the source of an entity ID for "id_source", nil when
+// there is no ID or it is local.
+func idSourceJSON(id EntityID, source EntityIDSource) *EntityIDSource {
+	if id == 0 || source.Kind == LocalSource {
+		return nil
+	}
+	return &source
+}
+
+// MarshalJSON encodes s as the "id_source" object of Appendix D, tagged with
+// its kind as the Rust compiler does.
+func (s EntityIDSource) MarshalJSON() ([]byte, error) {
+	return json.Marshal(entityIDSourceJSON{Type: s.Kind.String(), Name: s.Name, URL: s.URL, Path: s.Path})
+}
+
+// configJSON merges plugin configs into the `{ "plugin": { ... } }` object of
+// Appendix D.
+func configJSON(configs []*Config) value.Value {
+	out := value.Value{Kind: value.Object, Entries: []value.Entry{}}
+	for _, config := range configs {
+		out.Entries = append(out.Entries, value.Entry{Key: config.Name, Value: config.Value})
+	}
+	return out
+}
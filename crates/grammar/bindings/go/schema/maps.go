@@ -0,0 +1,91 @@
+package schema
+
+import "fmt"
+
+// checkMapKeys checks the key type of every map in the file. Spec §3.2
+// allows `string`, `number`, and unions of string literals or of number
+// literals, directly or through aliases. Nested maps such as
+// `string[string][Locale]` are checked at each level.
+func (s *Schema) checkMapKeys() []Diagnostic {
+	var diagnostics []Diagnostic
+	check := func(t *TypeExpr) {
+		if t == nil {
+			return
+		}
+		t.Walk(func(m *TypeExpr) {
+			if m.Kind != Map {
+				return
+			}
+			if _, problem := s.keyCategory(m.Key, map[string]bool{}); problem != "" {
+				diagnostics = append(diagnostics, Errorf(CodeInvalidMapKey, m.Key.Span,
+					"Invalid map key type '%s' in '%s': %s. Map keys must be string, number, or a union of string literals or of number literals",
+					m.Key, m, problem))
+			}
+		})
+	}
+
+	for _, alias := range s.TypeAliases {
+		check(alias.Type)
+	}
+	for _, model := range s.Models {
+		for _, field := range model.Fields {
+			check(field.Type)
+		}
+	}
+	return diagnostics
+}
+
+// keyCategory classifies a key type as "string" or "number". When the type
+// is not a valid key it returns a description of the problem instead. Names
+// that cannot be resolved in this file are accepted.
+func (s *Schema) keyCategory(t *TypeExpr, aliases map[string]bool) (category, problem string) {
+	switch t.Kind {
+	case StringLiteral:
+		return "string", ""
+	case NumberLiteral:
+		return "number", ""
+	case BooleanLiteral:
+		return "", "boolean keys are not supported"
+	case Null:
+		return "", "null keys are not supported"
+	case Array:
+		return "", fmt.Sprintf("'%s' is an array", t)
+	case Map:
+		return "", fmt.Sprintf("'%s' is a map", t)
+	case Union:
+		for _, member := range t.Members {
+			memberCategory, problem := s.keyCategory(member, aliases)
+			if problem != "" {
+				return "", problem
+			}
+			if category == "" {
+				category = memberCategory
+			} else if memberCategory != "" && memberCategory != category {
+				return "", "it mixes string and number keys"
+			}
+		}
+		return category, ""
+	}
+
+	switch t.Name {
+	case "string", "number":
+		return t.Name, ""
+	case "boolean", "JSON", "Model", "Type":
+		return "", fmt.Sprintf("%s keys are not supported", t.Name)
+	}
+	if alias := s.TypeAlias(t.Name); alias != nil {
+		if aliases[alias.Name] {
+			return "", ""
+		}
+		aliases[alias.Name] = true
+		category, problem := s.keyCategory(alias.Type, aliases)
+		if problem != "" {
+			problem = fmt.Sprintf("'%s' resolves to '%s', and %s", alias.Name, alias.Type, problem)
+		}
+		return category, problem
+	}
+	if s.Model(t.Name) != nil {
+		return "", fmt.Sprintf("'%s' is a model", t.Name)
+	}
+	return "", ""
+}
@@ -0,0 +1,37 @@
+package schema
+
+// Nullable reports whether t admits null: it is a union with a `null`
+// member, directly or through type aliases defined in s. Nullability is
+// independent of optionality; an optional field may be left out, and a
+// nullable one may be null.
+func (s *Schema) Nullable(t *TypeExpr) bool {
+	return s.nullable(t, map[string]bool{})
+}
+
+func (s *Schema) nullable(t *TypeExpr, aliases map[string]bool) bool {
+	switch t.Kind {
+	case Null:
+		return true
+	case Union:
+		for _, member := range t.Members {
+			if s.nullable(member, aliases) {
+				return true
+			}
+		}
+	case Identifier:
+		if alias := s.TypeAlias(t.Name); alias != nil && !aliases[alias.Name] {
+			aliases[alias.Name] = true
+			return s.nullable(alias.Type, aliases)
+		}
+	}
+	return false
+}
+
+// resolveNullability records on every field whether its type is nullable.
+func (s *Schema) resolveNullability() {
+	for _, model := range s.Models {
+		for _, field := range model.Fields {
+			field.Nullable = s.Nullable(field.FieldType())
+		}
+	}
+}
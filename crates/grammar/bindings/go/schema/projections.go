@@ -0,0 +1,135 @@
+package schema
+
+import (
+	"strings"
+
+	"github.com/larner-dev/cdm/bindings/go/position"
+)
+
+// Projection is the `pick Source { fields }` or `omit Source { fields }`
+// definition of a projected model.
+type Projection struct {
+	// "pick" or "omit"
+	Operator string
+	Source   *Reference
+	// Fields listed in the braces: the ones kept by pick, or the ones left
+	// out by omit
+	Fields []*Reference
+	Span   position.Span
+}
+
+// String renders p as CDM source text, such as `pick User { id, name }`.
+func (p *Projection) String() string {
+	names := make([]string, len(p.Fields))
+	for i, field := range p.Fields {
+		names[i] = field.Name
+	}
+	return p.Operator + " " + p.Source.Name + " { " + strings.Join(names, ", ") + " }"
+}
+
+// Equal reports whether p and other project the same fields of the same
+// source, ignoring spans and the order fields are listed in.
+func (p *Projection) Equal(other *Projection) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.Operator != other.Operator || p.Source.Name != other.Source.Name || len(p.Fields) != len(other.Fields) {
+		return false
+	}
+	listed := map[string]bool{}
+	for _, field := range p.Fields {
+		listed[field.Name] = true
+	}
+	for _, field := range other.Fields {
+		if !listed[field.Name] {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveProjections links every projected model to its source. A
+// projection is resolved as a model that extends its source and removes the
+// fields it does not keep, so field types, configs, overrides and
+// deprecations of the source flow through to it. Projections of
+// projections are resolved source first.
+//
+// Sources that are not defined in this file are left unresolved, and the
+// projection has no fields until they are.
+func (s *Schema) resolveProjections() []Diagnostic {
+	r := &projector{schema: s, resolved: map[*Model]bool{}, visiting: map[*Model]bool{}}
+	for _, model := range s.Models {
+		if model.Projection != nil {
+			r.resolve(model)
+		}
+	}
+	return r.diagnostics
+}
+
+type projector struct {
+	schema      *Schema
+	resolved    map[*Model]bool
+	visiting    map[*Model]bool
+	diagnostics []Diagnostic
+}
+
+func (r *projector) errorf(span position.Span, format string, args ...any) {
+	r.diagnostics = append(r.diagnostics, Errorf(CodeInvalidProjection, span, format, args...))
+}
+
+func (r *projector) resolve(model *Model) {
+	if r.resolved[model] {
+		return
+	}
+	s, p := r.schema, model.Projection
+	model.Parents, model.Removals = nil, nil
+	if r.visiting[model] {
+		r.errorf(p.Source.Span, "Projection '%s' depends on itself", model.Name)
+		return
+	}
+	r.visiting[model] = true
+	defer func() {
+		delete(r.visiting, model)
+		r.resolved[model] = true
+	}()
+
+	source := s.Model(p.Source.Name)
+	switch {
+	case source == model:
+		r.errorf(p.Source.Span, "Projection '%s' depends on itself", model.Name)
+		return
+	case source != nil && source.Projection != nil:
+		r.resolve(source)
+	case source == nil && s.Generic(p.Source.Name) != nil:
+		r.errorf(p.Source.Span, "Generic model '%s' cannot be projected; project a model that instantiates it", p.Source.Name)
+		return
+	case source == nil && s.TypeAlias(p.Source.Name) != nil:
+		r.errorf(p.Source.Span, "'%s' is a type alias; only models can be projected", p.Source.Name)
+		return
+	case source == nil:
+		return
+	}
+
+	complete := s.resolvesFields(source)
+	available := map[string]bool{}
+	for _, field := range s.Fields(source) {
+		available[field.Name] = true
+	}
+	listed := map[string]bool{}
+	for _, field := range p.Fields {
+		switch {
+		case listed[field.Name]:
+			r.errorf(field.Span, "Field '%s' is listed twice in projection '%s'", field.Name, model.Name)
+		case complete && !available[field.Name]:
+			r.errorf(field.Span, "'%s' is not a field of '%s'", field.Name, source.Name)
+		}
+		listed[field.Name] = true
+	}
+
+	model.Parents = []*Reference{p.Source}
+	for _, field := range s.Fields(source) {
+		if listed[field.Name] == (p.Operator == "omit") {
+			model.Removals = append(model.Removals, &Removal{Name: field.Name, Span: p.Span})
+		}
+	}
+}
@@ -0,0 +1,281 @@
+package schema
+
+import "github.com/larner-dev/cdm/bindings/go/position"
+
+// Cardinality describes how many records are on each side of a relation,
+// seen from the model that declares the field: ManyToOne for `Post.author`,
+// where many posts share one user.
+type Cardinality string
+
+const (
+	OneToOne   Cardinality = "one_to_one"
+	OneToMany  Cardinality = "one_to_many"
+	ManyToOne  Cardinality = "many_to_one"
+	ManyToMany Cardinality = "many_to_many"
+)
+
+// OnDeleteActions are the values `@relation { on_delete }` accepts.
+var OnDeleteActions = []string{"cascade", "restrict", "set_null", "no_action"}
+
+// Relation is an edge of the relation graph Check records on a schema: a
+// field that refers to another model, such as `author: User` or
+// `posts: Post[]`, with what its @relation config declares about it.
+type Relation struct {
+	Model string
+	Field string
+	// Model the field refers to
+	Target string
+	// Many is set when the field holds several targets, such as `Post[]`.
+	Many        bool
+	Cardinality Cardinality
+	// Field of Target that refers back, named by either side's @relation;
+	// empty when neither names one
+	Inverse string
+	// What happens to the record holding the foreign key when its target is
+	// deleted: one of OnDeleteActions, or empty for the generator's default
+	OnDelete string
+	// Join model of a many-to-many relation
+	Through string
+	// Name of the foreign key field or column
+	ForeignKey string
+	Span       position.Span
+}
+
+// RelationConfig is a decoded `@relation { inverse, on_delete, through,
+// foreign_key }` config. Every key is optional.
+type RelationConfig struct {
+	Inverse    string
+	OnDelete   string
+	Through    string
+	ForeignKey string
+	Span       position.Span
+}
+
+// RelationConfig returns the field's @relation config, or nil.
+func (f *Field) RelationConfig() *RelationConfig {
+	config := findConfig(f.Configs, "relation")
+	if config == nil {
+		return nil
+	}
+	r, _ := decodeRelation(config)
+	return r
+}
+
+// decodeRelation reads a @relation config, skipping and reporting entries
+// that are not one of its string keys.
+func decodeRelation(config *Config) (*RelationConfig, []Diagnostic) {
+	r := &RelationConfig{Span: config.Span}
+	diagnostics := decodeStrings(config,
+		stringKey{name: "inverse", target: &r.Inverse},
+		stringKey{name: "on_delete", target: &r.OnDelete, allowed: OnDeleteActions},
+		stringKey{name: "through", target: &r.Through},
+		stringKey{name: "foreign_key", target: &r.ForeignKey})
+	return r, diagnostics
+}
+
+// checkRelations builds the relation graph, one Relation for every field of
+// every model that refers to a model, inherited fields included. Fields of
+// inline object types are part of their owner and are not relations. A
+// @relation config is checked where its field is declared: the field must
+// refer to a model, its inverse must refer back and agree, and each key must
+// suit the side it is on.
+func (s *Schema) checkRelations() []Diagnostic {
+	s.Relations = nil
+	var diagnostics []Diagnostic
+	for _, model := range s.Models {
+		for _, field := range s.Fields(model) {
+			declared := model.Field(field.Name) == field
+			var config *RelationConfig
+			if c := findConfig(field.Configs, "relation"); c != nil {
+				var problems []Diagnostic
+				config, problems = decodeRelation(c)
+				if declared {
+					diagnostics = append(diagnostics, problems...)
+				}
+			}
+
+			target, many := s.relationTarget(field.FieldType())
+			if target == nil {
+				if declared && config != nil {
+					diagnostics = append(diagnostics, Errorf(CodeInvalidRelation, config.Span,
+						"@relation on '%s.%s' requires a field typed with a model or an array of models, found '%s'",
+						model.Name, field.Name, field.FieldType()))
+				}
+				continue
+			}
+
+			r := &Relation{Model: model.Name, Field: field.Name, Target: target.Name, Many: many, Span: field.Span}
+			if config != nil {
+				r.Inverse, r.OnDelete, r.Through, r.ForeignKey = config.Inverse, config.OnDelete, config.Through, config.ForeignKey
+			}
+			if r.Inverse == "" {
+				r.Inverse, r.Through = s.inverseOf(model, field, target, r.Through)
+			}
+			inverseMany := false
+			if inverse := s.effectiveField(target, r.Inverse); inverse != nil {
+				_, inverseMany = s.relationTarget(inverse.FieldType())
+			}
+			r.Cardinality = cardinality(many, r.Inverse != "", inverseMany, r.Through != "")
+			if declared && config != nil {
+				diagnostics = append(diagnostics, s.checkRelation(model, field, target, r, config)...)
+			}
+			s.Relations = append(s.Relations, r)
+		}
+	}
+	return diagnostics
+}
+
+// inverseOf finds the field of target whose @relation names field as its
+// inverse, returning its name and the join model either side declares.
+func (s *Schema) inverseOf(model *Model, field *Field, target *Model, through string) (string, string) {
+	for _, candidate := range s.Fields(target) {
+		config := candidate.RelationConfig()
+		if config == nil || config.Inverse != field.Name {
+			continue
+		}
+		if back, _ := s.relationTarget(candidate.FieldType()); back != model {
+			continue
+		}
+		if through == "" {
+			through = config.Through
+		}
+		return candidate.Name, through
+	}
+	return "", through
+}
+
+func (s *Schema) checkRelation(model *Model, field *Field, target *Model, r *Relation, config *RelationConfig) []Diagnostic {
+	var diagnostics []Diagnostic
+	errorf := func(format string, args ...any) {
+		diagnostics = append(diagnostics, Errorf(CodeInvalidRelation, config.Span, format, args...))
+	}
+	name := model.Name + "." + field.Name
+
+	if config.Inverse != "" {
+		inverse := s.effectiveField(target, config.Inverse)
+		switch {
+		case inverse == nil:
+			if s.resolvesFields(target) {
+				errorf("Relation '%s' names inverse '%s', which is not a field of '%s'", name, config.Inverse, target.Name)
+			}
+		default:
+			back, _ := s.relationTarget(inverse.FieldType())
+			other := inverse.RelationConfig()
+			switch {
+			case back != model:
+				errorf("Inverse '%s.%s' of '%s' does not refer to '%s'", target.Name, inverse.Name, name, model.Name)
+			case other != nil && other.Inverse != "" && other.Inverse != field.Name:
+				errorf("'%s' names '%s.%s' as its inverse, but '%s.%s' names '%s'",
+					name, target.Name, inverse.Name, target.Name, inverse.Name, other.Inverse)
+			case other != nil && other.Through != "" && config.Through != "" && other.Through != config.Through:
+				errorf("'%s' is joined through '%s', but its inverse '%s.%s' through '%s'",
+					name, config.Through, target.Name, inverse.Name, other.Through)
+			}
+		}
+	}
+
+	if config.Through != "" {
+		join := s.Model(config.Through)
+		switch {
+		case !r.Many:
+			errorf("through requires '%s' to hold several records, such as '%s[]'", name, target.Name)
+		case join == nil:
+			errorf("Join model '%s' of '%s' is not defined", config.Through, name)
+		case !s.refersTo(join, model) || !s.refersTo(join, target):
+			errorf("Join model '%s' of '%s' needs fields referring to both '%s' and '%s'", join.Name, name, model.Name, target.Name)
+		}
+	}
+
+	if config.OnDelete != "" {
+		switch {
+		case r.Many && config.Through == "":
+			errorf("on_delete belongs on the side holding the foreign key; '%s' holds several '%s' records", name, target.Name)
+		case config.OnDelete == "set_null" && !field.Optional && !field.Nullable:
+			errorf("on_delete: set_null requires '%s' to be optional or nullable", name)
+		}
+	}
+
+	if config.ForeignKey != "" && r.Many {
+		errorf("foreign_key applies to fields that hold one record; '%s' holds several", name)
+	}
+	return diagnostics
+}
+
+// relationTarget returns the model t refers to, following aliases, and
+// whether t holds several of them: `User`, `User | null` and an alias of
+// either give (User, false), and `User[]` gives (User, true). Other types,
+// and inline object types, give nil.
+func (s *Schema) relationTarget(t *TypeExpr) (*Model, bool) {
+	seen := map[string]bool{}
+	many := false
+	for {
+		switch t.Kind {
+		case Identifier:
+			if alias := s.TypeAlias(t.Name); alias != nil && !seen[alias.Name] {
+				seen[alias.Name] = true
+				t = alias.Type
+				continue
+			}
+			model := s.Model(t.Name)
+			if model == nil || model.InlineOf != nil {
+				return nil, false
+			}
+			return model, many
+		case Array:
+			if many {
+				return nil, false
+			}
+			many = true
+			t = t.Element
+			continue
+		case Union:
+			var member *TypeExpr
+			for _, m := range t.Members {
+				if m.Kind == Null {
+					continue
+				}
+				if member != nil {
+					return nil, false
+				}
+				member = m
+			}
+			if member == nil {
+				return nil, false
+			}
+			t = member
+			continue
+		}
+		return nil, false
+	}
+}
+
+// refersTo reports whether model has a field holding one target record.
+func (s *Schema) refersTo(model, target *Model) bool {
+	for _, field := range s.Fields(model) {
+		if m, many := s.relationTarget(field.FieldType()); m == target && !many {
+			return true
+		}
+	}
+	return false
+}
+
+func cardinality(many, hasInverse, inverseMany, through bool) Cardinality {
+	switch {
+	case many && (through || hasInverse && inverseMany):
+		return ManyToMany
+	case many:
+		return OneToMany
+	case hasInverse && !inverseMany:
+		return OneToOne
+	}
+	return ManyToOne
+}
+
+func contains(values []string, v string) bool {
+	for _, candidate := range values {
+		if candidate == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,615 @@
+// Package schema builds a semantic model of a single CDM file: its
+// directives, type aliases and models, with type expressions, defaults and
+// plugin configs decoded into Go values.
+//
+// Parse reports syntax errors and values that cannot be decoded. Check runs
+// the semantic checks that only need the file itself and records what it
+// infers, such as the discriminator of a union of models, on the schema.
+// Resolving `extends` chains and template imports is left to the caller.
+package schema
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	tree_sitter_cdm "github.com/larner-dev/cdm/bindings/go"
+	"github.com/larner-dev/cdm/bindings/go/literal"
+	"github.com/larner-dev/cdm/bindings/go/position"
+	"github.com/larner-dev/cdm/bindings/go/value"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// EntityID is the number of an `#N` entity ID. Zero means no ID was
+// assigned. IDs are scoped by the source that assigns them; see ScopedID.
+type EntityID int
+
+// Schema is the content of one CDM file.
+type Schema struct {
+	Extends     []*Extends
+	Imports     []*Import
+	Plugins     []*Plugin
+	TypeAliases []*TypeAlias
+	// Concrete models, followed after Check by the models it generates for
+	// instantiations of generic models
+	Models []*Model
+	// Generic models (`Page<T>`). They are templates for the models Check
+	// generates and are not models themselves.
+	Generics []*Model
+	// Model and type alias removals (`-Name`)
+	Removals []*Removal
+	// Set by Check: the relation graph, one Relation for each field of a
+	// model that refers to a model
+	Relations []*Relation
+	// Functions that field defaults may call. Nil means BuiltinFunctions;
+	// callers that load plugins register plugin functions here before
+	// calling Check.
+	Functions *Functions
+}
+
+// Extends is an `extends "<source>" { config }` directive.
+type Extends struct {
+	Source string
+	Config *value.Value
+	Span   position.Span
+}
+
+// Import is an `import <namespace> from "<source>" { config }` directive,
+// or a selective `import { Name, Name as Alias } from "<source>"` one, which
+// lists Names instead of a Namespace. The package imports resolves
+// selective imports.
+type Import struct {
+	Namespace string
+	Names     []*ImportName
+	Source    string
+	Config    *value.Value
+	Span      position.Span
+}
+
+// ImportName is one name listed in a selective import.
+type ImportName struct {
+	// Name of the definition in the template
+	Name string
+	// Name given with `as`; empty when the definition keeps its name
+	Alias string
+	Span  position.Span
+}
+
+// Local returns the name the importing file refers to the definition by.
+func (n *ImportName) Local() string {
+	if n.Alias != "" {
+		return n.Alias
+	}
+	return n.Name
+}
+
+// Plugin is an `@name from "<source>" { config }` plugin import. Source is
+// empty for registry plugins.
+type Plugin struct {
+	Name   string
+	Source string
+	Config *value.Value
+	Span   position.Span
+}
+
+// Config is an `@name { ... }` plugin configuration attached to a type
+// alias, model or field.
+type Config struct {
+	Name  string
+	Value value.Value
+	Span  position.Span
+}
+
+// Removal is a `-Name` removal of a model, type alias or field.
+type Removal struct {
+	Name string
+	Span position.Span
+}
+
+// Reference is a name that refers to another definition, such as a parent
+// in an extends clause.
+type Reference struct {
+	Name string
+	Span position.Span
+}
+
+// TypeAlias is a `Name: type { plugins } #id` definition.
+type TypeAlias struct {
+	Name     string
+	NameSpan position.Span
+	Type     *TypeExpr
+	Configs  []*Config
+	ID       EntityID
+	// Source that assigned ID: the local source unless the alias comes from
+	// an extended template
+	IDSource EntityIDSource
+	// Set by Check when the alias is a discriminated union of models
+	Discriminator *Discriminator
+	Span          position.Span
+}
+
+// Model is a `Name<Params> extends Parents { members } #id` definition.
+type Model struct {
+	Name     string
+	NameSpan position.Span
+	// Type parameters of a generic model; empty for other models
+	Params    []*Reference
+	Parents   []*Reference
+	Fields    []*Field
+	Removals  []*Removal
+	Overrides []*FieldOverride
+	Configs   []*Config
+	ID        EntityID
+	// Source that assigned ID: the local source unless the model comes from
+	// an extended template
+	IDSource EntityIDSource
+	// Set on the models Check generates for instantiations
+	Instance *Instance
+	// Set on models defined as `pick` or `omit` projections of another model
+	Projection *Projection
+	// Set on the models Check generates for inline object types
+	InlineOf *InlineOf
+	Span     position.Span
+}
+
+// Field is a field definition inside a model body.
+type Field struct {
+	Name     string
+	NameSpan position.Span
+	Optional bool
+	// Declared type; nil for untyped fields, which are strings
+	Type    *TypeExpr
+	Default *value.Value
+	Configs []*Config
+	ID      EntityID
+	// Source that assigned ID: the local source unless the field comes from
+	// an extended template
+	IDSource EntityIDSource
+	// Nullable is set by Check when the field's type admits null, such as
+	// `string | null` or an alias of it.
+	Nullable bool
+	// Set by Check for fields with a @computed config
+	Computed *Computation
+	Span     position.Span
+}
+
+// FieldOverride is a `name { @plugin { ... } }` override of an inherited
+// field's plugin configs.
+type FieldOverride struct {
+	Name    string
+	Configs []*Config
+	Span    position.Span
+}
+
+// FieldType returns the declared type of f, or `string` for untyped fields.
+func (f *Field) FieldType() *TypeExpr {
+	if f.Type == nil {
+		return Named("string")
+	}
+	return f.Type
+}
+
+func findConfig(configs []*Config, name string) *Config {
+	for _, config := range configs {
+		if config.Name == name {
+			return config
+		}
+	}
+	return nil
+}
+
+// Config returns the config for plugin name on the alias.
+func (a *TypeAlias) Config(name string) *Config { return findConfig(a.Configs, name) }
+
+// Config returns the config for plugin name on the model.
+func (m *Model) Config(name string) *Config { return findConfig(m.Configs, name) }
+
+// Config returns the config for plugin name on the field.
+func (f *Field) Config(name string) *Config { return findConfig(f.Configs, name) }
+
+// Field returns the field named name declared in the model body. Inherited
+// fields are not included; see Schema.Fields.
+func (m *Model) Field(name string) *Field {
+	for _, field := range m.Fields {
+		if field.Name == name {
+			return field
+		}
+	}
+	return nil
+}
+
+// TypeAlias returns the type alias named name, or nil.
+func (s *Schema) TypeAlias(name string) *TypeAlias {
+	for _, alias := range s.TypeAliases {
+		if alias.Name == name {
+			return alias
+		}
+	}
+	return nil
+}
+
+// Model returns the model named name, or nil.
+func (s *Schema) Model(name string) *Model {
+	for _, model := range s.Models {
+		if model.Name == name {
+			return model
+		}
+	}
+	return nil
+}
+
+// Fields returns the effective fields of a model: inherited fields in parent
+// order, minus removals, followed by the model's own fields. A field
+// redefined in the model replaces the inherited one in place. Parents that
+// are not defined in this file contribute nothing, and inheritance cycles are
+// cut.
+func (s *Schema) Fields(model *Model) []*Field {
+	return s.fields(model, map[string]bool{})
+}
+
+func (s *Schema) fields(model *Model, visiting map[string]bool) []*Field {
+	if visiting[model.Name] {
+		return nil
+	}
+	visiting[model.Name] = true
+	defer delete(visiting, model.Name)
+
+	var fields []*Field
+	index := map[string]int{}
+	add := func(field *Field) {
+		if i, ok := index[field.Name]; ok {
+			fields[i] = field
+			return
+		}
+		index[field.Name] = len(fields)
+		fields = append(fields, field)
+	}
+
+	for _, parent := range model.Parents {
+		if parentModel := s.Model(parent.Name); parentModel != nil {
+			for _, field := range s.fields(parentModel, visiting) {
+				add(field)
+			}
+		}
+	}
+	for _, removal := range model.Removals {
+		if i, ok := index[removal.Name]; ok {
+			fields[i] = nil
+			delete(index, removal.Name)
+		}
+	}
+	for _, field := range model.Fields {
+		add(field)
+	}
+
+	effective := fields[:0]
+	for _, field := range fields {
+		if field != nil {
+			effective = append(effective, field)
+		}
+	}
+	return effective
+}
+
+// Parse parses source and builds its Schema.
+func Parse(source []byte) (*Schema, []Diagnostic) {
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	if err := parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_cdm.Language())); err != nil {
+		return &Schema{}, []Diagnostic{{Severity: Error, Message: err.Error()}}
+	}
+
+	tree := parser.Parse(source, nil)
+	defer tree.Close()
+	return Build(tree.RootNode(), source)
+}
+
+// Build builds the Schema of an already parsed `source_file` node. Syntax
+// errors are reported and the definitions around them are kept, so a
+// partially valid file still yields a usable schema.
+func Build(root *tree_sitter.Node, source []byte) (*Schema, []Diagnostic) {
+	b := builder{source: source, schema: &Schema{}}
+	b.syntaxErrors(root)
+
+	for i := uint(0); i < root.NamedChildCount(); i++ {
+		node := root.NamedChild(i)
+		switch node.Kind() {
+		case "extends_template":
+			b.schema.Extends = append(b.schema.Extends, &Extends{
+				Source: b.stringField(node, "source"),
+				Config: b.config(node),
+				Span:   position.NodeSpan(node),
+			})
+		case "template_import":
+			b.schema.Imports = append(b.schema.Imports, &Import{
+				Namespace: b.text(node.ChildByFieldName("namespace")),
+				Names:     b.importNames(node.ChildByFieldName("names")),
+				Source:    b.stringField(node, "source"),
+				Config:    b.config(node),
+				Span:      position.NodeSpan(node),
+			})
+		case "plugin_import":
+			b.schema.Plugins = append(b.schema.Plugins, &Plugin{
+				Name:   b.text(node.ChildByFieldName("name")),
+				Source: b.stringField(node, "source"),
+				Config: b.config(node),
+				Span:   position.NodeSpan(node),
+			})
+		case "model_removal":
+			b.schema.Removals = append(b.schema.Removals, b.removal(node))
+		case "model_projection":
+			if model := b.projection(node); model != nil {
+				b.schema.Models = append(b.schema.Models, model)
+			}
+		case "type_alias":
+			if alias := b.typeAlias(node); alias != nil {
+				b.schema.TypeAliases = append(b.schema.TypeAliases, alias)
+			}
+		case "model_definition":
+			if model := b.model(node); model != nil && model.IsGeneric() {
+				b.schema.Generics = append(b.schema.Generics, model)
+			} else if model != nil {
+				b.schema.Models = append(b.schema.Models, model)
+			}
+		}
+	}
+	return b.schema, b.diagnostics
+}
+
+type builder struct {
+	source      []byte
+	schema      *Schema
+	diagnostics []Diagnostic
+}
+
+func (b *builder) syntaxErrors(node *tree_sitter.Node) {
+	if node.IsError() || node.IsMissing() {
+		b.diagnostics = append(b.diagnostics, Diagnostic{
+			Severity: Error,
+			Message:  fmt.Sprintf("Syntax error: unexpected '%s'", node.Utf8Text(b.source)),
+			Span:     position.NodeSpan(node),
+		})
+		return
+	}
+	if !node.HasError() {
+		return
+	}
+	for i := uint(0); i < node.ChildCount(); i++ {
+		b.syntaxErrors(node.Child(i))
+	}
+}
+
+// report records a value error unless it sits inside a syntax error that
+// has already been reported.
+func (b *builder) report(node *tree_sitter.Node, err error) {
+	if node.HasError() {
+		return
+	}
+	if valueErr, ok := err.(*value.Error); ok {
+		b.diagnostics = append(b.diagnostics, Diagnostic{Severity: Error, Message: valueErr.Message, Span: valueErr.Span})
+		return
+	}
+	b.diagnostics = append(b.diagnostics, Diagnostic{Severity: Error, Message: err.Error(), Span: position.NodeSpan(node)})
+}
+
+func (b *builder) text(node *tree_sitter.Node) string {
+	if node == nil {
+		return ""
+	}
+	return node.Utf8Text(b.source)
+}
+
+func (b *builder) stringField(node *tree_sitter.Node, field string) string {
+	child := node.ChildByFieldName(field)
+	if child == nil {
+		return ""
+	}
+	text, err := literal.Decode(child, b.source)
+	if err != nil {
+		b.report(child, &value.Error{Span: position.NodeSpan(child), Message: err.Error()})
+	}
+	return text
+}
+
+func (b *builder) config(node *tree_sitter.Node) *value.Value {
+	child := node.ChildByFieldName("config")
+	if child == nil {
+		return nil
+	}
+	v, err := value.FromNode(child, b.source)
+	if err != nil {
+		b.report(child, err)
+		return nil
+	}
+	return &v
+}
+
+// importNames builds the names of a selective import's `import_names`
+// node, or returns nil for a namespaced import.
+func (b *builder) importNames(node *tree_sitter.Node) []*ImportName {
+	if node == nil {
+		return nil
+	}
+	var names []*ImportName
+	cursor := node.Walk()
+	defer cursor.Close()
+	for _, child := range node.ChildrenByFieldName("name", cursor) {
+		names = append(names, &ImportName{
+			Name:  b.text(child.ChildByFieldName("name")),
+			Alias: b.text(child.ChildByFieldName("alias")),
+			Span:  position.NodeSpan(&child),
+		})
+	}
+	return names
+}
+
+func (b *builder) removal(node *tree_sitter.Node) *Removal {
+	return &Removal{Name: b.text(node.ChildByFieldName("name")), Span: position.NodeSpan(node)}
+}
+
+func (b *builder) entityID(node *tree_sitter.Node) EntityID {
+	child := node.ChildByFieldName("id")
+	if child == nil {
+		return 0
+	}
+	id, _ := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(b.text(child), "#")))
+	return EntityID(id)
+}
+
+// plugins decodes the plugin_config children of a plugin_block or model
+// body.
+func (b *builder) plugins(node *tree_sitter.Node) []*Config {
+	if node == nil {
+		return nil
+	}
+	var configs []*Config
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		child := node.NamedChild(i)
+		if child.Kind() != "plugin_config" {
+			continue
+		}
+		config := &Config{Name: b.text(child.ChildByFieldName("name")), Span: position.NodeSpan(child)}
+		if v := b.config(child); v != nil {
+			config.Value = *v
+		}
+		configs = append(configs, config)
+	}
+	return configs
+}
+
+func (b *builder) typeExpr(node *tree_sitter.Node) *TypeExpr {
+	if node == nil {
+		return nil
+	}
+	t, err := typeFromNode(node, b.source, b.field)
+	if err != nil {
+		b.report(node, err)
+		return nil
+	}
+	return t
+}
+
+func (b *builder) typeAlias(node *tree_sitter.Node) *TypeAlias {
+	name := node.ChildByFieldName("name")
+	t := b.typeExpr(node.ChildByFieldName("type"))
+	if name == nil || t == nil {
+		return nil
+	}
+	return &TypeAlias{
+		Name:     b.text(name),
+		NameSpan: position.NodeSpan(name),
+		Type:     t,
+		Configs:  b.plugins(node.ChildByFieldName("plugins")),
+		ID:       b.entityID(node),
+		Span:     position.NodeSpan(node),
+	}
+}
+
+func (b *builder) projection(node *tree_sitter.Node) *Model {
+	name := node.ChildByFieldName("name")
+	source := node.ChildByFieldName("source")
+	fields := node.ChildByFieldName("fields")
+	if name == nil || source == nil || fields == nil {
+		return nil
+	}
+	projection := &Projection{
+		Operator: b.text(node.ChildByFieldName("operator")),
+		Source:   &Reference{Name: b.text(source), Span: position.NodeSpan(source)},
+		Span:     position.NodeSpan(node),
+	}
+	cursor := fields.Walk()
+	for _, field := range fields.ChildrenByFieldName("field", cursor) {
+		projection.Fields = append(projection.Fields, &Reference{Name: b.text(&field), Span: position.NodeSpan(&field)})
+	}
+	cursor.Close()
+
+	return &Model{
+		Name:       b.text(name),
+		NameSpan:   position.NodeSpan(name),
+		Configs:    b.plugins(node.ChildByFieldName("plugins")),
+		ID:         b.entityID(node),
+		Projection: projection,
+		Span:       position.NodeSpan(node),
+	}
+}
+
+func (b *builder) model(node *tree_sitter.Node) *Model {
+	name := node.ChildByFieldName("name")
+	body := node.ChildByFieldName("body")
+	if name == nil || body == nil {
+		return nil
+	}
+	model := &Model{
+		Name:     b.text(name),
+		NameSpan: position.NodeSpan(name),
+		ID:       b.entityID(node),
+		Span:     position.NodeSpan(node),
+	}
+
+	if params := node.ChildByFieldName("parameters"); params != nil {
+		cursor := params.Walk()
+		for _, param := range params.ChildrenByFieldName("parameter", cursor) {
+			model.Params = append(model.Params, &Reference{Name: b.text(&param), Span: position.NodeSpan(&param)})
+		}
+		cursor.Close()
+	}
+
+	if extends := node.ChildByFieldName("extends"); extends != nil {
+		cursor := extends.Walk()
+		for _, parent := range extends.ChildrenByFieldName("parent", cursor) {
+			model.Parents = append(model.Parents, &Reference{Name: b.text(&parent), Span: position.NodeSpan(&parent)})
+		}
+		cursor.Close()
+	}
+
+	model.Configs = b.plugins(body)
+	for i := uint(0); i < body.NamedChildCount(); i++ {
+		child := body.NamedChild(i)
+		switch child.Kind() {
+		case "field_definition":
+			if field := b.field(child); field != nil {
+				model.Fields = append(model.Fields, field)
+			}
+		case "field_removal":
+			model.Removals = append(model.Removals, b.removal(child))
+		case "field_override":
+			model.Overrides = append(model.Overrides, &FieldOverride{
+				Name:    b.text(child.ChildByFieldName("name")),
+				Configs: b.plugins(child.ChildByFieldName("plugins")),
+				Span:    position.NodeSpan(child),
+			})
+		}
+	}
+	return model
+}
+
+func (b *builder) field(node *tree_sitter.Node) *Field {
+	name := node.ChildByFieldName("name")
+	if name == nil {
+		return nil
+	}
+	field := &Field{
+		Name:     b.text(name),
+		NameSpan: position.NodeSpan(name),
+		Optional: node.ChildByFieldName("optional") != nil,
+		Configs:  b.plugins(node.ChildByFieldName("plugins")),
+		ID:       b.entityID(node),
+		Span:     position.NodeSpan(node),
+	}
+	if typeNode := node.ChildByFieldName("type"); typeNode != nil {
+		field.Type = b.typeExpr(typeNode)
+	}
+	if defaultNode := node.ChildByFieldName("default"); defaultNode != nil {
+		v, err := value.FromNode(defaultNode, b.source)
+		if err != nil {
+			b.report(defaultNode, err)
+		} else {
+			field.Default = &v
+		}
+	}
+	return field
+}
+
+func isComment(node *tree_sitter.Node) bool {
+	return node.Kind() == "comment"
+}
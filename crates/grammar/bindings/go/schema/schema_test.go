@@ -0,0 +1,1709 @@
+package schema_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/larner-dev/cdm/bindings/go/schema"
+	"github.com/larner-dev/cdm/bindings/go/value"
+)
+
+func mustParse(t *testing.T, source string) *schema.Schema {
+	t.Helper()
+
+	s, diagnostics := schema.Parse([]byte(source))
+	if len(diagnostics) > 0 {
+		t.Fatalf("Parse returned diagnostics: %v", diagnostics)
+	}
+	return s
+}
+
+// check parses and checks source, returning the diagnostics of both steps.
+func check(t *testing.T, source string) (*schema.Schema, []schema.Diagnostic) {
+	t.Helper()
+
+	s := mustParse(t, source)
+	return s, s.Check()
+}
+
+func codes(diagnostics []schema.Diagnostic) string {
+	var out []string
+	for _, d := range diagnostics {
+		out = append(out, d.Code)
+	}
+	return strings.Join(out, ",")
+}
+
+func TestParse(t *testing.T) {
+	s := mustParse(t, `@sql { dialect: "postgres" }
+import auth from "cdm/auth"
+
+Email: string {
+  @validation { format: "email" }
+} #1
+
+Status: "active" | "pending" #2
+
+User extends Base {
+  email: Email #1
+  status?: Status = "active" #2
+  nickname
+  tags: string[] #3
+  labels: string[Locale]
+  -legacy
+  @sql { table: "users" }
+} #10
+`)
+
+	if len(s.Plugins) != 1 || s.Plugins[0].Name != "sql" || len(s.Imports) != 1 || s.Imports[0].Namespace != "auth" {
+		t.Errorf("directives = %+v %+v", s.Plugins, s.Imports)
+	}
+	if email := s.TypeAlias("Email"); email == nil || email.ID != 1 || email.Config("validation") == nil {
+		t.Errorf("Email = %+v", email)
+	}
+	if status := s.TypeAlias("Status"); status == nil || status.Type.String() != `"active" | "pending"` {
+		t.Errorf("Status = %+v", status)
+	}
+
+	user := s.Model("User")
+	if user == nil {
+		t.Fatal("User not found")
+	}
+	if user.ID != 10 || len(user.Parents) != 1 || user.Parents[0].Name != "Base" || len(user.Removals) != 1 {
+		t.Errorf("User = %+v", user)
+	}
+	if status := user.Field("status"); !status.Optional || status.Default == nil || status.Default.Text != "active" {
+		t.Errorf("status = %+v", status)
+	}
+	if nickname := user.Field("nickname"); nickname.Type != nil || nickname.FieldType().String() != "string" {
+		t.Errorf("nickname type = %v", nickname.Type)
+	}
+	if labels := user.Field("labels"); labels.Type.Kind != schema.Map || labels.Type.Key.Name != "Locale" {
+		t.Errorf("labels type = %+v", labels.Type)
+	}
+	if user.Config("sql") == nil {
+		t.Error("User should have an @sql config")
+	}
+}
+
+func TestParseSyntaxError(t *testing.T) {
+	s, diagnostics := schema.Parse([]byte("Email: string\nUser {\n  name: string\n  : broken\n}\nPost {\n  title: string\n}\n"))
+
+	if !schema.HasErrors(diagnostics) || !strings.Contains(diagnostics[0].String(), "Syntax error") {
+		t.Errorf("diagnostics = %v, want a syntax error", diagnostics)
+	}
+	if s.TypeAlias("Email") == nil {
+		t.Error("definitions before the error should be kept")
+	}
+}
+
+func TestInheritedFields(t *testing.T) {
+	s := mustParse(t, `Base {
+  id: string
+  legacy: string
+  kind: string
+}
+
+Child extends Base {
+  -legacy
+  kind: "child"
+  name: string
+}
+`)
+
+	var names []string
+	for _, field := range s.Fields(s.Model("Child")) {
+		names = append(names, field.Name+":"+field.FieldType().String())
+	}
+	if got, want := strings.Join(names, " "), `id:string kind:"child" name:string`; got != want {
+		t.Errorf("Fields = %s, want %s", got, want)
+	}
+}
+
+const eventUnion = `Event: Created | Deleted
+
+Created {
+  type: "created"
+  id: string
+}
+
+Deleted {
+  type: "deleted"
+  id: string
+}
+`
+
+func TestCycles(t *testing.T) {
+	_, diagnostics := check(t, `A extends C {
+  a: string
+}
+
+B extends A {
+  b: string
+}
+
+C extends B {
+  c: string
+}
+
+Self extends Self {
+  s: string
+}
+
+D extends A {
+  d: string
+}
+
+Tree: Node | Leaf
+Node: Tree[]
+Leaf: string
+Loop: Loop
+Person {
+  friends: Person[]
+}
+`)
+	var got []string
+	for _, d := range diagnostics {
+		got = append(got, fmt.Sprintf("%s %d: %s", d.Code, d.Span.Start.Row, d.Message))
+	}
+	want := []string{
+		"E102 0: Circular inheritance detected: A -> C -> B -> A",
+		"E102 12: Circular inheritance detected: Self -> Self",
+		"E102 20: Circular type reference detected: Tree -> Node -> Tree",
+		"E102 23: Circular type reference detected: Loop -> Loop",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("diagnostics =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	// A projection extends its source once linked.
+	_, diagnostics = check(t, "Source extends Public {\n  id: string\n}\n\nPublic: pick Source { id }\n")
+	if codes(diagnostics) != "E102" {
+		t.Errorf("diagnostics = %v, want E102 for a model extending its own projection", diagnostics)
+	}
+}
+
+func TestInferDiscriminator(t *testing.T) {
+	s, diagnostics := check(t, eventUnion)
+	if len(diagnostics) > 0 {
+		t.Fatalf("Check returned diagnostics: %v", diagnostics)
+	}
+
+	d := s.TypeAlias("Event").Discriminator
+	if d == nil || d.Explicit {
+		t.Fatalf("Discriminator = %v, want an inferred discriminator", d)
+	}
+	if got, want := d.String(), `type: "created" => Created, "deleted" => Deleted`; got != want {
+		t.Errorf("Discriminator = %s, want %s", got, want)
+	}
+}
+
+func TestInheritedDiscriminatorThroughAlias(t *testing.T) {
+	s, diagnostics := check(t, `Shape: Circle | Square
+SquareKind: "square"
+
+Base {
+  kind: string
+}
+
+Circle extends Base {
+  kind: "circle"
+}
+
+Square extends Base {
+  kind: SquareKind
+}
+`)
+	if len(diagnostics) > 0 {
+		t.Fatalf("Check returned diagnostics: %v", diagnostics)
+	}
+	if d := s.TypeAlias("Shape").Discriminator; d == nil || d.Variants[1].Value.Text != "square" {
+		t.Errorf("Discriminator = %v", d)
+	}
+}
+
+func TestExplicitDiscriminator(t *testing.T) {
+	s, diagnostics := check(t, `ConfigValue: StringConfig | NumberConfig {
+  @union { discriminator: "type" }
+}
+
+StringConfig {
+  type: "string"
+  version: 1
+}
+
+NumberConfig {
+  type: "number"
+  version: 2
+}
+`)
+	if len(diagnostics) > 0 {
+		t.Fatalf("Check returned diagnostics: %v", diagnostics)
+	}
+	if d := s.TypeAlias("ConfigValue").Discriminator; d == nil || !d.Explicit || d.Field != "type" {
+		t.Errorf("Discriminator = %v, want explicit 'type'", d)
+	}
+}
+
+func TestDiscriminatorErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		source string
+		codes  string
+		want   string
+	}{
+		{
+			name:   "no literal field",
+			source: "U: A | B\nA {\n  id: string\n}\nB {\n  id: string\n}\n",
+			codes:  "E105",
+			want:   "Cannot infer a discriminator",
+		},
+		{
+			name:   "ambiguous",
+			source: "U: A | B\nA {\n  type: \"a\"\n  kind: 1\n}\nB {\n  type: \"b\"\n  kind: 2\n}\n",
+			codes:  "E105",
+			want:   "'type' or 'kind'",
+		},
+		{
+			name:   "duplicate value",
+			source: "U: A | B | C\nA {\n  type: \"a\"\n}\nB {\n  type: \"b\"\n}\nC {\n  type: \"a\"\n}\n",
+			codes:  "E106",
+			want:   "already used by 'A'",
+		},
+		{
+			name:   "explicit field missing",
+			source: "U: A | B { @union { discriminator: \"tag\" } }\nA {\n  tag: \"a\"\n}\nB {\n  type: \"b\"\n}\n",
+			codes:  "E105",
+			want:   "member 'B' has no discriminator field 'tag'",
+		},
+		{
+			name:   "explicit field not literal",
+			source: "U: A | B { @union { discriminator: \"tag\" } }\nA {\n  tag: \"a\"\n}\nB {\n  tag: string\n}\n",
+			codes:  "E105",
+			want:   "must have a literal type",
+		},
+		{
+			name:   "union config on mixed union",
+			source: "U: A | \"none\" { @union { discriminator: \"tag\" } }\nA {\n  tag: \"a\"\n}\n",
+			codes:  "E105",
+			want:   "can only contain models",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s, diagnostics := check(t, c.source)
+			if got := codes(diagnostics); got != c.codes {
+				t.Fatalf("codes = %s, want %s (%v)", got, c.codes, diagnostics)
+			}
+			if !strings.Contains(diagnostics[0].Message, c.want) {
+				t.Errorf("message = %q, want it to mention %q", diagnostics[0].Message, c.want)
+			}
+			if s.TypeAlias("U").Discriminator != nil {
+				t.Error("an invalid union should not get a discriminator")
+			}
+		})
+	}
+}
+
+func TestMixedUnionIsNotDiscriminated(t *testing.T) {
+	s, diagnostics := check(t, "Result: \"error\" | Payload\nPayload {\n  data: JSON\n}\n")
+	if len(diagnostics) > 0 || s.TypeAlias("Result").Discriminator != nil {
+		t.Errorf("mixed unions should be left alone, got %v", diagnostics)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	s, diagnostics := check(t, `Event: Created | Deleted #1
+
+Created {
+  type: "created" #1
+  count: number = 0 { @sql { type: "INT" } }
+  scores: number[string]
+} #2
+
+Deleted extends Created {
+  type: "deleted"
+} #3
+`)
+	if len(diagnostics) > 0 {
+		t.Fatalf("Check returned diagnostics: %v", diagnostics)
+	}
+
+	encoded, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	for _, want := range []string{
+		`{"name":"Event","id":1,"alias_type":{"kind":"union","members":[{"kind":"identifier","name":"Created"},{"kind":"identifier","name":"Deleted"}]},"discriminator":{"field":"type","variants":[{"value":"created","model":"Created"},{"value":"deleted","model":"Deleted"}]},"config":{}}`,
+		`{"name":"type","id":1,"field_type":{"kind":"string_literal","value":"created"},"optional":false,"nullable":false,"default":null,"config":{}}`,
+		`{"name":"count","id":null,"field_type":{"kind":"identifier","name":"number"},"optional":false,"nullable":false,"default":0,"config":{"sql":{"type":"INT"}}}`,
+		`"field_type":{"kind":"map","value_type":{"kind":"identifier","name":"number"},"key_type":{"kind":"identifier","name":"string"}}`,
+		`"parents":["Created"]`,
+	} {
+		if !strings.Contains(string(encoded), want) {
+			t.Errorf("JSON is missing %s\n%s", want, encoded)
+		}
+	}
+}
+
+func TestDefaults(t *testing.T) {
+	const types = `Status: "draft" | "published"
+Priority: 1 | 2 | 3
+Locale: "en" | "fr"
+Tags: string[]
+
+Address {
+  city: string
+  zip?: string
+}
+`
+	cases := []struct {
+		field string
+		want  string
+	}{
+		{`active: boolean = true`, ""},
+		{`status: "draft" | "published" = "draft"`, ""},
+		{`status: Status = "published"`, ""},
+		{`priority: Priority = 2`, ""},
+		{`tags: Tags = ["a", "b"]`, ""},
+		{`labels: string[Locale] = { en: "Hello", fr: "Bonjour" }`, ""},
+		{`ranks: string[Priority] = { 1: "low" }`, ""},
+		{`home: Address = { city: "Paris" }`, ""},
+		{`extra: JSON = { anything: [1, "two"] }`, ""},
+		{`pg: sql.UUID = "x"`, ""},
+
+		{`active: boolean = "yes"`, `expected boolean, found "yes"`},
+		{`status: "draft" | "published" = "drafted"`, `expected "draft" | "published", found "drafted"`},
+		{`status: Status = "drafted"`, `expected Status ("draft" | "published"), found "drafted"`},
+		{`priority: Priority = 4`, `expected Priority (1 | 2 | 3), found 4`},
+		{`tags: Tags = ["a", 2]`, `[1]: expected string, found 2`},
+		{`labels: string[Locale] = { de: "Hallo" }`, `expected key of type Locale, found key "de"`},
+		{`labels: string[Locale] = { en: 1 }`, `.en: expected string, found 1`},
+		{`ranks: string[Priority] = { 7: "high" }`, `expected key of type Priority, found key "7"`},
+		{`home: Address = { city: "Paris", country: "FR" }`, `unknown field "country"`},
+		{`home: Address = { zip: "75001" }`, `expected field 'city' of Address, found no value`},
+		{`home: Address = { city: 75 }`, `.city: expected string, found 75`},
+		{`name: string = null`, `expected string, found null`},
+		{`bio?: string = null`, `expected string, found null`},
+		{`home: Address = { city: "Paris", zip: null }`, `.zip: expected string, found null`},
+	}
+	for _, c := range cases {
+		t.Run(c.field, func(t *testing.T) {
+			source := types + "\nPost {\n  " + c.field + "\n}\n"
+			s, diagnostics := schema.Parse([]byte(source))
+			diagnostics = append(diagnostics, s.Check()...)
+
+			if c.want == "" {
+				if len(diagnostics) > 0 {
+					t.Fatalf("unexpected diagnostics: %v", diagnostics)
+				}
+				return
+			}
+			if codes(diagnostics) != schema.CodeInvalidDefault {
+				t.Fatalf("diagnostics = %v, want one %s", diagnostics, schema.CodeInvalidDefault)
+			}
+			if !strings.Contains(diagnostics[0].Message, c.want) {
+				t.Errorf("message = %q, want it to contain %q", diagnostics[0].Message, c.want)
+			}
+			if start := diagnostics[0].Span.StartByte; start < strings.Index(source, "=") {
+				t.Errorf("diagnostic starts at byte %d, before the default", start)
+			}
+		})
+	}
+}
+
+// Plugin settings schemas are CDM files too, and their defaults become the
+// values plugins receive when a setting is left out.
+func TestPluginSettingsSchemaDefaults(t *testing.T) {
+	paths, err := filepath.Glob("../../../../cdm-plugin-*/schema.cdm")
+	if err != nil || len(paths) == 0 {
+		t.Skipf("no plugin settings schemas found: %v", err)
+	}
+	for _, path := range paths {
+		source, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s, diagnostics := schema.Parse(source)
+		diagnostics = append(diagnostics, s.Check()...)
+		for _, d := range diagnostics {
+			t.Errorf("%s: %s", path, d)
+		}
+	}
+}
+
+// withDefault parses a one-field model and sets the field's default to v.
+// Calls are set directly so these tests do not depend on the generated
+// parser knowing function_call defaults.
+func withDefault(t *testing.T, fieldType string, v value.Value) *schema.Schema {
+	t.Helper()
+
+	s := mustParse(t, "Timestamp: string\nCounter: number\n\nPost {\n  field: "+fieldType+"\n}\n")
+	s.Model("Post").Fields[0].Default = &v
+	return s
+}
+
+func call(name string) value.Value {
+	return value.Value{Kind: value.Call, Text: name}
+}
+
+func TestParseFunctionCallDefault(t *testing.T) {
+	s, diagnostics := schema.Parse([]byte("Post {\n  created_at: string = now() #1\n}\n"))
+	if len(diagnostics) > 0 {
+		t.Fatalf("parse: %v", diagnostics)
+	}
+	if d := s.Model("Post").Field("created_at").Default; d == nil || d.Kind != value.Call || d.Text != "now" {
+		t.Errorf("default = %+v, want now()", d)
+	}
+}
+
+func TestFunctionCallDefaults(t *testing.T) {
+	cases := []struct {
+		fieldType string
+		function  string
+		code      string
+	}{
+		{"string", "now", ""},
+		{"Timestamp", "now", ""},
+		{"string | number", "uuid", ""},
+		{"number", "autoincrement", ""},
+		{"Counter", "autoincrement", ""},
+		{"JSON", "now", ""},
+		{"boolean", "now", schema.CodeInvalidDefault},
+		{"\"draft\" | \"published\"", "uuid", schema.CodeInvalidDefault},
+		{"string[]", "now", schema.CodeInvalidDefault},
+		{"string", "today", schema.CodeUnknownFunction},
+	}
+	for _, c := range cases {
+		s := withDefault(t, c.fieldType, call(c.function))
+		if got := codes(s.Check()); got != c.code {
+			t.Errorf("%s = %s(): codes = %q, want %q", c.fieldType, c.function, got, c.code)
+		}
+	}
+}
+
+func TestPluginFunctions(t *testing.T) {
+	functions := schema.BuiltinFunctions()
+	if err := functions.Register(schema.Function{Name: "gen_random_uuid", Returns: schema.Named("string"), Plugin: "sql"}); err != nil {
+		t.Fatalf("Register returned error: %v", err)
+	}
+	if err := functions.Register(schema.Function{Name: "now", Returns: schema.Named("string"), Plugin: "sql"}); err == nil {
+		t.Error("registering a built-in name should fail")
+	}
+	if err := functions.Register(schema.Function{Name: "gen_random_uuid", Returns: schema.Named("string"), Plugin: "other"}); err == nil || !strings.Contains(err.Error(), "plugin 'sql'") {
+		t.Errorf("duplicate plugin function error = %v", err)
+	}
+
+	s := withDefault(t, "string", call("gen_random_uuid"))
+	if diagnostics := s.Check(); codes(diagnostics) != schema.CodeUnknownFunction || !strings.Contains(diagnostics[0].Message, "autoincrement, now, uuid") {
+		t.Errorf("without the plugin: %v", diagnostics)
+	}
+	s.Functions = functions
+	if diagnostics := s.Check(); len(diagnostics) > 0 {
+		t.Errorf("with the plugin: %v", diagnostics)
+	}
+}
+
+func TestFunctionCallJSON(t *testing.T) {
+	s := withDefault(t, "string", call("now"))
+	encoded, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	if want := `"default":{"kind":"call","name":"now"}`; !strings.Contains(string(encoded), want) {
+		t.Errorf("JSON is missing %s\n%s", want, encoded)
+	}
+}
+
+func TestMapKeys(t *testing.T) {
+	const types = `Locale: "en" | "fr"
+Rank: 1 | 2 | 3
+Key: string
+Flag: boolean
+Mixed: "a" | 1
+
+User {
+  name: string
+}
+`
+	cases := []struct {
+		fieldType string
+		want      string
+	}{
+		{"User[string]", ""},
+		{"User[number]", ""},
+		{"string[Locale]", ""},
+		{"string[Rank]", ""},
+		{"string[Key]", ""},
+		{`string["a" | "b"]`, ""},
+		{"string[string][Locale]", ""},
+		{"string[sql.Key]", ""},
+
+		{"string[boolean]", "boolean keys are not supported"},
+		{"string[User]", "'User' is a model"},
+		{"string[Flag]", "'Flag' resolves to 'boolean', and boolean keys are not supported"},
+		{"string[Mixed]", "mixes string and number keys"},
+		{"string[Locale | Rank]", "mixes string and number keys"},
+		{"string[User][string]", "'User' is a model"},
+		{"string[string][Flag]", "'Flag' resolves to 'boolean'"},
+		{"string[JSON]", "JSON keys are not supported"},
+	}
+	for _, c := range cases {
+		t.Run(c.fieldType, func(t *testing.T) {
+			_, diagnostics := check(t, types+"\nPost {\n  field: "+c.fieldType+"\n}\n")
+			if c.want == "" {
+				if len(diagnostics) > 0 {
+					t.Fatalf("unexpected diagnostics: %v", diagnostics)
+				}
+				return
+			}
+			if codes(diagnostics) != schema.CodeInvalidMapKey {
+				t.Fatalf("diagnostics = %v, want one %s", diagnostics, schema.CodeInvalidMapKey)
+			}
+			if !strings.Contains(diagnostics[0].Message, c.want) {
+				t.Errorf("message = %q, want it to contain %q", diagnostics[0].Message, c.want)
+			}
+		})
+	}
+}
+
+func TestMapKeysInAliases(t *testing.T) {
+	_, diagnostics := check(t, "Lookup: string[Post]\n\nPost {\n  title: string\n}\n")
+	if codes(diagnostics) != schema.CodeInvalidMapKey || diagnostics[0].Span.Start.Column != 15 {
+		t.Errorf("diagnostics = %v, want E109 at the key", diagnostics)
+	}
+}
+
+const deprecations = `Email: string {
+  @deprecated { reason: "too loose", since: "2.3", replacement: EmailAddress }
+}
+
+EmailAddress: string
+
+Legacy {
+  @deprecated {}
+  email: Email
+}
+
+Base {
+  name: string
+  nickname: string { @deprecated { replacement: "name" } }
+}
+
+User extends Base {
+  email: Email
+  old_email: Email { @deprecated { since: "2.0" } }
+  contacts: Email[]
+  name { @deprecated { reason: "split into first and last" } }
+}
+
+Admin extends Legacy {
+  level: number
+}
+`
+
+func TestDeprecationWarnings(t *testing.T) {
+	_, diagnostics := check(t, deprecations)
+
+	var got []string
+	for _, d := range diagnostics {
+		got = append(got, d.String())
+	}
+	want := []string{
+		"warning[18:10]: W007: 'Email' is deprecated since 2.3: too loose (use 'EmailAddress' instead)",
+		"warning[20:13]: W007: 'Email' is deprecated since 2.3: too loose (use 'EmailAddress' instead)",
+		"warning[24:15]: W007: 'Legacy' is deprecated",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("diagnostics =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if schema.HasErrors(diagnostics) {
+		t.Error("deprecation warnings should not be errors")
+	}
+}
+
+func TestDeprecationConfigErrors(t *testing.T) {
+	cases := []string{
+		`Email: string { @deprecated { reason: 3 } }`,
+		`Email: string { @deprecated { why: "because" } }`,
+		"User {\n  email: string { @deprecated { replacement: \"mail\" } }\n}",
+	}
+	for _, source := range cases {
+		_, diagnostics := check(t, source)
+		if codes(diagnostics) != schema.CodeInvalidPluginConfig {
+			t.Errorf("Check(%q) = %v, want %s", source, diagnostics, schema.CodeInvalidPluginConfig)
+		}
+	}
+
+	// A replacement inherited from a parent defined in another file cannot
+	// be verified here.
+	if _, diagnostics := check(t, "User extends Base {\n  email: string { @deprecated { replacement: \"mail\" } }\n}"); len(diagnostics) > 0 {
+		t.Errorf("unexpected diagnostics: %v", diagnostics)
+	}
+}
+
+func TestFieldDeprecation(t *testing.T) {
+	s := mustParse(t, deprecations)
+	user, base := s.Model("User"), s.Model("Base")
+
+	cases := []struct {
+		model *schema.Model
+		field string
+		want  string
+	}{
+		{user, "name", "'name' is deprecated: split into first and last"},
+		{base, "name", ""},
+		{user, "nickname", "'nickname' is deprecated (use 'name' instead)"},
+		{user, "old_email", "'old_email' is deprecated since 2.0"},
+		{user, "email", ""},
+	}
+	for _, c := range cases {
+		got := ""
+		if d := s.FieldDeprecation(c.model, c.field); d != nil {
+			got = d.Describe(c.field)
+		}
+		if got != c.want {
+			t.Errorf("FieldDeprecation(%s, %s) = %q, want %q", c.model.Name, c.field, got, c.want)
+		}
+
+		got = ""
+		if d := s.FieldDeprecations(c.model)[c.field]; d != nil {
+			got = d.Describe(c.field)
+		}
+		if got != c.want {
+			t.Errorf("FieldDeprecations(%s)[%s] = %q, want %q", c.model.Name, c.field, got, c.want)
+		}
+	}
+}
+
+func TestDeprecationJSON(t *testing.T) {
+	encoded, err := json.Marshal(mustParse(t, deprecations))
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	for _, want := range []string{
+		`"deprecated":{"reason":"too loose","since":"2.3","replacement":"EmailAddress"}`,
+		`{"name":"Legacy","id":null,"parents":[],"fields":[{"name":"email","id":null,"field_type":{"kind":"identifier","name":"Email"},"optional":false,"nullable":false,"default":null,"config":{}}],"deprecated":{},"config":{"deprecated":{}}}`,
+		`"name":"old_email","id":null,"field_type":{"kind":"identifier","name":"Email"},"optional":false,"nullable":false,"default":null,"deprecated":{"since":"2.0"}`,
+	} {
+		if !strings.Contains(string(encoded), want) {
+			t.Errorf("JSON is missing %s\n%s", want, encoded)
+		}
+	}
+}
+
+func TestParseGenericModel(t *testing.T) {
+	s, diagnostics := schema.Parse([]byte("Page<T> {\n  items: T[]\n}\n\nFeed {\n  users: Page<User>\n}\n"))
+	if len(diagnostics) > 0 {
+		t.Fatalf("parse: %v", diagnostics)
+	}
+	if page := s.Generic("Page"); page == nil || len(page.Params) != 1 || page.Params[0].Name != "T" {
+		t.Errorf("Generic(Page) = %+v", page)
+	}
+	if users := s.Model("Feed").Field("users").Type; users.String() != "Page<User>" {
+		t.Errorf("users type = %s, want Page<User>", users)
+	}
+}
+
+// instance builds a `name<args>` type expression.
+func instance(name string, args ...*schema.TypeExpr) *schema.TypeExpr {
+	return &schema.TypeExpr{Kind: schema.Identifier, Name: name, Args: args}
+}
+
+func arrayOf(element *schema.TypeExpr) *schema.TypeExpr {
+	return &schema.TypeExpr{Kind: schema.Array, Element: element}
+}
+
+// withGenerics parses source, turns the models in params into generic models
+// with those type parameters, and sets the types of the fields in types,
+// keyed by "Model.field". Generics are set up directly so these tests do not
+// depend on the generated parser knowing generic syntax.
+func withGenerics(t *testing.T, source string, params map[string][]string, types map[string]*schema.TypeExpr) *schema.Schema {
+	t.Helper()
+
+	s := mustParse(t, source)
+	models := s.Models[:0]
+	for _, model := range s.Models {
+		if names, ok := params[model.Name]; ok {
+			for _, name := range names {
+				model.Params = append(model.Params, &schema.Reference{Name: name})
+			}
+			s.Generics = append(s.Generics, model)
+		} else {
+			models = append(models, model)
+		}
+	}
+	s.Models = models
+	for path, typ := range types {
+		model, field, _ := strings.Cut(path, ".")
+		m := s.Model(model)
+		if m == nil {
+			m = s.Generic(model)
+		}
+		m.Field(field).Type = typ
+	}
+	return s
+}
+
+const pages = `User {
+  name: string
+} #10
+
+Page {
+  items: T[] #1
+  cursor?: string #2
+} #12
+
+Feed {
+  users: string
+  nested: string
+  tags: string
+}
+`
+
+func TestInstantiate(t *testing.T) {
+	s := withGenerics(t, pages, map[string][]string{"Page": {"T"}}, map[string]*schema.TypeExpr{
+		"Feed.users":  instance("Page", schema.Named("User")),
+		"Feed.nested": instance("Page", instance("Page", schema.Named("User"))),
+		"Feed.tags":   instance("Page", arrayOf(schema.Named("string"))),
+	})
+	for i := 0; i < 2; i++ {
+		if diagnostics := s.Check(); len(diagnostics) > 0 {
+			t.Fatalf("Check returned diagnostics: %v", diagnostics)
+		}
+	}
+
+	var names []string
+	for _, model := range s.Models {
+		names = append(names, model.Name)
+	}
+	if got, want := strings.Join(names, " "), "User Feed Page_User Page_Page_User Page_stringList"; got != want {
+		t.Errorf("models = %s, want %s", got, want)
+	}
+
+	users := s.Model("Feed").Field("users").Type
+	if users.Name != "Page_User" || users.Generic != "Page" || users.String() != "Page<User>" {
+		t.Errorf("users type = %+v", users)
+	}
+	cases := []struct {
+		model, key, items string
+	}{
+		{"Page_User", "#12<#10>", "User[]"},
+		{"Page_Page_User", "#12<#12<#10>>", "Page<User>[]"},
+		{"Page_stringList", "#12<string[]>", "string[][]"},
+	}
+	for _, c := range cases {
+		model := s.Model(c.model)
+		if model.Instance.Generic != "Page" || model.Instance.Key != c.key {
+			t.Errorf("%s instance = %+v, want key %s", c.model, model.Instance, c.key)
+		}
+		items := model.Field("items")
+		if items.Type.String() != c.items || items.ID != 1 {
+			t.Errorf("%s.items = %s #%d, want %s #1", c.model, items.Type, items.ID, c.items)
+		}
+	}
+	if items := s.Model("Page_Page_User").Field("items").Type; items.Element.Name != "Page_User" {
+		t.Errorf("Page_Page_User.items element = %s, want the Page_User model", items.Element.Name)
+	}
+	if items := s.Generic("Page").Field("items").Type; items.Element.Name != "T" {
+		t.Errorf("the generic model was modified: items = %s", items)
+	}
+}
+
+func TestGenericErrors(t *testing.T) {
+	user, page := schema.Named("User"), instance("Page", schema.Named("User"))
+	union := &schema.TypeExpr{Kind: schema.Union, Members: []*schema.TypeExpr{schema.Named("User"), schema.Named("string")}}
+	cases := []struct {
+		name   string
+		params map[string][]string
+		types  map[string]*schema.TypeExpr
+		want   string
+	}{
+		{"valid", map[string][]string{"Page": {"T"}}, map[string]*schema.TypeExpr{"Feed.users": page}, "Generic model 'Page<T>'"},
+		{"missing arguments", map[string][]string{"Page": {"T"}}, map[string]*schema.TypeExpr{"Feed.users": schema.Named("Page")},
+			"Generic model 'Page' needs 1 type argument, as in 'Page<T>'"},
+		{"too many arguments", map[string][]string{"Page": {"T"}}, map[string]*schema.TypeExpr{"Feed.users": instance("Page", user, user)},
+			"Generic model 'Page<T>' takes 1 type argument, found 2"},
+		{"not generic", map[string][]string{"Page": {"T"}}, map[string]*schema.TypeExpr{"Feed.users": instance("User", user)},
+			"'User' is not a generic model and takes no type arguments"},
+		{"union argument", map[string][]string{"Page": {"T"}}, map[string]*schema.TypeExpr{"Feed.users": instance("Page", union)},
+			"Type argument 'User | string' of 'Page' must be a named type, array, map or instantiation; declare a type alias for 'User | string'"},
+		{"in a generic model", map[string][]string{"Page": {"T"}}, map[string]*schema.TypeExpr{"Page.cursor": instance("Page")},
+			"Generic model 'Page' needs 1 type argument, as in 'Page<T>'"},
+		{"duplicate parameter", map[string][]string{"Page": {"T", "T"}}, nil, "Duplicate type parameter 'T' in 'Page'"},
+		{"hidden type", map[string][]string{"Page": {"User"}}, nil, "Type parameter 'User' of 'Page' hides the type 'User'"},
+		{"extends a generic model", map[string][]string{"Page": {"T"}, "Feed": {"T"}}, nil, "Model 'Admin' cannot extend generic model 'Feed'"},
+		{"name collision", map[string][]string{"Page": {"T"}}, map[string]*schema.TypeExpr{"Feed.users": instance("Page", schema.Named("Taken"))},
+			"'Page<Taken>' generates the model 'Page_Taken', which is already defined"},
+		{"instances with the same name", map[string][]string{"Page": {"T"}}, map[string]*schema.TypeExpr{
+			"Feed.users": instance("Page", arrayOf(user)),
+			"Feed.tags":  instance("Page", schema.Named("UserList")),
+		}, "'Page<UserList>' and 'Page<User[]>' both generate the model 'Page_UserList'; declare a type alias for one of the arguments"},
+		{"does not terminate", map[string][]string{"Page": {"T"}}, map[string]*schema.TypeExpr{
+			"Feed.users":  page,
+			"Page.cursor": instance("Page", arrayOf(schema.Named("T"))),
+		}, "Instantiating 'Page<User[][][][][][][][][][][][][][][][]>' does not terminate: 'Page' is instantiated with ever larger type arguments"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := withGenerics(t, pages+"\nUserList: string\n\nPage_Taken {\n  x: string\n}\n\nAdmin extends Feed {\n  y: string\n}\n", c.params, c.types)
+			var got []string
+			for _, d := range s.Check() {
+				if d.Code != schema.CodeInvalidGeneric {
+					t.Errorf("unexpected diagnostic %s", d)
+				}
+				got = append(got, d.Message)
+			}
+			if c.name == "valid" {
+				if len(got) > 0 {
+					t.Errorf("Check = %v, want no diagnostics", got)
+				}
+				return
+			}
+			if len(got) != 1 || got[0] != c.want {
+				t.Errorf("Check = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestGenericJSON(t *testing.T) {
+	s := withGenerics(t, pages, map[string][]string{"Page": {"T"}}, map[string]*schema.TypeExpr{"Feed.users": instance("Page", schema.Named("User"))})
+	s.Check()
+	encoded, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	for _, want := range []string{
+		`{"kind":"identifier","name":"Page_User"}`,
+		`{"name":"Page_User","id":null,"instance_of":{"generic":"Page","arguments":[{"kind":"identifier","name":"User"}],"key":"#12\u003c#10\u003e"},"parents":[],` +
+			`"fields":[{"name":"items","id":1,"field_type":{"kind":"array","element":{"kind":"identifier","name":"User"}}`,
+	} {
+		if !strings.Contains(string(encoded), want) {
+			t.Errorf("JSON is missing %s\n%s", want, encoded)
+		}
+	}
+	if strings.Contains(string(encoded), `"name":"Page",`) {
+		t.Errorf("JSON lists the generic model\n%s", encoded)
+	}
+}
+
+func TestParseProjection(t *testing.T) {
+	s, diagnostics := schema.Parse([]byte("User {\n  id: string\n  name: string\n}\n\nUserPublic: pick User { id, name } #20\n"))
+	if len(diagnostics) > 0 {
+		t.Fatalf("parse: %v", diagnostics)
+	}
+	if p := s.Model("UserPublic").Projection; p == nil || p.String() != "pick User { id, name }" {
+		t.Errorf("projection = %v, want pick User { id, name }", p)
+	}
+}
+
+// pick, omit and as are keywords only where the grammar expects them, so
+// they still work as names.
+func TestParseProjectionKeywordsAsNames(t *testing.T) {
+	s, diagnostics := schema.Parse([]byte("as: string\n\npick {\n  omit: as\n  as: pick[]\n}\n\nPage<omit> {\n  items: omit[]\n}\n"))
+	if len(diagnostics) > 0 {
+		t.Fatalf("parse: %v", diagnostics)
+	}
+	if a := s.TypeAlias("as"); a == nil || a.Type.String() != "string" {
+		t.Errorf("alias as = %+v", a)
+	}
+	model := s.Model("pick")
+	if model == nil {
+		t.Fatal("model pick not found")
+	}
+	if f := model.Field("omit"); f == nil || f.Type.String() != "as" {
+		t.Errorf("field omit = %+v", f)
+	}
+	if f := model.Field("as"); f == nil || f.Type.String() != "pick[]" {
+		t.Errorf("field as = %+v", f)
+	}
+	if page := s.Generic("Page"); page == nil || len(page.Params) != 1 || page.Params[0].Name != "omit" {
+		t.Errorf("Page = %+v", page)
+	}
+}
+
+// project appends a projected model to s. Projections are set up directly
+// so these tests do not depend on the generated parser knowing projection
+// syntax.
+func project(s *schema.Schema, name, operator, source string, fields ...string) *schema.Model {
+	p := &schema.Projection{Operator: operator, Source: &schema.Reference{Name: source}}
+	for _, field := range fields {
+		p.Fields = append(p.Fields, &schema.Reference{Name: field})
+	}
+	model := &schema.Model{Name: name, Projection: p}
+	s.Models = append(s.Models, model)
+	return model
+}
+
+const accounts = `Email: string
+
+Base {
+  id: string #1
+}
+
+User extends Base {
+  name: string #2
+  email: Email #3
+  password_hash: string #4
+}
+
+Admin extends User {
+  name { @deprecated { replacement: "email" } }
+}
+`
+
+func TestProjections(t *testing.T) {
+	s := mustParse(t, accounts)
+	public := project(s, "UserPublic", "pick", "User", "id", "name")
+	row := project(s, "UserRow", "omit", "User", "password_hash")
+	card := project(s, "AdminCard", "pick", "Admin", "name")
+	nested := project(s, "UserName", "pick", "UserPublic", "name")
+	for i := 0; i < 2; i++ {
+		if diagnostics := s.Check(); len(diagnostics) > 0 {
+			t.Fatalf("Check returned diagnostics: %v", diagnostics)
+		}
+	}
+
+	cases := []struct {
+		model *schema.Model
+		want  string
+	}{
+		{public, "id name"},
+		{row, "id name email"},
+		{card, "name"},
+		{nested, "name"},
+	}
+	for _, c := range cases {
+		var names []string
+		for _, field := range s.Fields(c.model) {
+			names = append(names, field.Name)
+		}
+		if got := strings.Join(names, " "); got != c.want {
+			t.Errorf("Fields(%s) = %s, want %s", c.model.Name, got, c.want)
+		}
+	}
+	if s.Fields(public)[1] != s.Model("User").Field("name") {
+		t.Error("UserPublic.name is not linked to User.name")
+	}
+	if d := s.FieldDeprecation(card, "name"); d == nil || d.Replacement != "email" {
+		t.Errorf("FieldDeprecation(AdminCard, name) = %+v, want the override from Admin", d)
+	}
+}
+
+func TestProjectionErrors(t *testing.T) {
+	cases := []struct {
+		name             string
+		operator, source string
+		fields           []string
+		want             string
+	}{
+		{"unknown field", "pick", "User", []string{"id", "nickname"}, "'nickname' is not a field of 'User'"},
+		{"duplicate field", "omit", "User", []string{"email", "email"}, "Field 'email' is listed twice in projection 'Projected'"},
+		{"type alias", "pick", "Email", []string{"id"}, "'Email' is a type alias; only models can be projected"},
+		{"itself", "pick", "Projected", []string{"id"}, "Projection 'Projected' depends on itself"},
+		{"undefined source", "pick", "auth.User", []string{"id"}, ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := mustParse(t, accounts)
+			project(s, "Projected", c.operator, c.source, c.fields...)
+			var got []string
+			for _, d := range s.Check() {
+				if d.Code != schema.CodeInvalidProjection {
+					t.Errorf("unexpected diagnostic %s", d)
+				}
+				got = append(got, d.Message)
+			}
+			if strings.Join(got, "\n") != c.want {
+				t.Errorf("Check = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestProjectionJSON(t *testing.T) {
+	s := mustParse(t, accounts)
+	project(s, "UserPublic", "pick", "User", "id", "name")
+	s.Check()
+	encoded, err := json.Marshal(s.Model("UserPublic"))
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	want := `{"name":"UserPublic","id":null,"projection":{"operator":"pick","source":"User","fields":["id","name"]},"parents":["User"],"fields":[],"config":{}}`
+	if string(encoded) != want {
+		t.Errorf("JSON =\n%s\nwant\n%s", encoded, want)
+	}
+}
+
+func TestParseInlineObject(t *testing.T) {
+	s, diagnostics := schema.Parse([]byte("User {\n  address: { street: string, city?: string #2 }\n}\n"))
+	if len(diagnostics) > 0 {
+		t.Fatalf("parse: %v", diagnostics)
+	}
+	if address := s.Model("User").Field("address").Type; !address.Inline || address.String() != "{ street: string, city?: string }" {
+		t.Errorf("address type = %+v", address)
+	}
+}
+
+// object builds an inline object type. Objects are set up directly so
+// these tests do not depend on the generated parser knowing inline object
+// syntax.
+func object(fields ...*schema.Field) *schema.TypeExpr {
+	return &schema.TypeExpr{Kind: schema.Identifier, Inline: true, Fields: fields}
+}
+
+func field(name, typ string, id schema.EntityID) *schema.Field {
+	return &schema.Field{Name: name, Type: schema.Named(typ), ID: id}
+}
+
+func TestInlineObjects(t *testing.T) {
+	s := mustParse(t, "Result: string\n\nUser {\n  name: string #1\n  address: string #3\n} #10\n")
+	address := object(field("street", "string", 1), &schema.Field{Name: "geo", Type: object(field("lat", "number", 1)), ID: 2})
+	s.Model("User").Field("address").Type = address
+	s.TypeAlias("Result").Type = &schema.TypeExpr{Kind: schema.Union, Members: []*schema.TypeExpr{
+		object(&schema.Field{Name: "type", Type: &schema.TypeExpr{Kind: schema.StringLiteral, Literal: value.Value{Kind: value.String, Text: "ok"}}}),
+		object(&schema.Field{Name: "type", Type: &schema.TypeExpr{Kind: schema.StringLiteral, Literal: value.Value{Kind: value.String, Text: "error"}}}, field("message", "string", 0)),
+	}}
+	for i := 0; i < 2; i++ {
+		if diagnostics := s.Check(); len(diagnostics) > 0 {
+			t.Fatalf("Check returned diagnostics: %v", diagnostics)
+		}
+	}
+
+	var names []string
+	for _, model := range s.Models {
+		names = append(names, model.Name)
+	}
+	if got, want := strings.Join(names, " "), "User Result_object_1 Result_object_2 User_address User_address_geo"; got != want {
+		t.Errorf("models = %s, want %s", got, want)
+	}
+	if d := s.TypeAlias("Result").Discriminator; d == nil || d.Field != "type" || d.Variants[1].Model != "Result_object_2" {
+		t.Errorf("Result discriminator = %+v", d)
+	}
+	if address.Name != "User_address" || address.String() != "{ street: string, geo: { lat: number } }" {
+		t.Errorf("address type = %s %s", address.Name, address)
+	}
+
+	cases := []struct {
+		model string
+		want  schema.InlineOf
+	}{
+		{"Result_object_2", schema.InlineOf{Owner: "Result", Key: "Result.object.2"}},
+		{"User_address", schema.InlineOf{Owner: "User", Field: "address", Key: "#10.#3"}},
+		{"User_address_geo", schema.InlineOf{Owner: "User_address", Field: "geo", Key: "#10.#3.#2"}},
+	}
+	for _, c := range cases {
+		if got := s.Model(c.model).InlineOf; got == nil || *got != c.want {
+			t.Errorf("%s InlineOf = %+v, want %+v", c.model, got, c.want)
+		}
+	}
+}
+
+func TestInlineObjectNameTaken(t *testing.T) {
+	s := mustParse(t, "User {\n  address: string\n}\n\nUser_address {\n  street: string\n}\n")
+	s.Model("User").Field("address").Type = object(field("street", "string", 0))
+	diagnostics := s.Check()
+	if len(diagnostics) != 1 || diagnostics[0].Code != schema.CodeInvalidInlineObject ||
+		diagnostics[0].Message != "Inline object type generates the model 'User_address', which is already defined" {
+		t.Errorf("Check = %v", diagnostics)
+	}
+}
+
+func TestInlineObjectInstantiation(t *testing.T) {
+	s := mustParse(t, "Page<T> {\n  items: T[]\n}\n\nBox<T> {\n  value: { inner: T, page: Page<T> }\n}\n\nPost {\n  title: string\n}\n\nFeed {\n  latest: { posts: Page<Post>, meta: { more: Page<Post[]> } }\n  boxed: Box<Post>\n}\n")
+	if diagnostics := s.Check(); len(diagnostics) > 0 {
+		t.Fatalf("Check returned diagnostics: %v", diagnostics)
+	}
+	cases := []struct {
+		model, field, want string
+	}{
+		{"Feed_latest", "posts", "Page_Post"},
+		{"Feed_latest_meta", "more", "Page_PostList"},
+		{"Box_Post_value", "page", "Page_Post"},
+	}
+	for _, c := range cases {
+		model := s.Model(c.model)
+		if model == nil {
+			t.Errorf("model %s was not generated", c.model)
+			continue
+		}
+		if typ := model.Field(c.field).Type; typ.Name != c.want || s.Model(typ.Name) == nil {
+			t.Errorf("%s.%s type = %+v, want the %s model", c.model, c.field, typ, c.want)
+		}
+	}
+}
+
+func TestInlineObjectJSON(t *testing.T) {
+	s := mustParse(t, "User {\n  address: string #3\n} #10\n")
+	s.Model("User").Field("address").Type = object(&schema.Field{Name: "city", Type: schema.Named("string"), Configs: []*schema.Config{{Name: "sql", Value: value.Value{Kind: value.Object}}}, ID: 1})
+	s.Check()
+	encoded, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	for _, want := range []string{
+		`"field_type":{"kind":"identifier","name":"User_address","inline":true}`,
+		`{"name":"User_address","id":null,"inline_of":{"owner":"User","field":"address","key":"#10.#3"},"parents":[],` +
+			`"fields":[{"name":"city","id":1,"field_type":{"kind":"identifier","name":"string"},"optional":false,"nullable":false,"default":null,"config":{"sql":{}}}]`,
+	} {
+		if !strings.Contains(string(encoded), want) {
+			t.Errorf("JSON is missing %s\n%s", want, encoded)
+		}
+	}
+}
+
+func TestNumberLiteralTypes(t *testing.T) {
+	s := mustParse(t, "Task {\n  priority: 1 | 2 | 3 = 2\n  status: 200\n}\n")
+	if diagnostics := s.Check(); len(diagnostics) > 0 {
+		t.Fatalf("Check returned diagnostics: %v", diagnostics)
+	}
+	encoded, err := json.Marshal(s.Model("Task"))
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	for _, want := range []string{
+		`"field_type":{"kind":"union","members":[{"kind":"number_literal","value":1},{"kind":"number_literal","value":2},{"kind":"number_literal","value":3}]}`,
+		`"field_type":{"kind":"number_literal","value":200}`,
+	} {
+		if !strings.Contains(string(encoded), want) {
+			t.Errorf("JSON is missing %s\n%s", want, encoded)
+		}
+	}
+}
+
+func TestParseBooleanLiteral(t *testing.T) {
+	s, diagnostics := schema.Parse([]byte("Flag {\n  enabled: true\n  state: \"on\" | false\n}\n"))
+	if len(diagnostics) > 0 {
+		t.Fatalf("parse: %v", diagnostics)
+	}
+	enabled := s.Model("Flag").Field("enabled").Type
+	if enabled.Kind != schema.BooleanLiteral || !enabled.Literal.Bool {
+		t.Errorf("enabled type = %+v", enabled)
+	}
+	if state := s.Model("Flag").Field("state").Type; state.String() != `"on" | false` {
+		t.Errorf("state type = %s", state)
+	}
+}
+
+// boolean builds a boolean literal type. They are set up directly so these
+// tests do not depend on the generated parser knowing boolean literal types.
+func boolean(b bool) *schema.TypeExpr {
+	return &schema.TypeExpr{Kind: schema.BooleanLiteral, Literal: value.Value{Kind: value.Bool, Bool: b}}
+}
+
+func TestBooleanLiteralTypes(t *testing.T) {
+	cases := []struct {
+		source string
+		want   string
+	}{
+		{"Flag {\n  enabled: string = true\n}\n", ""},
+		{"Flag {\n  enabled: string = false\n}\n", "Invalid default for field 'Flag.enabled': expected true, found false"},
+		{"Flag {\n  enabled: string = \"true\"\n}\n", `Invalid default for field 'Flag.enabled': expected true, found "true"`},
+	}
+	for _, c := range cases {
+		s := mustParse(t, c.source)
+		s.Model("Flag").Field("enabled").Type = boolean(true)
+		diagnostics := s.Check()
+		if c.want == "" {
+			if len(diagnostics) > 0 {
+				t.Errorf("%q: unexpected diagnostics: %v", c.source, diagnostics)
+			}
+			continue
+		}
+		if len(diagnostics) != 1 || diagnostics[0].Code != schema.CodeInvalidDefault || diagnostics[0].Message != c.want {
+			t.Errorf("%q: Check = %v, want %s", c.source, diagnostics, c.want)
+		}
+	}
+
+	s := mustParse(t, "Flag {\n  state: string\n  byFlag: string[string]\n}\n")
+	s.Model("Flag").Field("state").Type = &schema.TypeExpr{Kind: schema.Union, Members: []*schema.TypeExpr{
+		{Kind: schema.StringLiteral, Literal: value.Value{Kind: value.String, Text: "on"}}, boolean(false),
+	}}
+	s.Model("Flag").Field("byFlag").Type.Key = boolean(true)
+	diagnostics := s.Check()
+	if len(diagnostics) != 1 || diagnostics[0].Code != schema.CodeInvalidMapKey {
+		t.Errorf("Check = %v, want one %s", diagnostics, schema.CodeInvalidMapKey)
+	}
+	encoded, err := json.Marshal(s.Model("Flag").Field("state"))
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	want := `"field_type":{"kind":"union","members":[{"kind":"string_literal","value":"on"},{"kind":"boolean_literal","value":false}]}`
+	if !strings.Contains(string(encoded), want) {
+		t.Errorf("JSON is missing %s\n%s", want, encoded)
+	}
+}
+
+func TestParseNullable(t *testing.T) {
+	s, diagnostics := schema.Parse([]byte("User {\n  bio: string | null\n}\n"))
+	if len(diagnostics) > 0 {
+		t.Fatalf("parse: %v", diagnostics)
+	}
+	bio := s.Model("User").Field("bio").Type
+	if bio.Kind != schema.Union || bio.Members[1].Kind != schema.Null || bio.String() != "string | null" {
+		t.Errorf("bio type = %+v", bio)
+	}
+}
+
+// orNull adds a null member to t. Nullable types are set up directly so
+// these tests do not depend on the generated parser knowing them.
+func orNull(t *schema.TypeExpr) *schema.TypeExpr {
+	return &schema.TypeExpr{Kind: schema.Union, Members: []*schema.TypeExpr{t, {Kind: schema.Null}}}
+}
+
+func TestNullable(t *testing.T) {
+	s := mustParse(t, `Bio: string
+
+Profile {
+  bio: Bio = null
+  nickname?: string
+  tagline: Bio = "hi"
+  address: Address = { city: "Paris", zip: null }
+}
+
+Address {
+  city: string
+  zip?: string
+}
+`)
+	s.TypeAlias("Bio").Type = orNull(schema.Named("string"))
+	s.Model("Address").Field("zip").Type = orNull(schema.Named("string"))
+	if diagnostics := s.Check(); len(diagnostics) > 0 {
+		t.Fatalf("Check returned diagnostics: %v", diagnostics)
+	}
+	profile := s.Model("Profile")
+	if !profile.Field("bio").Nullable || !profile.Field("tagline").Nullable || profile.Field("nickname").Nullable {
+		t.Errorf("nullable = bio %v, tagline %v, nickname %v",
+			profile.Field("bio").Nullable, profile.Field("tagline").Nullable, profile.Field("nickname").Nullable)
+	}
+
+	encoded, err := json.Marshal(s.Model("Address").Field("zip"))
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	want := `{"name":"zip","id":null,"field_type":{"kind":"union","members":[{"kind":"identifier","name":"string"},{"kind":"null"}]},"optional":true,"nullable":true,"default":null,"config":{}}`
+	if string(encoded) != want {
+		t.Errorf("JSON =\n%s\nwant\n%s", encoded, want)
+	}
+
+	s.TypeAlias("Bio").Type = schema.Named("string")
+	diagnostics := s.Check()
+	if len(diagnostics) != 1 || diagnostics[0].Message != "Invalid default for field 'Profile.bio': expected Bio (string), found null" {
+		t.Errorf("Check = %v", diagnostics)
+	}
+	if profile.Field("bio").Nullable {
+		t.Error("bio is still nullable after its alias lost null")
+	}
+}
+
+const relations = `Author: User
+
+User {
+  posts: Post[] { @relation { inverse: author } }
+  profile?: Profile
+  tags: Tag[] { @relation { inverse: users, through: UserTag } }
+}
+
+Post {
+  author: Author { @relation { on_delete: cascade, foreign_key: "author_id" } }
+  editor?: User { @relation { on_delete: set_null } }
+}
+
+Profile {
+  user: User { @relation { inverse: profile } }
+}
+
+Tag {
+  users: User[]
+}
+
+UserTag {
+  user: User
+  tag: Tag
+}
+
+Customer {
+  address: string
+}
+`
+
+func TestRelations(t *testing.T) {
+	s := mustParse(t, relations)
+	s.Model("Customer").Field("address").Type = object(field("city", "string", 0))
+	if diagnostics := s.Check(); len(diagnostics) > 0 {
+		t.Fatalf("Check returned diagnostics: %v", diagnostics)
+	}
+
+	var got []string
+	for _, r := range s.Relations {
+		got = append(got, fmt.Sprintf("%s.%s -> %s %s inverse=%s on_delete=%s through=%s foreign_key=%s",
+			r.Model, r.Field, r.Target, r.Cardinality, r.Inverse, r.OnDelete, r.Through, r.ForeignKey))
+	}
+	// Inverses named on one side are filled in on the other; the inline
+	// object of Customer.address is not a relation.
+	want := []string{
+		"User.posts -> Post one_to_many inverse=author on_delete= through= foreign_key=",
+		"User.profile -> Profile one_to_one inverse=user on_delete= through= foreign_key=",
+		"User.tags -> Tag many_to_many inverse=users on_delete= through=UserTag foreign_key=",
+		"Post.author -> User many_to_one inverse=posts on_delete=cascade through= foreign_key=author_id",
+		"Post.editor -> User many_to_one inverse= on_delete=set_null through= foreign_key=",
+		"Profile.user -> User one_to_one inverse=profile on_delete= through= foreign_key=",
+		"Tag.users -> User many_to_many inverse=tags on_delete= through=UserTag foreign_key=",
+		"UserTag.user -> User many_to_one inverse= on_delete= through= foreign_key=",
+		"UserTag.tag -> Tag many_to_one inverse= on_delete= through= foreign_key=",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("relations =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	encoded, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	if want := `{"model":"Post","field":"author","target":"User","cardinality":"many_to_one","inverse":"posts","on_delete":"cascade","foreign_key":"author_id"}`; !strings.Contains(string(encoded), want) {
+		t.Errorf("JSON does not contain %s:\n%s", want, encoded)
+	}
+}
+
+func TestRelationErrors(t *testing.T) {
+	cases := []struct {
+		source string
+		code   string
+		want   string
+	}{
+		{
+			"User {\n  name: string { @relation { inverse: x } }\n}",
+			schema.CodeInvalidRelation, "@relation on 'User.name' requires a field typed with a model or an array of models, found 'string'",
+		},
+		{
+			"User {\n  posts: Post[] { @relation { inverse: writer } }\n}\n\nPost {\n  author: User\n}",
+			schema.CodeInvalidRelation, "Relation 'User.posts' names inverse 'writer', which is not a field of 'Post'",
+		},
+		{
+			"User {\n  posts: Post[] { @relation { inverse: title } }\n}\n\nPost {\n  title: string\n}",
+			schema.CodeInvalidRelation, "Inverse 'Post.title' of 'User.posts' does not refer to 'User'",
+		},
+		{
+			"User {\n  posts: Post[] { @relation { inverse: author } }\n  drafts: Post[]\n}\n\nPost {\n  author: User { @relation { inverse: drafts } }\n}",
+			schema.CodeInvalidRelation, "'User.posts' names 'Post.author' as its inverse, but 'Post.author' names 'drafts'",
+		},
+		{
+			"User {\n  posts: Post[] { @relation { on_delete: cascade } }\n}\n\nPost {\n  author: User\n}",
+			schema.CodeInvalidRelation, "on_delete belongs on the side holding the foreign key; 'User.posts' holds several 'Post' records",
+		},
+		{
+			"User {\n  name: string\n}\n\nPost {\n  author: User { @relation { on_delete: set_null } }\n}",
+			schema.CodeInvalidRelation, "on_delete: set_null requires 'Post.author' to be optional or nullable",
+		},
+		{
+			"User {\n  tags: Tag[] { @relation { through: UserTag } }\n}\n\nTag {\n  name: string\n}\n\nUserTag {\n  user: User\n}",
+			schema.CodeInvalidRelation, "Join model 'UserTag' of 'User.tags' needs fields referring to both 'User' and 'Tag'",
+		},
+		{
+			"User {\n  tags: Tag[] { @relation { foreign_key: \"tag_id\" } }\n}\n\nTag {\n  name: string\n}",
+			schema.CodeInvalidRelation, "foreign_key applies to fields that hold one record; 'User.tags' holds several",
+		},
+		{
+			"User {\n  name: string\n}\n\nPost {\n  author: User { @relation { on_delete: destroy } }\n}",
+			schema.CodeInvalidPluginConfig, "Invalid @relation config: on_delete must be one of cascade, restrict, set_null, no_action, found 'destroy'",
+		},
+		{
+			"User {\n  name: string\n}\n\nPost {\n  author: User { @relation { inverse: 3 } }\n}",
+			schema.CodeInvalidPluginConfig, "Invalid @relation config: inverse must be a string, found number",
+		},
+	}
+	for _, c := range cases {
+		_, diagnostics := check(t, c.source)
+		if len(diagnostics) != 1 || diagnostics[0].Code != c.code || diagnostics[0].Message != c.want {
+			t.Errorf("Check(%q) = %v, want %s: %s", c.source, diagnostics, c.code, c.want)
+		}
+	}
+
+	// Inherited relations are checked where they are declared.
+	source := "Base {\n  owner: User { @relation { inverse: things } }\n}\n\nItem extends Base {\n  name: string\n}\n\nUser {\n  name: string\n}"
+	if _, diagnostics := check(t, source); codes(diagnostics) != schema.CodeInvalidRelation {
+		t.Errorf("Check = %v, want one %s", diagnostics, schema.CodeInvalidRelation)
+	}
+}
+
+func TestIndexes(t *testing.T) {
+	s, diagnostics := check(t, `Base {
+  id: string
+  email: string
+  deleted_at?: string
+  @sql {
+    indexes: {
+      primary: { fields: ["id"], primary: true },
+      email_unique: { fields: ["email"], unique: true, method: "btree" }
+    }
+  }
+}
+
+User extends Base {
+  name: string
+  @sql {
+    indexes: {
+      email_unique: { where: "deleted_at IS NULL AND email <> ''" },
+      name_idx: { fields: [name] }
+    }
+  }
+}`)
+	if len(diagnostics) > 0 {
+		t.Fatalf("Check returned diagnostics: %v", diagnostics)
+	}
+
+	// The child's entry for email_unique is merged into the inherited one.
+	var got []string
+	for _, index := range s.Indexes(s.Model("User")) {
+		got = append(got, fmt.Sprintf("%s %v primary=%v unique=%v method=%s where=%q model=%s",
+			index.Name, index.Fields, index.Primary, index.Unique, index.Method, index.Where, index.Model))
+	}
+	want := []string{
+		`primary [id] primary=true unique=false method= where="" model=Base`,
+		`email_unique [email] primary=false unique=true method=btree where="deleted_at IS NULL AND email <> ''" model=User`,
+		`name_idx [name] primary=false unique=false method= where="" model=User`,
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("indexes =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if len(s.Indexes(s.Model("Base"))) != 2 || s.Indexes(s.Model("Base"))[1].Where != "" {
+		t.Errorf("Base indexes = %+v", s.Indexes(s.Model("Base")))
+	}
+}
+
+func TestIndexErrors(t *testing.T) {
+	cases := []struct {
+		source string
+		code   string
+		want   string
+	}{
+		{
+			"User {\n  email: string\n  @sql { indexes: { email_idx: { fields: [\"mail\"] } } }\n}",
+			schema.CodeInvalidIndex, "Index 'email_idx' of 'User' names 'mail', which is not a field of 'User'",
+		},
+		{
+			"User {\n  email: string\n  @sql { indexes: { email_idx: { unique: true } } }\n}",
+			schema.CodeInvalidIndex, "Index 'email_idx' of 'User' lists no fields",
+		},
+		{
+			"User {\n  email: string\n  @sql { indexes: { email_idx: { fields: [\"email\"] }, email_idx: { fields: [\"email\"] } } }\n}",
+			schema.CodeInvalidIndex, "Duplicate index 'email_idx' in 'User'",
+		},
+		{
+			"User {\n  email: string\n  @sql { indexes: { email_idx: { fields: [\"email\"] } } }\n}\n\nAccount {\n  email: string\n  @sql { indexes: { email_idx: { fields: [\"email\"] } } }\n}",
+			schema.CodeInvalidIndex, "Index 'email_idx' of 'Account' is already declared by 'User'; index names must be unique",
+		},
+		{
+			"User {\n  email: string\n  @sql { indexes: { email_idx: { fields: [\"email\"] } } }\n}\n\nGuest extends User {\n  -email\n}",
+			schema.CodeInvalidIndex, "'Guest' removes 'email', which its inherited index 'email_idx' uses",
+		},
+		{
+			"User {\n  email: string\n  @sql { indexes: { email_idx: { fields: [\"email\"], method: \"fast\" } } }\n}",
+			schema.CodeInvalidPluginConfig, "Invalid @sql config: method of index 'email_idx' must be one of btree, hash, gin, gist, spgist, brin, found \"fast\"",
+		},
+		{
+			"User {\n  email: string\n  @sql { indexes: { email_idx: { fields: [\"email\"], partial: true } } }\n}",
+			schema.CodeInvalidPluginConfig, "Invalid @sql config: unknown key 'partial' in index 'email_idx'; expected fields, primary, unique, method or where",
+		},
+		{
+			"Page<T> {\n  items: T[]\n  cursor: string\n  @sql { indexes: { cursor_idx: { fields: [\"cursor\", \"next\"] } } }\n}\n\nFeed {\n  users: Page<string>\n  counts: Page<number>\n}",
+			schema.CodeInvalidIndex, "Index 'cursor_idx' of 'Page' names 'next', which is not a field of 'Page'",
+		},
+	}
+	for _, c := range cases {
+		_, diagnostics := check(t, c.source)
+		if len(diagnostics) != 1 || diagnostics[0].Code != c.code || diagnostics[0].Message != c.want {
+			t.Errorf("Check(%q) = %v, want %s: %s", c.source, diagnostics, c.code, c.want)
+		}
+	}
+}
+
+const computed = `Product {
+  name: string
+  price: number
+  reviews: Review[]
+  average_rating: number { @computed { from: "AVG(reviews.rating)" } }
+  review_count: number { @computed { from: "count(reviews)" } }
+  score: number { @computed { from: "average_rating * review_count + price" } }
+  label: string { @computed { from: "name || ' by ' || maker.name" } }
+  maker: Maker
+}
+
+Review {
+  product: Product
+  rating: number
+}
+
+Maker {
+  name: string
+}
+`
+
+func TestComputed(t *testing.T) {
+	s, diagnostics := check(t, computed)
+	if len(diagnostics) > 0 {
+		t.Fatalf("Check returned diagnostics: %v", diagnostics)
+	}
+	product := s.Model("Product")
+
+	var got []string
+	for _, field := range product.Fields {
+		if field.Computed == nil {
+			continue
+		}
+		var deps []string
+		for _, d := range field.Computed.Dependencies {
+			deps = append(deps, strings.TrimSpace(fmt.Sprintf("%s=%s.%s %s", d, d.Model, d.Field, d.Aggregate)))
+		}
+		got = append(got, field.Name+": "+strings.Join(deps, ", "))
+	}
+	want := []string{
+		"average_rating: reviews.rating=Review.rating AVG",
+		"review_count: reviews=Product.reviews COUNT",
+		"score: average_rating=Product.average_rating, review_count=Product.review_count, price=Product.price",
+		"label: name=Product.name, maker.name=Maker.name",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("dependencies =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	var inputs []string
+	for _, field := range s.InputFields(product) {
+		inputs = append(inputs, field.Name)
+	}
+	if got, want := strings.Join(inputs, " "), "name price reviews maker"; got != want {
+		t.Errorf("InputFields = %s, want %s", got, want)
+	}
+
+	encoded, err := json.Marshal(product.Field("average_rating"))
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	if want := `"computed":{"from":"AVG(reviews.rating)","dependencies":[{"path":"reviews.rating","model":"Review","field":"rating","aggregate":"AVG"}]}`; !strings.Contains(string(encoded), want) {
+		t.Errorf("JSON = %s, want it to contain %s", encoded, want)
+	}
+}
+
+func TestComputedErrors(t *testing.T) {
+	cases := []struct {
+		source string
+		code   string
+		want   string
+	}{
+		{
+			"Order {\n  total: number { @computed { from: \"price *\" } }\n}",
+			schema.CodeInvalidComputed, "Invalid expression for computed field 'Order.total': unexpected end of expression at byte 7",
+		},
+		{
+			"Order {\n  total: number { @computed { from: \"price * quantity\" } }\n  price: number\n}",
+			schema.CodeInvalidComputed, "'quantity' in computed field 'Order.total' is not a field of 'Order'",
+		},
+		{
+			"Order {\n  total: number { @computed { from: \"price.amount\" } }\n  price: number\n}",
+			schema.CodeInvalidComputed, "'price.amount' in computed field 'Order.total' goes through 'Order.price', which is not a relation",
+		},
+		{
+			"Order {\n  total: number { @computed { from: \"items.price\" } }\n  items: Item[]\n}\n\nItem {\n  price: number\n}",
+			schema.CodeInvalidComputed, "'items.price' in computed field 'Order.total' goes through 'Order.items', which holds several records; use an aggregate such as AVG, SUM, COUNT, MIN, MAX",
+		},
+		{
+			"Order {\n  total: number = 0 { @computed { from: \"1\" } }\n}",
+			schema.CodeInvalidComputed, "Computed field 'Order.total' cannot have a default",
+		},
+		{
+			"Order {\n  total: number { @computed { from: \"1\", as: \"view\" } }\n}",
+			schema.CodeInvalidPluginConfig, "Invalid @computed config: unknown key 'as'; expected from",
+		},
+		{
+			"Order {\n  total: number { @computed {} }\n}",
+			schema.CodeInvalidPluginConfig, "Invalid @computed config: from is required",
+		},
+	}
+	for _, c := range cases {
+		_, diagnostics := check(t, c.source)
+		if len(diagnostics) != 1 || diagnostics[0].Code != c.code || diagnostics[0].Message != c.want {
+			t.Errorf("Check(%q) = %v, want %s: %s", c.source, diagnostics, c.code, c.want)
+		}
+	}
+
+	_, diagnostics := check(t, "Order {\n  a: number { @computed { from: \"b + 1\" } }\n  b: number { @computed { from: \"a * 2\" } }\n}")
+	var got []string
+	for _, d := range diagnostics {
+		got = append(got, d.Message)
+	}
+	if want := "Computed field 'Order.a' depends on itself\nComputed field 'Order.b' depends on itself"; strings.Join(got, "\n") != want {
+		t.Errorf("diagnostics = %v, want %s", got, want)
+	}
+}
+
+func TestScopedIDs(t *testing.T) {
+	registry := schema.TemplateIDSource("cdm/auth", nil)
+	gitPath := value.Value{Kind: value.Object, Entries: []value.Entry{{Key: "git_path", Value: value.Value{Kind: value.String, Text: "auth"}}}}
+	cases := []struct {
+		id   schema.ScopedID
+		want string
+	}{
+		{schema.ScopedID{ID: 10}, "#10"},
+		{schema.ScopedID{Source: registry, ID: 10}, "cdm/auth:#10"},
+		{schema.ScopedID{Source: schema.TemplateIDSource("git:https://example.com/t.git", &gitPath), ID: 3}, "git:https://example.com/t.git#auth:#3"},
+		{schema.ScopedID{Source: schema.TemplateIDSource("./templates/shared", nil), ID: 3}, "./templates/shared:#3"},
+		{schema.ScopedID{Source: schema.TemplateIDSource("./base.cdm", nil), ID: 3}, "#3"},
+		{schema.ScopedID{Model: &schema.ScopedID{ID: 10}, ID: 2}, "#10.#2"},
+		{schema.ScopedID{Source: registry, Model: &schema.ScopedID{Source: registry, ID: 10}, ID: 2}, "cdm/auth:#10.#2"},
+		{schema.ScopedID{Model: &schema.ScopedID{Source: registry, ID: 10}, ID: 5}, "cdm/auth:#10.local:#5"},
+		{schema.ScopedID{Source: registry}, ""},
+	}
+	for _, c := range cases {
+		if got := c.id.String(); got != c.want {
+			t.Errorf("String(%+v) = %q, want %q", c.id, got, c.want)
+		}
+	}
+
+	s := mustParse(t, "User {\n  email: string #2\n} #10\n")
+	user := s.Model("User")
+	user.IDSource = registry
+	if got := user.Field("email").ScopedID(user).String(); got != "cdm/auth:#10.local:#2" {
+		t.Errorf("email = %s", got)
+	}
+	data, err := json.Marshal(user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"id":10,"id_source":{"type":"registry","name":"cdm/auth"}`) ||
+		strings.Contains(string(data), `"id":2,"id_source"`) {
+		t.Errorf("JSON = %s", data)
+	}
+}
+
+func TestEntityIDErrors(t *testing.T) {
+	_, diagnostics := check(t, `Email: string #1
+
+User {
+  email: Email #1
+  name: string #1
+} #1
+
+Post {
+  title: string #1
+} #2
+`)
+	got := fmt.Sprint(diagnostics)
+	if len(diagnostics) != 2 || !strings.Contains(got, "E501: Duplicate entity ID #1: already used by 'Email'") ||
+		!strings.Contains(got, "E502: Duplicate field ID #1 in model 'User': already used by 'email'") {
+		t.Errorf("diagnostics = %v", diagnostics)
+	}
+
+	// The same numbers assigned by a template are in another scope.
+	s := mustParse(t, "User {\n  email: string #1\n  name: string #1\n} #1\n\nAccount {} #1\n")
+	user := s.Model("User")
+	user.IDSource = schema.TemplateIDSource("cdm/auth", nil)
+	user.Fields[0].IDSource = user.IDSource
+	if diagnostics := s.Check(); len(diagnostics) > 0 {
+		t.Errorf("Check returned diagnostics for IDs of different scopes: %v", diagnostics)
+	}
+}
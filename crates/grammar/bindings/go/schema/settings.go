@@ -0,0 +1,104 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/larner-dev/cdm/bindings/go/position"
+	"github.com/larner-dev/cdm/bindings/go/value"
+)
+
+// SettingsLoader returns a function that parses source, the schema.cdm
+// defining the configs of the built-in vocabulary plugin, the first time it
+// is called. It panics if source does not parse, since such a file is
+// embedded in the program.
+func SettingsLoader(plugin string, source []byte) func() *Schema {
+	var once sync.Once
+	var settings *Schema
+	return func() *Schema {
+		once.Do(func() {
+			s, diagnostics := Parse(source)
+			if len(diagnostics) > 0 {
+				panic(fmt.Sprintf("%s: invalid schema.cdm: %v", plugin, diagnostics))
+			}
+			settings = s
+		})
+		return settings
+	}
+}
+
+// ConfigValue returns the value of config with its bare identifiers read
+// as strings. Config keys take plain words, as in `level: pii`, and the
+// parser reads those as references.
+func ConfigValue(config *Config) value.Value {
+	v := config.Value
+	v.Entries = append([]value.Entry(nil), v.Entries...)
+	for i, entry := range v.Entries {
+		if entry.Value.Kind == value.Reference {
+			v.Entries[i].Value.Kind = value.String
+		}
+	}
+	return v
+}
+
+// CheckConfig matches config against the model named model of settings and
+// returns its value as ConfigValue reads it. A mismatch is reported as
+// E402.
+func CheckConfig(settings *Schema, config *Config, model string) (value.Value, []Diagnostic) {
+	v := ConfigValue(config)
+	if mismatch := settings.Match(v, Named(model)); mismatch != nil {
+		return v, []Diagnostic{Errorf(CodeInvalidPluginConfig, mismatch.Span, "Invalid @%s config: %s", config.Name, mismatch)}
+	}
+	return v, nil
+}
+
+// stringKey is a key of a config whose values are all strings.
+type stringKey struct {
+	name   string
+	target *string
+	// Values the key accepts; empty for any string
+	allowed []string
+}
+
+// decodeStrings sets the target of each key config sets, skipping and
+// reporting (E402) keys that are not listed and values that are not
+// strings or not allowed.
+func decodeStrings(config *Config, keys ...stringKey) []Diagnostic {
+	var diagnostics []Diagnostic
+	errorf := func(span position.Span, format string, args ...any) {
+		diagnostics = append(diagnostics, Errorf(CodeInvalidPluginConfig, span, "Invalid @%s config: "+format, append([]any{config.Name}, args...)...))
+	}
+	for _, entry := range ConfigValue(config).Entries {
+		v := entry.Value
+		var key *stringKey
+		for i := range keys {
+			if keys[i].name == entry.Key {
+				key = &keys[i]
+			}
+		}
+		switch {
+		case key == nil:
+			names := make([]string, len(keys))
+			for i, k := range keys {
+				names[i] = k.name
+			}
+			errorf(entry.KeySpan, "unknown key '%s'; expected %s", entry.Key, orList(names))
+		case v.Kind != value.String:
+			errorf(v.Span, "%s must be a string, found %s", entry.Key, v.Kind)
+		case len(key.allowed) > 0 && !contains(key.allowed, v.Text):
+			errorf(v.Span, "%s must be one of %s, found '%s'", entry.Key, strings.Join(key.allowed, ", "), v.Text)
+		default:
+			*key.target = v.Text
+		}
+	}
+	return diagnostics
+}
+
+// orList joins names as in "a, b or c".
+func orList(names []string) string {
+	if len(names) < 2 {
+		return strings.Join(names, "")
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " or " + names[len(names)-1]
+}
@@ -0,0 +1,257 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/larner-dev/cdm/bindings/go/position"
+	"github.com/larner-dev/cdm/bindings/go/value"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// TypeKind identifies the shape of a TypeExpr. Its String form is the
+// "kind" tag used in the Appendix D JSON.
+type TypeKind int
+
+const (
+	// Identifier names a built-in type, alias or model, possibly qualified
+	// with a template namespace (`sql.UUID`).
+	Identifier TypeKind = iota
+	Array
+	Map
+	Union
+	StringLiteral
+	NumberLiteral
+	BooleanLiteral
+	// Null is the `null` member of a nullable union such as
+	// `string | null`.
+	Null
+)
+
+func (k TypeKind) String() string {
+	switch k {
+	case Identifier:
+		return "identifier"
+	case Array:
+		return "array"
+	case Map:
+		return "map"
+	case Union:
+		return "union"
+	case StringLiteral:
+		return "string_literal"
+	case NumberLiteral:
+		return "number_literal"
+	case BooleanLiteral:
+		return "boolean_literal"
+	case Null:
+		return "null"
+	}
+	return fmt.Sprintf("TypeKind(%d)", int(k))
+}
+
+// builtins are the built-in type names. Model and Type are only meaningful
+// in plugin settings schemas, where they accept a model or type name.
+var builtins = map[string]bool{
+	"string": true, "number": true, "boolean": true, "JSON": true,
+	"Model": true, "Type": true,
+}
+
+// TypeExpr is a parsed type expression. Only the fields that match Kind are
+// set.
+type TypeExpr struct {
+	Kind TypeKind
+	// Type name for Identifier, including any namespace prefix. Check
+	// replaces the name of an instantiation such as `Page<User>` with the
+	// model it generates, `Page_User`, and moves the generic model's name
+	// to Generic.
+	Name string
+	// Type arguments of an Identifier that instantiates a generic model
+	Args []*TypeExpr
+	// Generic model an instantiation was resolved against
+	Generic string
+	// Inline is set on Identifiers that are inline object types, such as
+	// `{ street: string }`, with the object's fields in Fields. Check sets
+	// Name to the model it generates for the object, such as
+	// `User_address`.
+	Inline bool
+	Fields []*Field
+	// Element type of an Array, value type of a Map
+	Element *TypeExpr
+	// Key type of a Map
+	Key *TypeExpr
+	// Members of a Union, in source order
+	Members []*TypeExpr
+	// Value of a StringLiteral, NumberLiteral or BooleanLiteral
+	Literal value.Value
+	Span    position.Span
+}
+
+// Named returns an Identifier type expression for name, with no span.
+func Named(name string) *TypeExpr {
+	return &TypeExpr{Kind: Identifier, Name: name}
+}
+
+// IsLiteral reports whether t is a string, number or boolean literal type.
+func (t *TypeExpr) IsLiteral() bool {
+	return t.Kind == StringLiteral || t.Kind == NumberLiteral || t.Kind == BooleanLiteral
+}
+
+// Walk calls visit for t and every type expression nested in it, parents
+// before children. The field types of inline objects are not visited; they
+// belong to the models Check generates for the objects.
+func (t *TypeExpr) Walk(visit func(*TypeExpr)) {
+	visit(t)
+	if t.Element != nil {
+		t.Element.Walk(visit)
+	}
+	if t.Key != nil {
+		t.Key.Walk(visit)
+	}
+	for _, member := range t.Members {
+		member.Walk(visit)
+	}
+	for _, arg := range t.Args {
+		arg.Walk(visit)
+	}
+}
+
+// String renders t as CDM source text.
+func (t *TypeExpr) String() string {
+	switch t.Kind {
+	case Identifier:
+		if t.Inline {
+			return inlineString(t.Fields)
+		}
+		if len(t.Args) > 0 {
+			args := make([]string, len(t.Args))
+			for i, arg := range t.Args {
+				args[i] = arg.String()
+			}
+			return t.genericName() + "<" + strings.Join(args, ", ") + ">"
+		}
+		return t.Name
+	case Array:
+		return t.Element.String() + "[]"
+	case Map:
+		return t.Element.String() + "[" + t.Key.String() + "]"
+	case Union:
+		members := make([]string, len(t.Members))
+		for i, member := range t.Members {
+			members[i] = member.String()
+		}
+		return strings.Join(members, " | ")
+	case StringLiteral, NumberLiteral, BooleanLiteral:
+		return t.Literal.String()
+	case Null:
+		return "null"
+	}
+	return ""
+}
+
+// typeFromNode builds a TypeExpr from a `_type_expression` node. field
+// builds the field definitions of inline object types.
+func typeFromNode(node *tree_sitter.Node, source []byte, field func(*tree_sitter.Node) *Field) (*TypeExpr, error) {
+	span := position.NodeSpan(node)
+
+	switch node.Kind() {
+	case "type_identifier":
+		return &TypeExpr{Kind: Identifier, Name: node.Utf8Text(source), Span: span}, nil
+
+	case "generic_type":
+		name := node.ChildByFieldName("name")
+		t := &TypeExpr{Kind: Identifier, Name: name.Utf8Text(source), Span: span}
+		cursor := node.Walk()
+		defer cursor.Close()
+		for _, child := range node.ChildrenByFieldName("argument", cursor) {
+			arg, err := typeFromNode(&child, source, field)
+			if err != nil {
+				return nil, err
+			}
+			t.Args = append(t.Args, arg)
+		}
+		return t, nil
+
+	case "object_type":
+		t := &TypeExpr{Kind: Identifier, Inline: true, Span: span}
+		cursor := node.Walk()
+		defer cursor.Close()
+		for _, child := range node.ChildrenByFieldName("field", cursor) {
+			if f := field(&child); f != nil {
+				t.Fields = append(t.Fields, f)
+			}
+		}
+		return t, nil
+
+	case "array_type":
+		element, err := typeFromNode(node.NamedChild(0), source, field)
+		if err != nil {
+			return nil, err
+		}
+		return &TypeExpr{Kind: Array, Element: element, Span: span}, nil
+
+	case "map_type":
+		element, err := typeFromNode(node.ChildByFieldName("value_type"), source, field)
+		if err != nil {
+			return nil, err
+		}
+		key, err := typeFromNode(node.ChildByFieldName("key_type"), source, field)
+		if err != nil {
+			return nil, err
+		}
+		return &TypeExpr{Kind: Map, Element: element, Key: key, Span: span}, nil
+
+	case "union_type", "key_union_type":
+		union := &TypeExpr{Kind: Union, Span: span}
+		for i := uint(0); i < node.NamedChildCount(); i++ {
+			child := node.NamedChild(i)
+			if isComment(child) {
+				continue
+			}
+			member, err := typeFromNode(child, source, field)
+			if err != nil {
+				return nil, err
+			}
+			union.Members = append(union.Members, member)
+		}
+		return union, nil
+
+	case "string_literal", "number_literal", "boolean_literal":
+		literal, err := value.FromNode(node, source)
+		if err != nil {
+			return nil, err
+		}
+		kind := StringLiteral
+		switch literal.Kind {
+		case value.Number:
+			kind = NumberLiteral
+		case value.Bool:
+			kind = BooleanLiteral
+		}
+		return &TypeExpr{Kind: kind, Literal: literal, Span: span}, nil
+
+	case "null_literal":
+		return &TypeExpr{Kind: Null, Span: span}, nil
+	}
+
+	return nil, &value.Error{Span: span, Message: fmt.Sprintf("%s is not a type", node.Kind())}
+}
+
+// inlineString renders the fields of an inline object type, such as
+// `{ street: string, city?: string }`.
+func inlineString(fields []*Field) string {
+	if len(fields) == 0 {
+		return "{}"
+	}
+	parts := make([]string, len(fields))
+	for i, f := range fields {
+		parts[i] = f.Name
+		if f.Optional {
+			parts[i] += "?"
+		}
+		if f.Type != nil {
+			parts[i] += ": " + f.Type.String()
+		}
+	}
+	return "{ " + strings.Join(parts, ", ") + " }"
+}
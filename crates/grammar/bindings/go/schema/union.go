@@ -0,0 +1,235 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/larner-dev/cdm/bindings/go/position"
+	"github.com/larner-dev/cdm/bindings/go/value"
+)
+
+// Discriminator describes a type alias that is a discriminated union of
+// models, such as
+//
+//	ConfigValue: StringConfig | NumberConfig
+//
+// where every member model has a field with a literal type, `type: "string"`
+// and `type: "number"`, whose value tells the variants apart.
+type Discriminator struct {
+	// Name of the discriminator field
+	Field string
+	// Explicit is true when the field was named with
+	// `@union { discriminator: "..." }` rather than inferred.
+	Explicit bool
+	// One variant per union member, in member order
+	Variants []Variant
+}
+
+// Variant maps a discriminator value to the member model that carries it.
+type Variant struct {
+	Model string
+	Value value.Value
+}
+
+// Variant returns the variant whose discriminator value equals v.
+func (d *Discriminator) Variant(v value.Value) (Variant, bool) {
+	for _, variant := range d.Variants {
+		if value.Equal(variant.Value, v) {
+			return variant, true
+		}
+	}
+	return Variant{}, false
+}
+
+// checkUnion determines the discriminator of alias when it is a union of
+// models defined in this file. Unions that mix models with other types are
+// ordinary unions and are left alone unless they carry an @union config.
+func (s *Schema) checkUnion(alias *TypeAlias) []Diagnostic {
+	alias.Discriminator = nil
+	config := alias.Config("union")
+
+	var members []*Model
+	if alias.Type.Kind == Union {
+		for _, member := range alias.Type.Members {
+			model := s.unionMember(member)
+			if model == nil {
+				if config == nil {
+					return nil
+				}
+				return []Diagnostic{Errorf(CodeMissingDiscriminator, member.Span,
+					"Discriminated union '%s' can only contain models, found '%s'", alias.Name, member)}
+			}
+			members = append(members, model)
+		}
+	}
+	if len(members) == 0 {
+		if config == nil {
+			return nil
+		}
+		return []Diagnostic{Errorf(CodeMissingDiscriminator, config.Span,
+			"@union requires '%s' to be a union of models", alias.Name)}
+	}
+
+	if config != nil {
+		if field, ok := config.Value.Get("discriminator"); ok {
+			if field.Kind != value.String {
+				return []Diagnostic{Errorf(CodeMissingDiscriminator, field.Span,
+					"@union discriminator must be a field name string, found %s", field.Kind)}
+			}
+			return s.explicitDiscriminator(alias, members, field.Text)
+		}
+	}
+	return s.inferDiscriminator(alias, members)
+}
+
+// unionMember returns the model named by a union member, or nil if the
+// member is anything other than the name of a model in this file.
+func (s *Schema) unionMember(member *TypeExpr) *Model {
+	if member.Kind != Identifier {
+		return nil
+	}
+	return s.Model(member.Name)
+}
+
+func (s *Schema) explicitDiscriminator(alias *TypeAlias, members []*Model, name string) []Diagnostic {
+	var diagnostics []Diagnostic
+	discriminator := &Discriminator{Field: name, Explicit: true}
+
+	for i, model := range members {
+		span := alias.Type.Members[i].Span
+		field := s.effectiveField(model, name)
+		if field == nil {
+			diagnostics = append(diagnostics, Errorf(CodeMissingDiscriminator, span,
+				"Union '%s' member '%s' has no discriminator field '%s'", alias.Name, model.Name, name))
+			continue
+		}
+		literal := s.literalType(field.FieldType())
+		if literal == nil {
+			diagnostics = append(diagnostics, Errorf(CodeMissingDiscriminator, field.Span,
+				"Discriminator field '%s.%s' must have a literal type, found '%s'",
+				model.Name, name, field.FieldType()))
+			continue
+		}
+		discriminator.Variants = append(discriminator.Variants, Variant{Model: model.Name, Value: literal.Literal})
+	}
+
+	diagnostics = append(diagnostics, duplicateVariants(alias, discriminator)...)
+	if len(diagnostics) == 0 {
+		alias.Discriminator = discriminator
+	}
+	return diagnostics
+}
+
+// inferDiscriminator picks the only field that every member declares with a
+// literal type and a distinct value.
+func (s *Schema) inferDiscriminator(alias *TypeAlias, members []*Model) []Diagnostic {
+	var found []*Discriminator
+	var clashing *Discriminator
+
+candidates:
+	for _, field := range s.Fields(members[0]) {
+		discriminator := &Discriminator{Field: field.Name}
+		for _, model := range members {
+			memberField := s.effectiveField(model, field.Name)
+			if memberField == nil {
+				continue candidates
+			}
+			literal := s.literalType(memberField.FieldType())
+			if literal == nil {
+				continue candidates
+			}
+			discriminator.Variants = append(discriminator.Variants, Variant{Model: model.Name, Value: literal.Literal})
+		}
+		if len(duplicateVariants(alias, discriminator)) > 0 {
+			if clashing == nil {
+				clashing = discriminator
+			}
+			continue
+		}
+		found = append(found, discriminator)
+	}
+
+	switch {
+	case len(found) == 1:
+		alias.Discriminator = found[0]
+		return nil
+	case len(found) > 1:
+		names := make([]string, len(found))
+		for i, discriminator := range found {
+			names[i] = "'" + discriminator.Field + "'"
+		}
+		return []Diagnostic{Errorf(CodeMissingDiscriminator, alias.NameSpan,
+			"Union '%s' could be discriminated by %s; choose one with @union { discriminator: \"...\" }",
+			alias.Name, strings.Join(names, " or "))}
+	case clashing != nil:
+		return duplicateVariants(alias, clashing)
+	}
+	return []Diagnostic{Errorf(CodeMissingDiscriminator, alias.NameSpan,
+		"Cannot infer a discriminator for union '%s': every member model needs a field with a distinct literal type, such as `type: \"...\"`",
+		alias.Name)}
+}
+
+// duplicateVariants reports variants whose value is already used by an
+// earlier member.
+func duplicateVariants(alias *TypeAlias, discriminator *Discriminator) []Diagnostic {
+	var diagnostics []Diagnostic
+	for i, variant := range discriminator.Variants {
+		for _, earlier := range discriminator.Variants[:i] {
+			if value.Equal(earlier.Value, variant.Value) {
+				diagnostics = append(diagnostics, Errorf(CodeDuplicateDiscriminator, memberSpan(alias, variant.Model),
+					"Discriminator value %s of '%s' is already used by '%s' in union '%s'",
+					variant.Value, variant.Model, earlier.Model, alias.Name))
+				break
+			}
+		}
+	}
+	return diagnostics
+}
+
+func memberSpan(alias *TypeAlias, model string) position.Span {
+	for _, member := range alias.Type.Members {
+		if member.Kind == Identifier && member.Name == model {
+			return member.Span
+		}
+	}
+	return alias.NameSpan
+}
+
+// effectiveField returns the field named name on model, including inherited
+// fields.
+func (s *Schema) effectiveField(model *Model, name string) *Field {
+	for _, field := range s.Fields(model) {
+		if field.Name == name {
+			return field
+		}
+	}
+	return nil
+}
+
+// literalType follows type aliases from t until it reaches a single literal
+// type, returning nil if t is anything else.
+func (s *Schema) literalType(t *TypeExpr) *TypeExpr {
+	seen := map[string]bool{}
+	for t.Kind == Identifier {
+		alias := s.TypeAlias(t.Name)
+		if alias == nil || seen[t.Name] {
+			return nil
+		}
+		seen[t.Name] = true
+		t = alias.Type
+	}
+	if t.IsLiteral() {
+		return t
+	}
+	return nil
+}
+
+// String renders the discriminator as `field: value => Model, ...`, which is
+// handy in test failures and debug output.
+func (d *Discriminator) String() string {
+	variants := make([]string, len(d.Variants))
+	for i, variant := range d.Variants {
+		variants[i] = fmt.Sprintf("%s => %s", variant.Value, variant.Model)
+	}
+	return d.Field + ": " + strings.Join(variants, ", ")
+}
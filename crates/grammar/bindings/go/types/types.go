@@ -0,0 +1,257 @@
+// Package types implements the type compatibility rules of spec §3.4 over
+// schema type expressions.
+//
+// Types are first normalized: aliases are replaced by what they stand for,
+// nested unions are flattened, union members that another member already
+// covers are dropped, and the remaining members are sorted. Two types are
+// then compared structurally. Models and names that cannot be resolved, such
+// as template imports, are nominal: they only match themselves.
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/larner-dev/cdm/bindings/go/schema"
+	"github.com/larner-dev/cdm/bindings/go/value"
+)
+
+// Env resolves type alias names against a schema.
+type Env struct {
+	schema *schema.Schema
+}
+
+// NewEnv returns an Env that resolves aliases defined in s. A nil schema
+// resolves nothing.
+func NewEnv(s *schema.Schema) *Env {
+	return &Env{schema: s}
+}
+
+// Normalize returns the normal form of t. The result shares no nodes with t
+// and carries no spans.
+func (e *Env) Normalize(t *schema.TypeExpr) *schema.TypeExpr {
+	return e.normalize(t, map[string]bool{})
+}
+
+func (e *Env) normalize(t *schema.TypeExpr, aliases map[string]bool) *schema.TypeExpr {
+	switch t.Kind {
+	case schema.Identifier:
+		if e.schema != nil && !aliases[t.Name] {
+			if alias := e.schema.TypeAlias(t.Name); alias != nil {
+				aliases[t.Name] = true
+				defer delete(aliases, t.Name)
+				return e.normalize(alias.Type, aliases)
+			}
+		}
+		// Instantiations and inline objects are nominal, like the models
+		// Check generates for them; before Check they are told apart by
+		// their arguments or fields.
+		if len(t.Args) > 0 && t.Generic == "" || t.Inline && t.Name == "" {
+			return schema.Named(t.String())
+		}
+		return schema.Named(t.Name)
+
+	case schema.Array:
+		return &schema.TypeExpr{Kind: schema.Array, Element: e.normalize(t.Element, aliases)}
+
+	case schema.Map:
+		return &schema.TypeExpr{
+			Kind:    schema.Map,
+			Element: e.normalize(t.Element, aliases),
+			Key:     e.normalize(t.Key, aliases),
+		}
+
+	case schema.Union:
+		var members []*schema.TypeExpr
+		for _, member := range t.Members {
+			normal := e.normalize(member, aliases)
+			if normal.Kind == schema.Union {
+				members = append(members, normal.Members...)
+			} else {
+				members = append(members, normal)
+			}
+		}
+		return union(members)
+	}
+
+	return &schema.TypeExpr{Kind: t.Kind, Literal: value.Value{Kind: t.Literal.Kind, Bool: t.Literal.Bool, Text: t.Literal.Text, Number: t.Literal.Number}}
+}
+
+// union builds the normal form of a union of normalized, non-union members.
+func union(members []*schema.TypeExpr) *schema.TypeExpr {
+	var kept []*schema.TypeExpr
+	for i, member := range members {
+		covered := false
+		for j, other := range members {
+			if i == j || !assignable(member, other) {
+				continue
+			}
+			// Of two equivalent members keep the first.
+			if !assignable(other, member) || j < i {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			kept = append(kept, member)
+		}
+	}
+	if len(kept) == 1 {
+		return kept[0]
+	}
+	sort.SliceStable(kept, func(i, j int) bool { return kept[i].String() < kept[j].String() })
+	return &schema.TypeExpr{Kind: schema.Union, Members: kept}
+}
+
+// AssignableTo reports whether every value of a is also a value of b, after
+// resolving aliases in e.
+func (e *Env) AssignableTo(a, b *schema.TypeExpr) bool {
+	return assignable(e.Normalize(a), e.Normalize(b))
+}
+
+// Equivalent reports whether a and b accept exactly the same values, after
+// resolving aliases in e.
+func (e *Env) Equivalent(a, b *schema.TypeExpr) bool {
+	return Equivalent(e.Normalize(a), e.Normalize(b))
+}
+
+// AssignableTo reports whether every value of a is also a value of b. Both
+// types should already be normalized; see Env.AssignableTo.
+//
+//   - A type is assignable to itself, and every type to JSON.
+//   - A string literal is assignable to string, a number literal to number,
+//     a boolean literal to boolean.
+//   - Arrays are assignable if their elements are.
+//   - Maps are assignable if their keys and values are.
+//   - A union is assignable if each member is; a type is assignable to a
+//     union if it is assignable to one of its members.
+func AssignableTo(a, b *schema.TypeExpr) bool {
+	return assignable(a, b)
+}
+
+func assignable(a, b *schema.TypeExpr) bool {
+	if b.Kind == schema.Identifier && b.Name == "JSON" {
+		return true
+	}
+	if a.Kind == schema.Union {
+		for _, member := range a.Members {
+			if !assignable(member, b) {
+				return false
+			}
+		}
+		return true
+	}
+	if b.Kind == schema.Union {
+		for _, member := range b.Members {
+			if assignable(a, member) {
+				return true
+			}
+		}
+		// `true | false` holds every boolean.
+		return a.Kind == schema.Identifier && a.Name == "boolean" && hasBoolean(b, true) && hasBoolean(b, false)
+	}
+
+	switch a.Kind {
+	case schema.Identifier:
+		return b.Kind == schema.Identifier && a.Name == b.Name
+	case schema.StringLiteral:
+		return b.Kind == schema.StringLiteral && a.Literal.Text == b.Literal.Text ||
+			b.Kind == schema.Identifier && b.Name == "string"
+	case schema.NumberLiteral:
+		return b.Kind == schema.NumberLiteral && a.Literal.Number.Cmp(b.Literal.Number) == 0 ||
+			b.Kind == schema.Identifier && b.Name == "number"
+	case schema.BooleanLiteral:
+		return b.Kind == schema.BooleanLiteral && a.Literal.Bool == b.Literal.Bool ||
+			b.Kind == schema.Identifier && b.Name == "boolean"
+	case schema.Null:
+		return b.Kind == schema.Null
+	case schema.Array:
+		return b.Kind == schema.Array && assignable(a.Element, b.Element)
+	case schema.Map:
+		return b.Kind == schema.Map && assignable(a.Key, b.Key) && assignable(a.Element, b.Element)
+	}
+	return false
+}
+
+func hasBoolean(union *schema.TypeExpr, b bool) bool {
+	for _, member := range union.Members {
+		if member.Kind == schema.BooleanLiteral && member.Literal.Bool == b {
+			return true
+		}
+	}
+	return false
+}
+
+// WithoutNull splits a normalized type into the type of its non-null values
+// and whether it admits null, so `string | null` gives `string` and true. A
+// type that admits nothing but null is returned as it is.
+func WithoutNull(t *schema.TypeExpr) (*schema.TypeExpr, bool) {
+	if t.Kind != schema.Union {
+		return t, t.Kind == schema.Null
+	}
+	var members []*schema.TypeExpr
+	for _, member := range t.Members {
+		if member.Kind != schema.Null {
+			members = append(members, member)
+		}
+	}
+	switch {
+	case len(members) == len(t.Members):
+		return t, false
+	case len(members) == 1:
+		return members[0], true
+	}
+	return &schema.TypeExpr{Kind: schema.Union, Members: members}, true
+}
+
+// Equivalent reports whether a and b accept exactly the same values. Both
+// types should already be normalized; see Env.Equivalent.
+func Equivalent(a, b *schema.TypeExpr) bool {
+	return assignable(a, b) && assignable(b, a)
+}
+
+// Change classifies how a type changed between two versions of a schema.
+type Change int
+
+const (
+	// Same means the old and new types accept the same values.
+	Same Change = iota
+	// Widening means the new type accepts every old value and more, so
+	// existing data stays valid.
+	Widening
+	// Narrowing means the new type rejects some old values, so existing
+	// data may need to be migrated.
+	Narrowing
+	// Incompatible means neither type contains the other.
+	Incompatible
+)
+
+func (c Change) String() string {
+	switch c {
+	case Same:
+		return "same"
+	case Widening:
+		return "widening"
+	case Narrowing:
+		return "narrowing"
+	case Incompatible:
+		return "incompatible"
+	}
+	return fmt.Sprintf("Change(%d)", int(c))
+}
+
+// Classify compares a type before and after a change. Both types should
+// already be normalized, each in the Env of its own schema version.
+func Classify(from, to *schema.TypeExpr) Change {
+	widens := assignable(from, to)
+	narrows := assignable(to, from)
+	switch {
+	case widens && narrows:
+		return Same
+	case widens:
+		return Widening
+	case narrows:
+		return Narrowing
+	}
+	return Incompatible
+}
@@ -0,0 +1,188 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/larner-dev/cdm/bindings/go/schema"
+	"github.com/larner-dev/cdm/bindings/go/types"
+	"github.com/larner-dev/cdm/bindings/go/value"
+)
+
+const aliases = `Email: string
+Status: "active" | "pending"
+AllStatus: Status | "deleted"
+Rank: 1 | 2 | 3
+Emails: Email[]
+Loop: Loop
+`
+
+// fieldTypes parses a model whose fields have the given types, in a file
+// that also defines the aliases above, and returns the type of each field.
+func fieldTypes(t *testing.T, env string, exprs ...string) (*types.Env, []*schema.TypeExpr) {
+	t.Helper()
+
+	source := env + "\nUser {\n  name: string\n}\n\nHolder {\n"
+	for i, expr := range exprs {
+		source += "  f" + string(rune('a'+i)) + ": " + expr + "\n"
+	}
+	source += "}\n"
+
+	s, diagnostics := schema.Parse([]byte(source))
+	if len(diagnostics) > 0 {
+		t.Fatalf("Parse returned diagnostics: %v", diagnostics)
+	}
+	var out []*schema.TypeExpr
+	for _, field := range s.Model("Holder").Fields {
+		out = append(out, field.Type)
+	}
+	return types.NewEnv(s), out
+}
+
+func TestNormalize(t *testing.T) {
+	cases := []struct {
+		expr string
+		want string
+	}{
+		{"Email", "string"},
+		{"Emails", "string[]"},
+		{"AllStatus", `"active" | "deleted" | "pending"`},
+		{`"pending" | Status`, `"active" | "pending"`},
+		{`"a" | string`, "string"},
+		{"User | JSON", "JSON"},
+		{"Email[Rank]", "string[1 | 2 | 3]"},
+		{"Rank | 2", "1 | 2 | 3"},
+		{"Loop", "Loop"},
+		{"sql.UUID", "sql.UUID"},
+	}
+	for _, c := range cases {
+		env, exprs := fieldTypes(t, aliases, c.expr)
+		if got := env.Normalize(exprs[0]).String(); got != c.want {
+			t.Errorf("Normalize(%s) = %s, want %s", c.expr, got, c.want)
+		}
+	}
+}
+
+func TestAssignableTo(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want bool
+	}{
+		{"string", "string", true},
+		{"Email", "string", true},
+		{"string", "Email", true},
+		{`"active"`, "string", true},
+		{"string", `"active"`, false},
+		{"Status", "AllStatus", true},
+		{"AllStatus", "Status", false},
+		{"Rank", "number", true},
+		{"Status", "number", false},
+		{"Emails", "string[]", true},
+		{"Status[]", "string[]", true},
+		{"string[]", "Status[]", false},
+		{"string[Status]", "string[string]", true},
+		{"string[string]", "string[Status]", false},
+		{"Status[string]", "string[string]", true},
+		{"User", "User", true},
+		{"User", "string", false},
+		{"User", "JSON", true},
+		{"JSON", "User", false},
+		{"User", "User | string", true},
+		{"string | number", "number | string", true},
+		{"string | number", "string", false},
+		{"sql.UUID", "string", false},
+	}
+	for _, c := range cases {
+		env, exprs := fieldTypes(t, aliases, c.a, c.b)
+		if got := env.AssignableTo(exprs[0], exprs[1]); got != c.want {
+			t.Errorf("AssignableTo(%s, %s) = %v, want %v", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestEquivalent(t *testing.T) {
+	env, exprs := fieldTypes(t, aliases, "AllStatus", `"deleted" | "pending" | "active"`, "Emails", "string[]", "Status", "string")
+	if !env.Equivalent(exprs[0], exprs[1]) {
+		t.Error("AllStatus should be equivalent to its spelled-out union")
+	}
+	if !env.Equivalent(exprs[2], exprs[3]) {
+		t.Error("Emails should be equivalent to string[]")
+	}
+	if env.Equivalent(exprs[4], exprs[5]) {
+		t.Error("Status should not be equivalent to string")
+	}
+}
+
+// Classify compares types from two versions of a schema, each normalized
+// against its own aliases.
+func TestClassify(t *testing.T) {
+	cases := []struct {
+		before, after string
+		want          types.Change
+	}{
+		{`Status: "a" | "b"`, `Status: "b" | "a"`, types.Same},
+		{`Status: "a" | "b"`, `Status: "a" | "b" | "c"`, types.Widening},
+		{`Status: "a" | "b"`, `Status: "a"`, types.Narrowing},
+		{`Status: "a" | "b"`, `Status: string`, types.Widening},
+		{`Status: string`, `Status: number`, types.Incompatible},
+		{`Status: string[]`, `Status: string[] | string`, types.Widening},
+		{`Status: number[string]`, `Status: number["x" | "y"]`, types.Narrowing},
+	}
+	for _, c := range cases {
+		beforeEnv, before := fieldTypes(t, c.before, "Status")
+		afterEnv, after := fieldTypes(t, c.after, "Status")
+		got := types.Classify(beforeEnv.Normalize(before[0]), afterEnv.Normalize(after[0]))
+		if got != c.want {
+			t.Errorf("Classify(%s => %s) = %s, want %s", c.before, c.after, got, c.want)
+		}
+	}
+}
+
+// Boolean literal types are built directly so the test does not depend on
+// the generated parser knowing them.
+func TestBooleanLiterals(t *testing.T) {
+	literal := func(b bool) *schema.TypeExpr {
+		return &schema.TypeExpr{Kind: schema.BooleanLiteral, Literal: value.Value{Kind: value.Bool, Bool: b}}
+	}
+	union := func(members ...*schema.TypeExpr) *schema.TypeExpr {
+		return &schema.TypeExpr{Kind: schema.Union, Members: members}
+	}
+	boolean := schema.Named("boolean")
+	env := types.NewEnv(nil)
+
+	if !env.AssignableTo(literal(true), boolean) || env.AssignableTo(boolean, literal(true)) {
+		t.Error("true should be assignable to boolean, and not the reverse")
+	}
+	if env.AssignableTo(literal(true), literal(false)) {
+		t.Error("true should not be assignable to false")
+	}
+	if !env.Equivalent(boolean, union(literal(false), literal(true))) {
+		t.Error("boolean should be equivalent to true | false")
+	}
+	if got := env.Normalize(union(literal(true), boolean)).String(); got != "boolean" {
+		t.Errorf("Normalize(true | boolean) = %s, want boolean", got)
+	}
+	if got := types.Classify(env.Normalize(literal(true)), env.Normalize(boolean)); got != types.Widening {
+		t.Errorf("Classify(true => boolean) = %s, want widening", got)
+	}
+}
+
+func TestWithoutNull(t *testing.T) {
+	null := &schema.TypeExpr{Kind: schema.Null}
+	env, exprs := fieldTypes(t, aliases, "Status", "string")
+	cases := []struct {
+		t        *schema.TypeExpr
+		want     string
+		nullable bool
+	}{
+		{exprs[1], "string", false},
+		{&schema.TypeExpr{Kind: schema.Union, Members: []*schema.TypeExpr{exprs[1], null}}, "string", true},
+		{&schema.TypeExpr{Kind: schema.Union, Members: []*schema.TypeExpr{exprs[0], null}}, `"active" | "pending"`, true},
+		{null, "null", true},
+	}
+	for _, c := range cases {
+		got, nullable := types.WithoutNull(env.Normalize(c.t))
+		if got.String() != c.want || nullable != c.nullable {
+			t.Errorf("WithoutNull(%s) = %s, %v, want %s, %v", c.t, got, nullable, c.want, c.nullable)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package validation
+
+import (
+	"fmt"
+	"math/big"
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	"github.com/larner-dev/cdm/bindings/go/schema"
+	"github.com/larner-dev/cdm/bindings/go/value"
+)
+
+// Violation is a part of a value that breaks its type or a constraint.
+type Violation struct {
+	// Path to the offending part, such as `.email` or `.tags[2]`; empty for
+	// the value itself
+	Path    string
+	Message string
+}
+
+func (v Violation) String() string {
+	if v.Path == "" {
+		return v.Message
+	}
+	return v.Path + ": " + v.Message
+}
+
+// Validator evaluates values against the types and @validation
+// constraints of a schema.
+type Validator struct {
+	schema *schema.Schema
+}
+
+// NewValidator returns a Validator for the types defined in s.
+func NewValidator(s *schema.Schema) *Validator {
+	return &Validator{schema: s}
+}
+
+// ValidateJSON decodes a JSON document and validates it against t.
+func (v *Validator) ValidateJSON(data []byte, t *schema.TypeExpr) ([]Violation, error) {
+	decoded, err := value.FromJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	return v.Validate(decoded, t), nil
+}
+
+// Validate checks data against t. A value of the wrong type yields a single
+// violation; otherwise every broken constraint is reported.
+func (v *Validator) Validate(data value.Value, t *schema.TypeExpr) []Violation {
+	if mismatch := v.schema.Match(data, t); mismatch != nil {
+		return []Violation{{Path: mismatch.Path, Message: fmt.Sprintf("expected %s, found %s", mismatch.Expected, mismatch.Found)}}
+	}
+	var violations []Violation
+	v.evaluate(data, t, Constraints{}, "", map[string]bool{}, &violations)
+	return violations
+}
+
+// evaluate walks data alongside its type, collecting the constraints of the
+// aliases and fields it passes through. data is known to match t.
+func (v *Validator) evaluate(data value.Value, t *schema.TypeExpr, c Constraints, path string, aliases map[string]bool, out *[]Violation) {
+	switch t.Kind {
+	case schema.Identifier:
+		if alias := v.schema.TypeAlias(t.Name); alias != nil && !aliases[alias.Name] {
+			aliases[alias.Name] = true
+			defer delete(aliases, alias.Name)
+			v.evaluate(data, alias.Type, FromConfigs(alias.Configs).Merge(c), path, aliases, out)
+			return
+		}
+		if model := v.schema.Model(t.Name); model != nil {
+			v.evaluateModel(data, model, path, out)
+			return
+		}
+
+	case schema.Array:
+		apply(data, c, path, out)
+		element := c.elementConstraints()
+		for i, item := range data.Items {
+			v.evaluate(item, t.Element, element, fmt.Sprintf("%s[%d]", path, i), aliases, out)
+		}
+		return
+
+	case schema.Map:
+		for _, entry := range data.Entries {
+			v.evaluate(entry.Value, t.Element, Constraints{}, path+"."+entry.Key, aliases, out)
+		}
+		return
+
+	case schema.Union:
+		for _, member := range t.Members {
+			if v.schema.Match(data, member) == nil {
+				v.evaluate(data, member, c, path, aliases, out)
+				return
+			}
+		}
+		return
+	}
+	apply(data, c, path, out)
+}
+
+func (v *Validator) evaluateModel(data value.Value, model *schema.Model, path string, out *[]Violation) {
+	for _, field := range v.schema.Fields(model) {
+		fieldValue, ok := data.Get(field.Name)
+		if !ok || fieldValue.Kind == value.Null {
+			continue
+		}
+		c := FromConfigs(field.Configs)
+		for _, override := range model.Overrides {
+			if override.Name == field.Name {
+				c = c.Merge(FromConfigs(override.Configs))
+			}
+		}
+		v.evaluate(fieldValue, field.FieldType(), c, path+"."+field.Name, map[string]bool{}, out)
+	}
+}
+
+// apply checks the constraints that apply directly to data.
+func apply(data value.Value, c Constraints, path string, out *[]Violation) {
+	violate := func(format string, args ...any) {
+		*out = append(*out, Violation{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	switch data.Kind {
+	case value.String:
+		if c.Format != "" {
+			if valid, known := formats[c.Format]; known && !valid(data.Text) {
+				violate("must be a valid %s", c.Format)
+			}
+		}
+		if c.Pattern != nil && !c.Pattern.MatchString(data.Text) {
+			violate("must match pattern %q", c.Pattern)
+		}
+		length := utf8.RuneCountInString(data.Text)
+		if c.MinLength != nil && length < *c.MinLength {
+			violate("must be at least %d characters, found %d", *c.MinLength, length)
+		}
+		if c.MaxLength != nil && length > *c.MaxLength {
+			violate("must be at most %d characters, found %d", *c.MaxLength, length)
+		}
+
+	case value.Number:
+		if c.Min != "" && data.Number.Cmp(c.Min) < 0 {
+			violate("must be at least %s, found %s", c.Min, data.Number)
+		}
+		if c.Max != "" && data.Number.Cmp(c.Max) > 0 {
+			violate("must be at most %s, found %s", c.Max, data.Number)
+		}
+		if c.MultipleOf != "" && !isMultiple(data.Number, c.MultipleOf) {
+			violate("must be a multiple of %s, found %s", c.MultipleOf, data.Number)
+		}
+
+	case value.Array:
+		if c.MinLength != nil && len(data.Items) < *c.MinLength {
+			violate("must have at least %d items, found %d", *c.MinLength, len(data.Items))
+		}
+		if c.MaxLength != nil && len(data.Items) > *c.MaxLength {
+			violate("must have at most %d items, found %d", *c.MaxLength, len(data.Items))
+		}
+		if c.UniqueItems {
+			for i := range data.Items {
+				for j := 0; j < i; j++ {
+					if value.Equal(data.Items[i], data.Items[j]) {
+						violate("items must be unique, but items %d and %d are equal", j, i)
+					}
+				}
+			}
+		}
+	}
+}
+
+func isMultiple(n, of value.Decimal) bool {
+	a, ok := n.Rat()
+	if !ok {
+		return false
+	}
+	b, ok := of.Rat()
+	if !ok || b.Sign() == 0 {
+		return false
+	}
+	return new(big.Rat).Quo(a, b).IsInt()
+}
+
+var (
+	uuidPattern     = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	hostnamePattern = regexp.MustCompile(`^(?i:[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?)(?:\.(?i:[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?))*$`)
+)
+
+// Formats returns the names of the formats the validator can check, in
+// sorted order.
+func Formats() []string {
+	names := make([]string, 0, len(formats))
+	for name := range formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// formats holds a checker for each member of the Format alias in
+// schema.cdm.
+var formats = map[string]func(string) bool{
+	"email": func(s string) bool {
+		address, err := mail.ParseAddress(s)
+		return err == nil && address.Name == "" && address.Address == s
+	},
+	"uuid": uuidPattern.MatchString,
+	"uri": func(s string) bool {
+		u, err := url.Parse(s)
+		return err == nil && u.Scheme != ""
+	},
+	"url": func(s string) bool {
+		u, err := url.Parse(s)
+		return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+	},
+	"hostname": func(s string) bool {
+		return len(s) <= 253 && hostnamePattern.MatchString(s)
+	},
+	"ipv4": func(s string) bool {
+		addr, err := netip.ParseAddr(s)
+		return err == nil && addr.Is4()
+	},
+	"ipv6": func(s string) bool {
+		addr, err := netip.ParseAddr(s)
+		return err == nil && addr.Is6()
+	},
+	"date": func(s string) bool {
+		_, err := time.Parse(time.DateOnly, s)
+		return err == nil
+	},
+	"time": func(s string) bool {
+		_, err := time.Parse("15:04:05Z07:00", s)
+		if err != nil {
+			_, err = time.Parse(time.TimeOnly, s)
+		}
+		return err == nil
+	},
+	"date-time": func(s string) bool {
+		_, err := time.Parse(time.RFC3339Nano, s)
+		return err == nil
+	},
+}
@@ -0,0 +1,274 @@
+// Package validation defines the standard `@validation` constraint
+// vocabulary: format, min, max, min_length, max_length, pattern,
+// multiple_of and unique_items.
+//
+// Check reports malformed or misplaced constraints in a schema, and
+// Validator evaluates values (typically decoded from JSON) against a
+// schema's types and constraints. The settings schema in schema.cdm is the
+// single definition of what each constraint accepts.
+package validation
+
+import (
+	_ "embed"
+	"regexp"
+
+	"github.com/larner-dev/cdm/bindings/go/schema"
+	"github.com/larner-dev/cdm/bindings/go/types"
+	"github.com/larner-dev/cdm/bindings/go/value"
+)
+
+// PluginName is the name constraints are configured under, as in
+// `@validation { format: "email" }`.
+const PluginName = "validation"
+
+//go:embed schema.cdm
+var settingsSource []byte
+
+// SettingsSchema returns the parsed schema.cdm that @validation configs
+// must conform to.
+var SettingsSchema = schema.SettingsLoader(PluginName, settingsSource)
+
+// Constraints is a decoded @validation config. Unset numeric bounds are
+// empty decimals and nil lengths.
+type Constraints struct {
+	Format      string
+	Min         value.Decimal
+	Max         value.Decimal
+	MinLength   *int
+	MaxLength   *int
+	Pattern     *regexp.Regexp
+	MultipleOf  value.Decimal
+	UniqueItems bool
+}
+
+// IsZero reports whether no constraint is set.
+func (c Constraints) IsZero() bool {
+	return c.Format == "" && c.Min == "" && c.Max == "" && c.MinLength == nil && c.MaxLength == nil &&
+		c.Pattern == nil && c.MultipleOf == "" && !c.UniqueItems
+}
+
+// Merge returns c with every constraint that override sets replacing c's.
+// A field's constraints override those of the alias it is typed with.
+func (c Constraints) Merge(override Constraints) Constraints {
+	if override.Format != "" {
+		c.Format = override.Format
+	}
+	if override.Min != "" {
+		c.Min = override.Min
+	}
+	if override.Max != "" {
+		c.Max = override.Max
+	}
+	if override.MinLength != nil {
+		c.MinLength = override.MinLength
+	}
+	if override.MaxLength != nil {
+		c.MaxLength = override.MaxLength
+	}
+	if override.Pattern != nil {
+		c.Pattern = override.Pattern
+	}
+	if override.MultipleOf != "" {
+		c.MultipleOf = override.MultipleOf
+	}
+	if override.UniqueItems {
+		c.UniqueItems = true
+	}
+	return c
+}
+
+// elementConstraints returns the constraints that apply to each element of
+// an array rather than to the array itself.
+func (c Constraints) elementConstraints() Constraints {
+	return Constraints{Format: c.Format, Min: c.Min, Max: c.Max, Pattern: c.Pattern, MultipleOf: c.MultipleOf}
+}
+
+// FromConfigs returns the constraints of the @validation config among
+// configs. Malformed entries are skipped; Check reports them.
+func FromConfigs(configs []*schema.Config) Constraints {
+	for _, config := range configs {
+		if config.Name == PluginName {
+			c, _ := decode(schema.ConfigValue(config))
+			return c
+		}
+	}
+	return Constraints{}
+}
+
+// decode reads constraints from a config that conforms to schema.cdm,
+// returning a diagnostic message and span for each value that is well
+// typed but still invalid.
+func decode(config value.Value) (Constraints, []schema.Diagnostic) {
+	var c Constraints
+	var problems []schema.Diagnostic
+	problem := func(v value.Value, format string, args ...any) {
+		problems = append(problems, schema.Errorf(schema.CodeInvalidConstraint, v.Span, format, args...))
+	}
+	length := func(v value.Value, key string) *int {
+		n, ok := v.Number.Int64()
+		if !ok || n < 0 {
+			problem(v, "%s must be a non-negative integer, found %s", key, v.Number)
+			return nil
+		}
+		length := int(n)
+		return &length
+	}
+
+	for _, entry := range config.Entries {
+		v := entry.Value
+		switch entry.Key {
+		case "format":
+			c.Format = v.Text
+		case "min":
+			c.Min = v.Number
+		case "max":
+			c.Max = v.Number
+		case "min_length":
+			c.MinLength = length(v, entry.Key)
+		case "max_length":
+			c.MaxLength = length(v, entry.Key)
+		case "pattern":
+			pattern, err := regexp.Compile(v.Text)
+			if err != nil {
+				problem(v, "invalid pattern: %v", err)
+				continue
+			}
+			c.Pattern = pattern
+		case "multiple_of":
+			if v.Number.Cmp("0") <= 0 {
+				problem(v, "multiple_of must be greater than 0, found %s", v.Number)
+				continue
+			}
+			c.MultipleOf = v.Number
+		case "unique_items":
+			c.UniqueItems = v.Bool
+		}
+	}
+
+	if c.Min != "" && c.Max != "" && c.Min.Cmp(c.Max) > 0 {
+		v, _ := config.Get("min")
+		problem(v, "min %s is greater than max %s", c.Min, c.Max)
+	}
+	if c.MinLength != nil && c.MaxLength != nil && *c.MinLength > *c.MaxLength {
+		v, _ := config.Get("min_length")
+		problem(v, "min_length %d is greater than max_length %d", *c.MinLength, *c.MaxLength)
+	}
+	return c, problems
+}
+
+// Check validates every @validation config on the type aliases, fields and
+// field overrides of s: that it conforms to schema.cdm (E402), that its
+// values are usable (E110), and that each constraint can apply to the type
+// it is attached to (E110).
+func Check(s *schema.Schema) []schema.Diagnostic {
+	var diagnostics []schema.Diagnostic
+	env := types.NewEnv(s)
+
+	for _, alias := range s.TypeAliases {
+		if config := alias.Config(PluginName); config != nil {
+			diagnostics = append(diagnostics, checkConfig(s, env, config, "TypeAliasSettings", alias.Type)...)
+		}
+	}
+	for _, model := range s.Models {
+		for _, field := range model.Fields {
+			if config := field.Config(PluginName); config != nil {
+				diagnostics = append(diagnostics, checkConfig(s, env, config, "FieldSettings", field.FieldType())...)
+			}
+		}
+		for _, override := range model.Overrides {
+			config := findConfig(override.Configs)
+			if config == nil {
+				continue
+			}
+			for _, field := range s.Fields(model) {
+				if field.Name == override.Name {
+					diagnostics = append(diagnostics, checkConfig(s, env, config, "FieldSettings", field.FieldType())...)
+				}
+			}
+		}
+	}
+	return diagnostics
+}
+
+func findConfig(configs []*schema.Config) *schema.Config {
+	for _, config := range configs {
+		if config.Name == PluginName {
+			return config
+		}
+	}
+	return nil
+}
+
+func checkConfig(s *schema.Schema, env *types.Env, config *schema.Config, settings string, t *schema.TypeExpr) []schema.Diagnostic {
+	v, mismatch := schema.CheckConfig(SettingsSchema(), config, settings)
+	if len(mismatch) > 0 {
+		return mismatch
+	}
+
+	_, diagnostics := decode(v)
+	shape := shapeOf(s, env.Normalize(t))
+	if shape.any {
+		return diagnostics
+	}
+
+	for _, entry := range v.Entries {
+		var applies bool
+		var targets string
+		switch entry.Key {
+		case "format", "pattern":
+			applies, targets = shape.str || shape.element.str, "strings"
+		case "min", "max", "multiple_of":
+			applies, targets = shape.num || shape.element.num, "numbers"
+		case "min_length", "max_length":
+			applies, targets = shape.str || shape.array, "strings and arrays"
+		case "unique_items":
+			applies, targets = shape.array, "arrays"
+		}
+		if !applies {
+			diagnostics = append(diagnostics, schema.Errorf(schema.CodeInvalidConstraint, entry.KeySpan,
+				"%s cannot apply to type '%s'; it constrains %s", entry.Key, t, targets))
+		}
+	}
+	return diagnostics
+}
+
+// shape summarizes which kinds of values a normalized type admits.
+type shape struct {
+	str, num, array, any bool
+	element              *shape
+}
+
+func shapeOf(s *schema.Schema, t *schema.TypeExpr) shape {
+	out := shape{element: &shape{}}
+	switch t.Kind {
+	case schema.StringLiteral:
+		out.str = true
+	case schema.NumberLiteral:
+		out.num = true
+	case schema.Array:
+		out.array = true
+		element := shapeOf(s, t.Element)
+		out.element = &element
+	case schema.Union:
+		for _, member := range t.Members {
+			m := shapeOf(s, member)
+			out.str, out.num, out.array, out.any = out.str || m.str, out.num || m.num, out.array || m.array, out.any || m.any
+			out.element.str = out.element.str || m.element.str
+			out.element.num = out.element.num || m.element.num
+		}
+	case schema.Identifier:
+		switch t.Name {
+		case "string":
+			out.str = true
+		case "number":
+			out.num = true
+		case "boolean", "Model", "Type":
+		case "JSON":
+			out.any = true
+		default:
+			// Models are objects; names defined elsewhere are unknown.
+			out.any = s.Model(t.Name) == nil
+		}
+	}
+	return out
+}
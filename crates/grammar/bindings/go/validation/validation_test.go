@@ -0,0 +1,170 @@
+package validation_test
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/larner-dev/cdm/bindings/go/schema"
+	"github.com/larner-dev/cdm/bindings/go/validation"
+)
+
+func mustParse(t *testing.T, source string) *schema.Schema {
+	t.Helper()
+
+	s, diagnostics := schema.Parse([]byte(source))
+	if len(diagnostics) > 0 {
+		t.Fatalf("Parse returned diagnostics: %v", diagnostics)
+	}
+	return s
+}
+
+func TestSettingsSchema(t *testing.T) {
+	s := validation.SettingsSchema()
+	for _, name := range []string{"GlobalSettings", "TypeAliasSettings", "FieldSettings"} {
+		if s.Model(name) == nil {
+			t.Errorf("schema.cdm does not define %s", name)
+		}
+	}
+
+	// Every format the schema admits must have a checker.
+	var formats []string
+	for _, member := range s.TypeAlias("Format").Type.Members {
+		formats = append(formats, member.Literal.Text)
+	}
+	sort.Strings(formats)
+	if got, want := strings.Join(validation.Formats(), ","), strings.Join(formats, ","); got != want {
+		t.Errorf("Formats() = %s, want %s", got, want)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	cases := []struct {
+		source string
+		want   string
+	}{
+		{`Email: string { @validation { format: "email", max_length: 254 } }`, ""},
+		{`Id: string { @validation { format: uuid } }`, ""},
+		{`Age: number { @validation { min: 0, max: 150, multiple_of: 1 } }`, ""},
+		{`Tags: string[] { @validation { min_length: 1, unique_items: true, pattern: "^[a-z]+$" } }`, ""},
+		{`Anything: JSON { @validation { min: 0, pattern: "x" } }`, ""},
+		{`Email: string { @validation { format: "e-mail" } }`, "E402"},
+		{`Email: string { @validation { maxLength: 10 } }`, "E402"},
+		{`Email: string { @validation { min_length: "1" } }`, "E402"},
+		{`Age: number { @validation { format: "email" } }`, "E110"},
+		{`Name: string { @validation { min: 1 } }`, "E110"},
+		{`Flag: boolean { @validation { min_length: 1 } }`, "E110"},
+		{`Name: string { @validation { unique_items: true } }`, "E110"},
+		{`Name: string { @validation { min_length: 1.5 } }`, "E110"},
+		{`Name: string { @validation { max_length: -1 } }`, "E110"},
+		{`Name: string { @validation { pattern: "[" } }`, "E110"},
+		{`Age: number { @validation { multiple_of: 0 } }`, "E110"},
+		{`Age: number { @validation { min: 10, max: 1 } }`, "E110"},
+		{`Name: string { @validation { min_length: 10, max_length: 1 } }`, "E110"},
+		{"Email: string\nUser {\n  email: Email { @validation { format: email } }\n  age: number { @validation { pattern: \"x\" } }\n}", "E110"},
+		{"Base {\n  age: number\n}\nUser extends Base {\n  age { @validation { min_length: 1 } }\n}", "E110"},
+	}
+	for _, c := range cases {
+		s := mustParse(t, c.source)
+		var got []string
+		for _, d := range validation.Check(s) {
+			got = append(got, d.Code)
+		}
+		if strings.Join(got, ",") != c.want {
+			t.Errorf("Check(%q) = %v, want %s", c.source, validation.Check(s), c.want)
+		}
+	}
+}
+
+const users = `Email: string {
+  @validation { format: "email" }
+}
+
+Slug: string {
+  @validation { pattern: "^[a-z0-9-]+$", max_length: 20 }
+}
+
+Address {
+  city: string { @validation { min_length: 1 } }
+}
+
+Post {
+  slug: Slug
+  tags?: string[] { @validation { max_length: 2, unique_items: true, min_length: 2 } }
+}
+
+User {
+  email: Email
+  short_email?: Email { @validation { max_length: 12 } }
+  age?: number { @validation { min: 0, max: 150, multiple_of: 1 } }
+  score?: number { @validation { multiple_of: 0.5 } }
+  address?: Address
+  posts?: Post[]
+  links?: string[string]
+  id?: string { @validation { format: uuid } }
+}
+`
+
+func TestValidate(t *testing.T) {
+	s := mustParse(t, users)
+	v := validation.NewValidator(s)
+
+	cases := []struct {
+		json string
+		want []string
+	}{
+		{`{"email": "ada@example.com", "age": 36, "score": 2.5}`, nil},
+		{`{"email": "ada"}`, []string{".email: must be a valid email"}},
+		{`{"email": 3}`, []string{".email: expected Email (string), found 3"}},
+		{`{"email": "ada@example.com", "short_email": "ada@example.com"}`, []string{".short_email: must be at most 12 characters, found 15"}},
+		{`{"email": "ada@example.com", "short_email": "ada"}`, []string{".short_email: must be a valid email"}},
+		{`{"email": "ada@example.com", "age": -1}`, []string{".age: must be at least 0, found -1"}},
+		{`{"email": "ada@example.com", "age": 1.5}`, []string{".age: must be a multiple of 1, found 1.5"}},
+		{`{"email": "ada@example.com", "score": 0.25}`, []string{".score: must be a multiple of 0.5, found 0.25"}},
+		{`{"email": "ada@example.com", "address": {"city": ""}}`, []string{".address.city: must be at least 1 characters, found 0"}},
+		{`{"email": "ada@example.com", "posts": [{"slug": "ok"}, {"slug": "Not OK"}]}`, []string{`.posts[1].slug: must match pattern "^[a-z0-9-]+$"`}},
+		{`{"email": "ada@example.com", "posts": [{"slug": "a", "tags": ["x", "x"]}]}`, []string{".posts[0].tags: items must be unique, but items 0 and 1 are equal"}},
+		{`{"email": "ada@example.com", "posts": [{"slug": "a", "tags": ["x"]}]}`, []string{".posts[0].tags: must have at least 2 items, found 1"}},
+		{`{"email": "ada@example.com", "id": "123e4567-e89b-12d3-a456-426614174000"}`, nil},
+		{`{"email": "ada@example.com", "id": "123"}`, []string{".id: must be a valid uuid"}},
+	}
+	for _, c := range cases {
+		violations, err := v.ValidateJSON([]byte(c.json), schema.Named("User"))
+		if err != nil {
+			t.Fatalf("ValidateJSON(%s): %v", c.json, err)
+		}
+		var got []string
+		for _, violation := range violations {
+			got = append(got, violation.String())
+		}
+		if strings.Join(got, "\n") != strings.Join(c.want, "\n") {
+			t.Errorf("ValidateJSON(%s) = %q, want %q", c.json, got, c.want)
+		}
+	}
+}
+
+func TestFormats(t *testing.T) {
+	cases := []struct {
+		format, valid, invalid string
+	}{
+		{"email", "ada@example.com", "Ada <ada@example.com>"},
+		{"uri", "urn:isbn:0451450523", "example.com"},
+		{"url", "https://example.com/a?b=c", "ftp://example.com"},
+		{"hostname", "api.example.com", "-bad-.com"},
+		{"ipv4", "192.168.0.1", "::1"},
+		{"ipv6", "::1", "192.168.0.1"},
+		{"date", "2024-02-29", "2023-02-29"},
+		{"time", "13:45:00Z", "25:00:00"},
+		{"date-time", "2024-01-02T03:04:05.678+01:00", "2024-01-02 03:04:05"},
+	}
+	for _, c := range cases {
+		s := mustParse(t, "Value: string { @validation { format: \""+c.format+"\" } }")
+		v := validation.NewValidator(s)
+		if violations, _ := v.ValidateJSON([]byte(`"`+c.valid+`"`), schema.Named("Value")); len(violations) > 0 {
+			t.Errorf("%s: %q was rejected: %v", c.format, c.valid, violations)
+		}
+		if violations, _ := v.ValidateJSON([]byte(`"`+c.invalid+`"`), schema.Named("Value")); len(violations) == 0 {
+			t.Errorf("%s: %q was accepted", c.format, c.invalid)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package value
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// MarshalJSON encodes v as JSON. Object keys keep their order, numbers keep
+// their digits (leading zeros and a bare decimal point, which JSON does not
+// allow, are dropped, so `007.50` is written 7.50), and references are written as strings
+// holding the referenced name, which is how plugins receive them. Calls are
+// written as `{ "kind": "call", "name": "now" }`, the Appendix D form of a
+// function call default.
+func (v Value) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := v.writeJSON(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (v Value) writeJSON(buf *bytes.Buffer) error {
+	switch v.Kind {
+	case Null:
+		buf.WriteString("null")
+	case Bool:
+		if v.Bool {
+			buf.WriteString("true")
+		} else {
+			buf.WriteString("false")
+		}
+	case Number:
+		number, ok := jsonNumber(v.Number)
+		if !ok {
+			return fmt.Errorf("invalid number %q", string(v.Number))
+		}
+		buf.WriteString(number)
+	case String, Reference:
+		encoded, err := json.Marshal(v.Text)
+		if err != nil {
+			return err
+		}
+		buf.Write(encoded)
+	case Call:
+		name, err := json.Marshal(v.Text)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(`{"kind":"call","name":`)
+		buf.Write(name)
+		buf.WriteByte('}')
+	case Array:
+		buf.WriteByte('[')
+		for i, item := range v.Items {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := item.writeJSON(buf); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case Object:
+		buf.WriteByte('{')
+		for i, entry := range v.Entries {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			key, err := json.Marshal(entry.Key)
+			if err != nil {
+				return err
+			}
+			buf.Write(key)
+			buf.WriteByte(':')
+			if err := entry.Value.writeJSON(buf); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	default:
+		return fmt.Errorf("cannot encode value of kind %s", v.Kind)
+	}
+	return nil
+}
+
+// jsonNumber spells d as a JSON number: an optional minus sign, an integer
+// part without leading zeros, and a fraction and exponent as d has them. It
+// reports false if d is not a decimal number.
+func jsonNumber(d Decimal) (string, bool) {
+	s := string(d)
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	} else {
+		s = strings.TrimPrefix(s, "+")
+	}
+	exponent := ""
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		s, exponent = s[:i], s[i:]
+		digits := exponent[1:]
+		if strings.HasPrefix(digits, "+") || strings.HasPrefix(digits, "-") {
+			digits = digits[1:]
+		}
+		if digits == "" || !isDigits(digits) {
+			return "", false
+		}
+	}
+	integer, fraction, _ := strings.Cut(s, ".")
+	if !isDigits(integer) || !isDigits(fraction) || integer+fraction == "" {
+		return "", false
+	}
+	integer = strings.TrimLeft(integer, "0")
+	if integer == "" {
+		integer = "0"
+	}
+	if fraction != "" {
+		fraction = "." + fraction
+	}
+	return sign + integer + fraction + exponent, true
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// UnmarshalJSON decodes JSON into v, keeping object key order and exact
+// number text. JSON has no references or calls, so names arrive as strings
+// and calls as objects.
+func (v *Value) UnmarshalJSON(data []byte) error {
+	decoded, err := FromJSON(data)
+	if err != nil {
+		return err
+	}
+	*v = decoded
+	return nil
+}
+
+// FromJSON decodes a JSON document into a Value.
+func FromJSON(data []byte) (Value, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	v, err := decodeJSON(decoder)
+	if err != nil {
+		return Value{}, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return Value{}, fmt.Errorf("unexpected data after JSON value")
+	}
+	return v, nil
+}
+
+func decodeJSON(decoder *json.Decoder) (Value, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return Value{}, err
+	}
+
+	switch t := token.(type) {
+	case nil:
+		return Value{Kind: Null}, nil
+	case bool:
+		return Value{Kind: Bool, Bool: t}, nil
+	case json.Number:
+		return Value{Kind: Number, Number: Decimal(t)}, nil
+	case string:
+		return Value{Kind: String, Text: t}, nil
+	case json.Delim:
+		switch t {
+		case '[':
+			v := Value{Kind: Array, Items: []Value{}}
+			for decoder.More() {
+				item, err := decodeJSON(decoder)
+				if err != nil {
+					return Value{}, err
+				}
+				v.Items = append(v.Items, item)
+			}
+			_, err := decoder.Token()
+			return v, err
+		case '{':
+			v := Value{Kind: Object, Entries: []Entry{}}
+			for decoder.More() {
+				keyToken, err := decoder.Token()
+				if err != nil {
+					return Value{}, err
+				}
+				key, _ := keyToken.(string)
+				item, err := decodeJSON(decoder)
+				if err != nil {
+					return Value{}, err
+				}
+				v.Entries = append(v.Entries, Entry{Key: key, Value: item})
+			}
+			_, err := decoder.Token()
+			return v, err
+		}
+	}
+	return Value{}, fmt.Errorf("unexpected JSON token %v", token)
+}
+
+// Interface converts v into the generic form produced by encoding/json with
+// UseNumber: map[string]any, []any, json.Number, string, bool and nil. Key
+// order is lost.
+func (v Value) Interface() any {
+	switch v.Kind {
+	case Bool:
+		return v.Bool
+	case Number:
+		return json.Number(v.Number)
+	case String, Reference:
+		return v.Text
+	case Call:
+		return map[string]any{"kind": "call", "name": v.Text}
+	case Array:
+		items := make([]any, len(v.Items))
+		for i, item := range v.Items {
+			items[i] = item.Interface()
+		}
+		return items
+	case Object:
+		entries := make(map[string]any, len(v.Entries))
+		for _, entry := range v.Entries {
+			entries[entry.Key] = entry.Value.Interface()
+		}
+		return entries
+	}
+	return nil
+}
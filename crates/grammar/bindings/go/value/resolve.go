@@ -0,0 +1,62 @@
+package value
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Binding pairs an identifier_value reference with the symbol it names.
+type Binding[S any] struct {
+	Reference *Value
+	Symbol    S
+}
+
+// UnresolvedError reports a reference that names no known symbol.
+type UnresolvedError struct {
+	Reference *Value
+}
+
+func (e *UnresolvedError) Error() string {
+	return fmt.Sprintf("%d:%d: unknown reference '%s'",
+		e.Reference.Span.Start.Row+1, e.Reference.Span.Start.Column+1, e.Reference.Text)
+}
+
+// References returns every Reference value in v, in document order. The
+// pointers refer into v, so v must not be copied while they are in use.
+func (v *Value) References() []*Value {
+	var refs []*Value
+	v.walk(func(item *Value) {
+		if item.Kind == Reference {
+			refs = append(refs, item)
+		}
+	})
+	return refs
+}
+
+func (v *Value) walk(visit func(*Value)) {
+	visit(v)
+	for i := range v.Items {
+		v.Items[i].walk(visit)
+	}
+	for i := range v.Entries {
+		v.Entries[i].Value.walk(visit)
+	}
+}
+
+// Resolve looks up the symbol named by each reference in v. lookup is
+// usually backed by a symbol table of models and type aliases. References
+// lookup does not know are returned as *UnresolvedError values joined into a
+// single error.
+func Resolve[S any](v *Value, lookup func(name string) (S, bool)) ([]Binding[S], error) {
+	var bindings []Binding[S]
+	var errs []error
+	for _, ref := range v.References() {
+		symbol, ok := lookup(ref.Text)
+		if !ok {
+			errs = append(errs, &UnresolvedError{Reference: ref})
+			continue
+		}
+		bindings = append(bindings, Binding[S]{Reference: ref, Symbol: symbol})
+	}
+	return bindings, errors.Join(errs...)
+}
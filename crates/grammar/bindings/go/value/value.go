@@ -0,0 +1,427 @@
+// Package value models the literal values that appear in plugin
+// configurations, template and plugin import configs, and field defaults.
+//
+// Values are built from `object_literal`, `array_literal`, `string_literal`,
+// `number_literal`, `boolean_literal`, `null_literal`, `identifier_value` and
+// `function_call` nodes. Object keys keep their source order, numbers keep their exact
+// decimal text, and every value remembers its span so diagnostics can point
+// inside a config.
+package value
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/larner-dev/cdm/bindings/go/literal"
+	"github.com/larner-dev/cdm/bindings/go/position"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// Kind identifies the type of a Value.
+type Kind int
+
+const (
+	Null Kind = iota
+	Bool
+	Number
+	String
+	Array
+	Object
+	// Reference is an unquoted identifier such as `User` in `input: User`,
+	// naming a model or type alias.
+	Reference
+	// Call is a function call default such as `now()`, evaluated when a
+	// record is created rather than when the schema is read.
+	Call
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Null:
+		return "null"
+	case Bool:
+		return "boolean"
+	case Number:
+		return "number"
+	case String:
+		return "string"
+	case Array:
+		return "array"
+	case Object:
+		return "object"
+	case Reference:
+		return "reference"
+	case Call:
+		return "call"
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// Value is a literal value. Only the fields that match Kind are set.
+type Value struct {
+	Kind Kind
+	// Set for Bool
+	Bool bool
+	// Set for Number
+	Number Decimal
+	// Decoded string for String, identifier name for Reference, function
+	// name for Call
+	Text string
+	// Elements of an Array
+	Items []Value
+	// Entries of an Object, in source order
+	Entries []Entry
+	// Source extent; zero for values that did not come from CDM source
+	Span position.Span
+}
+
+// Entry is a key/value pair of an object literal.
+type Entry struct {
+	Key     string
+	KeySpan position.Span
+	Value   Value
+}
+
+// Decimal is an exact decimal number kept as its literal text, so large IDs
+// and precise amounts survive without float64 rounding.
+type Decimal string
+
+// Int64 returns the value as an int64 if it is an integer in range.
+func (d Decimal) Int64() (int64, bool) {
+	n, err := strconv.ParseInt(string(d), 10, 64)
+	return n, err == nil
+}
+
+// Float64 returns the nearest float64.
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(string(d), 64)
+	return f
+}
+
+// Rat returns the exact value.
+func (d Decimal) Rat() (*big.Rat, bool) {
+	return new(big.Rat).SetString(string(d))
+}
+
+// IsInteger reports whether the number has no fractional part.
+func (d Decimal) IsInteger() bool {
+	r, ok := d.Rat()
+	return ok && r.IsInt()
+}
+
+// Cmp compares two decimals numerically, returning -1, 0 or +1. Malformed
+// decimals compare as zero.
+func (d Decimal) Cmp(other Decimal) int {
+	a, ok := d.Rat()
+	if !ok {
+		a = new(big.Rat)
+	}
+	b, ok := other.Rat()
+	if !ok {
+		b = new(big.Rat)
+	}
+	return a.Cmp(b)
+}
+
+// Get returns the value of the last entry named key, matching JSON's
+// last-one-wins rule for duplicate keys.
+func (v Value) Get(key string) (Value, bool) {
+	for i := len(v.Entries) - 1; i >= 0; i-- {
+		if v.Entries[i].Key == key {
+			return v.Entries[i].Value, true
+		}
+	}
+	return Value{}, false
+}
+
+// Keys returns the object keys in source order.
+func (v Value) Keys() []string {
+	keys := make([]string, len(v.Entries))
+	for i, entry := range v.Entries {
+		keys[i] = entry.Key
+	}
+	return keys
+}
+
+// Merge deep merges override into base, the way plugin configs merge along
+// inheritance and context chains: objects merge key by key, keeping base's
+// key order and adding new keys at the end, and anything else in override
+// replaces what is in base.
+func Merge(base, override Value) Value {
+	if base.Kind != Object || override.Kind != Object {
+		return override
+	}
+	out := base
+	out.Entries = append([]Entry{}, base.Entries...)
+	for _, entry := range override.Entries {
+		found := false
+		for i, existing := range out.Entries {
+			if existing.Key == entry.Key {
+				out.Entries[i].Value = Merge(existing.Value, entry.Value)
+				found = true
+				break
+			}
+		}
+		if !found {
+			out.Entries = append(out.Entries, entry)
+		}
+	}
+	return out
+}
+
+// Equal reports whether two values are structurally equal. Numbers compare
+// numerically, object entries compare in order, and spans are ignored.
+func Equal(a, b Value) bool {
+	if a.Kind != b.Kind {
+		return false
+	}
+
+	switch a.Kind {
+	case Null:
+		return true
+	case Bool:
+		return a.Bool == b.Bool
+	case Number:
+		return a.Number.Cmp(b.Number) == 0
+	case String, Reference, Call:
+		return a.Text == b.Text
+	case Array:
+		if len(a.Items) != len(b.Items) {
+			return false
+		}
+		for i := range a.Items {
+			if !Equal(a.Items[i], b.Items[i]) {
+				return false
+			}
+		}
+		return true
+	case Object:
+		if len(a.Entries) != len(b.Entries) {
+			return false
+		}
+		for i := range a.Entries {
+			if a.Entries[i].Key != b.Entries[i].Key || !Equal(a.Entries[i].Value, b.Entries[i].Value) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// Error reports a value that could not be built from source.
+type Error struct {
+	Span    position.Span
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d:%d: %s", e.Span.Start.Row+1, e.Span.Start.Column+1, e.Message)
+}
+
+// FromNode builds a Value from a literal node. Syntax errors inside the
+// literal and malformed strings are reported as *Error.
+func FromNode(node *tree_sitter.Node, source []byte) (Value, error) {
+	span := position.NodeSpan(node)
+
+	if node.IsError() || node.IsMissing() {
+		return Value{}, &Error{Span: span, Message: fmt.Sprintf("invalid value %q", node.Utf8Text(source))}
+	}
+
+	switch node.Kind() {
+	case "null_literal":
+		return Value{Kind: Null, Span: span}, nil
+
+	case "boolean_literal":
+		return Value{Kind: Bool, Bool: node.Utf8Text(source) == "true", Span: span}, nil
+
+	case "number_literal":
+		return Value{Kind: Number, Number: Decimal(node.Utf8Text(source)), Span: span}, nil
+
+	case "string_literal":
+		text, err := decodeString(node, source)
+		if err != nil {
+			return Value{}, err
+		}
+		return Value{Kind: String, Text: text, Span: span}, nil
+
+	case "identifier_value":
+		return Value{Kind: Reference, Text: node.Utf8Text(source), Span: span}, nil
+
+	case "function_call":
+		name := node.ChildByFieldName("name")
+		if name == nil || hasErrorChild(node) {
+			return Value{}, &Error{Span: span, Message: fmt.Sprintf("invalid function call %q", node.Utf8Text(source))}
+		}
+		return Value{Kind: Call, Text: name.Utf8Text(source), Span: span}, nil
+
+	case "array_literal":
+		v := Value{Kind: Array, Items: []Value{}, Span: span}
+		for i := uint(0); i < node.NamedChildCount(); i++ {
+			child := node.NamedChild(i)
+			if isComment(child) {
+				continue
+			}
+			item, err := FromNode(child, source)
+			if err != nil {
+				return Value{}, err
+			}
+			v.Items = append(v.Items, item)
+		}
+		return v, nil
+
+	case "object_literal":
+		v := Value{Kind: Object, Entries: []Entry{}, Span: span}
+		for i := uint(0); i < node.NamedChildCount(); i++ {
+			child := node.NamedChild(i)
+			if isComment(child) {
+				continue
+			}
+			if child.Kind() != "object_entry" {
+				return Value{}, &Error{Span: position.NodeSpan(child), Message: fmt.Sprintf("invalid object entry %q", child.Utf8Text(source))}
+			}
+			entry, err := entryFromNode(child, source)
+			if err != nil {
+				return Value{}, err
+			}
+			v.Entries = append(v.Entries, entry)
+		}
+		return v, nil
+	}
+
+	return Value{}, &Error{Span: span, Message: fmt.Sprintf("%s is not a value", node.Kind())}
+}
+
+func entryFromNode(node *tree_sitter.Node, source []byte) (Entry, error) {
+	keyNode := node.ChildByFieldName("key")
+	valueNode := node.ChildByFieldName("value")
+	if keyNode == nil || valueNode == nil || hasErrorChild(node) {
+		return Entry{}, &Error{Span: position.NodeSpan(node), Message: fmt.Sprintf("invalid object entry %q", node.Utf8Text(source))}
+	}
+
+	key := keyNode.Utf8Text(source)
+	if keyNode.Kind() == "string_literal" {
+		decoded, err := decodeString(keyNode, source)
+		if err != nil {
+			return Entry{}, err
+		}
+		key = decoded
+	}
+
+	v, err := FromNode(valueNode, source)
+	if err != nil {
+		return Entry{}, err
+	}
+	return Entry{Key: key, KeySpan: position.NodeSpan(keyNode), Value: v}, nil
+}
+
+// isComment reports whether node is a comment. Error nodes produced by
+// recovery are also extras, so IsExtra alone cannot be used to skip comments.
+func isComment(node *tree_sitter.Node) bool {
+	return node.Kind() == "comment"
+}
+
+func hasErrorChild(node *tree_sitter.Node) bool {
+	for i := uint(0); i < node.ChildCount(); i++ {
+		if child := node.Child(i); child.IsError() || child.IsMissing() {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeString(node *tree_sitter.Node, source []byte) (string, error) {
+	text, err := literal.Decode(node, source)
+	if literalErr, ok := err.(*literal.Error); ok {
+		index := position.NewLineIndex(source)
+		point := index.Point(literalErr.Offset)
+		return "", &Error{
+			Span: position.Span{
+				StartByte: literalErr.Offset,
+				EndByte:   int(node.EndByte()),
+				Start:     point,
+				End:       node.EndPosition(),
+			},
+			Message: literalErr.Message,
+		}
+	}
+	return text, err
+}
+
+// String renders v as CDM source text. Objects and arrays are written on a
+// single line.
+func (v Value) String() string {
+	var b strings.Builder
+	v.writeCDM(&b)
+	return b.String()
+}
+
+func (v Value) writeCDM(b *strings.Builder) {
+	switch v.Kind {
+	case Null:
+		b.WriteString("null")
+	case Bool:
+		b.WriteString(strconv.FormatBool(v.Bool))
+	case Number:
+		b.WriteString(string(v.Number))
+	case String:
+		b.WriteString(literal.Quote(v.Text))
+	case Reference:
+		b.WriteString(v.Text)
+	case Call:
+		b.WriteString(v.Text)
+		b.WriteString("()")
+	case Array:
+		b.WriteByte('[')
+		for i, item := range v.Items {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			item.writeCDM(b)
+		}
+		b.WriteByte(']')
+	case Object:
+		if len(v.Entries) == 0 {
+			b.WriteString("{}")
+			return
+		}
+		b.WriteString("{ ")
+		for i, entry := range v.Entries {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			if isIdentifier(entry.Key) && !keywords[entry.Key] {
+				b.WriteString(entry.Key)
+			} else {
+				b.WriteString(literal.Quote(entry.Key))
+			}
+			b.WriteString(": ")
+			entry.Value.writeCDM(b)
+		}
+		b.WriteString(" }")
+	}
+}
+
+// Words the grammar lexes as keywords, which must be quoted as object keys.
+var keywords = map[string]bool{
+	"true": true, "false": true, "null": true,
+	"extends": true, "import": true, "from": true,
+}
+
+// isIdentifier reports whether s matches the grammar's identifier rule.
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, c := range []byte(s) {
+		switch {
+		case c == '_', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		case i > 0 && c >= '0' && c <= '9':
+		default:
+			return false
+		}
+	}
+	return true
+}
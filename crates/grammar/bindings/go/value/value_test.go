@@ -0,0 +1,285 @@
+package value_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	tree_sitter_cdm "github.com/larner-dev/cdm/bindings/go"
+	"github.com/larner-dev/cdm/bindings/go/value"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// parseConfig parses `@test <literal>` and returns the config value.
+func parseConfig(t *testing.T, literal string) (value.Value, error) {
+	t.Helper()
+
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	if err := parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_cdm.Language())); err != nil {
+		t.Fatalf("Error loading Contextual Data Models grammar: %v", err)
+	}
+
+	source := []byte("@test " + literal + "\n")
+	tree := parser.Parse(source, nil)
+	defer tree.Close()
+
+	plugin := tree.RootNode().NamedChild(0)
+	if plugin == nil || plugin.Kind() != "plugin_import" {
+		t.Fatalf("expected plugin_import, got %s", tree.RootNode().ToSexp())
+	}
+	config := plugin.ChildByFieldName("config")
+	if config == nil {
+		t.Fatalf("plugin_import has no config: %s", tree.RootNode().ToSexp())
+	}
+	return value.FromNode(config, source)
+}
+
+func mustParseConfig(t *testing.T, literal string) value.Value {
+	t.Helper()
+
+	v, err := parseConfig(t, literal)
+	if err != nil {
+		t.Fatalf("FromNode(%s) returned error: %v", literal, err)
+	}
+	return v
+}
+
+func TestFromNode(t *testing.T) {
+	v := mustParseConfig(t, `{
+  table: "users",
+  "quoted key": "café",
+  count: 3,
+  ratio: -0.25,
+  enabled: true,
+  parent: null,
+  input: User,
+  tags: ["a", 1, false],
+  nested: { deep: { x: 1 } }
+}`)
+
+	if v.Kind != value.Object {
+		t.Fatalf("Kind = %s, want object", v.Kind)
+	}
+	wantKeys := []string{"table", "quoted key", "count", "ratio", "enabled", "parent", "input", "tags", "nested"}
+	if got := v.Keys(); strings.Join(got, ",") != strings.Join(wantKeys, ",") {
+		t.Errorf("Keys() = %v, want %v", got, wantKeys)
+	}
+
+	if quoted, _ := v.Get("quoted key"); quoted.Text != "café" {
+		t.Errorf(`"quoted key" = %q, want "café"`, quoted.Text)
+	}
+	if ratio, _ := v.Get("ratio"); ratio.Kind != value.Number || ratio.Number != "-0.25" {
+		t.Errorf("ratio = %+v, want number -0.25", ratio)
+	}
+	if input, _ := v.Get("input"); input.Kind != value.Reference || input.Text != "User" {
+		t.Errorf("input = %+v, want reference User", input)
+	}
+	if tags, _ := v.Get("tags"); len(tags.Items) != 3 || tags.Items[2].Kind != value.Bool {
+		t.Errorf("tags = %+v, want three items ending in a boolean", tags)
+	}
+	nested, _ := v.Get("nested")
+	deep, _ := nested.Get("deep")
+	if x, ok := deep.Get("x"); !ok || x.Number != "1" {
+		t.Errorf("nested.deep.x = %+v, want 1", x)
+	}
+}
+
+func TestSpans(t *testing.T) {
+	v := mustParseConfig(t, "{\n  input: User,\n  name: \"x\"\n}")
+
+	input, _ := v.Get("input")
+	if input.Span.Start.Row != 1 || input.Span.Start.Column != 9 {
+		t.Errorf("input span starts at %+v, want row 1 column 9", input.Span.Start)
+	}
+	if got := v.Entries[1].KeySpan.Start; got.Row != 2 || got.Column != 2 {
+		t.Errorf("name key span starts at %+v, want row 2 column 2", got)
+	}
+}
+
+func TestExactNumbers(t *testing.T) {
+	v := mustParseConfig(t, `{ id: 9007199254740993, price: 19.99 }`)
+
+	id, _ := v.Get("id")
+	if n, ok := id.Number.Int64(); !ok || n != 9007199254740993 {
+		t.Errorf("id = %d, want 9007199254740993", n)
+	}
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	if want := `{"id":9007199254740993,"price":19.99}`; string(encoded) != want {
+		t.Errorf("Marshal = %s, want %s", encoded, want)
+	}
+	if !id.Number.IsInteger() || (value.Decimal("19.99")).IsInteger() {
+		t.Error("IsInteger returned the wrong answer")
+	}
+	if value.Decimal("1.50").Cmp("1.5") != 0 {
+		t.Error("1.50 and 1.5 should compare equal")
+	}
+}
+
+func TestMarshalNumbers(t *testing.T) {
+	for _, tt := range []struct {
+		number value.Decimal
+		want   string
+	}{
+		{"0", "0"},
+		{"007", "7"},
+		{"-00.50", "-0.50"},
+		{"1.", "1"},
+		{".5", "0.5"},
+		{"+3", "3"},
+		{"012e+05", "12e+05"},
+		{"1e+-5", ""},
+		{"abc", ""},
+		{"1e", ""},
+		{".", ""},
+		{`"1"`, ""},
+	} {
+		encoded, err := json.Marshal(value.Value{Kind: value.Number, Number: tt.number})
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("Marshal(%s) = %s, want an error", tt.number, encoded)
+			}
+			continue
+		}
+		if err != nil || string(encoded) != tt.want {
+			t.Errorf("Marshal(%s) = %s, %v; want %s", tt.number, encoded, err, tt.want)
+		}
+	}
+}
+
+func TestInvalidString(t *testing.T) {
+	_, err := parseConfig(t, `{ name: "bad \ud800" }`)
+	var valueErr *value.Error
+	if !errors.As(err, &valueErr) {
+		t.Fatalf("error = %v, want *value.Error", err)
+	}
+	if valueErr.Span.Start.Column != 19 {
+		t.Errorf("error column = %d, want 19", valueErr.Span.Start.Column)
+	}
+}
+
+func TestSyntaxError(t *testing.T) {
+	for _, literal := range []string{`{ name: "x", : 1 }`, `{ a: [1 2] }`} {
+		if _, err := parseConfig(t, literal); err == nil {
+			t.Errorf("expected an error for %s", literal)
+		}
+	}
+}
+
+func TestComments(t *testing.T) {
+	v := mustParseConfig(t, "{\n  // the table\n  table: \"users\",\n  tags: [\n    \"a\", // first\n    \"b\"\n  ]\n}")
+
+	if got := v.Keys(); len(got) != 2 {
+		t.Errorf("Keys() = %v, want [table tags]", got)
+	}
+	if tags, _ := v.Get("tags"); len(tags.Items) != 2 {
+		t.Errorf("tags = %+v, want two items", tags.Items)
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	input := `{"z":1,"a":[true,null,"s",1.000000000000000000001],"m":{"k":"v"}}`
+
+	v, err := value.FromJSON([]byte(input))
+	if err != nil {
+		t.Fatalf("FromJSON returned error: %v", err)
+	}
+	if got := v.Keys(); strings.Join(got, ",") != "z,a,m" {
+		t.Errorf("Keys() = %v, want [z a m]", got)
+	}
+
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	if string(encoded) != input {
+		t.Errorf("Marshal = %s, want %s", encoded, input)
+	}
+
+	var decoded value.Value
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	if !value.Equal(v, decoded) {
+		t.Error("round-tripped value differs")
+	}
+
+	if _, err := value.FromJSON([]byte(`{} {}`)); err == nil {
+		t.Error("FromJSON should reject trailing data")
+	}
+}
+
+func TestEqualIgnoresSpans(t *testing.T) {
+	fromSource := mustParseConfig(t, `{ a: 1.0, b: ["x"] }`)
+	fromJSON, err := value.FromJSON([]byte(`{"a":1,"b":["x"]}`))
+	if err != nil {
+		t.Fatalf("FromJSON returned error: %v", err)
+	}
+	if !value.Equal(fromSource, fromJSON) {
+		t.Error("values with equal content should be Equal")
+	}
+}
+
+func TestMerge(t *testing.T) {
+	base := mustParseConfig(t, `{ dialect: "postgres", naming: { tables: "snake_case", columns: "snake_case" }, tags: ["a", "b"] }`)
+	override := mustParseConfig(t, `{ naming: { columns: "camelCase" }, tags: ["c"], schema: "api" }`)
+	want := `{ dialect: "postgres", naming: { tables: "snake_case", columns: "camelCase" }, tags: ["c"], schema: "api" }`
+	if got := value.Merge(base, override).String(); got != want {
+		t.Errorf("Merge = %s, want %s", got, want)
+	}
+	if got := base.String(); !strings.Contains(got, `columns: "snake_case"`) {
+		t.Errorf("Merge changed its base: %s", got)
+	}
+}
+
+func TestStringRendersCDM(t *testing.T) {
+	v := mustParseConfig(t, `{ table: "users", "two words": [1, true], input: User, true: null }`)
+
+	want := `{ table: "users", "two words": [1, true], input: User, "true": null }`
+	if got := v.String(); got != want {
+		t.Errorf("String() = %s, want %s", got, want)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	v := mustParseConfig(t, `{ input: User, output: [Post, Missing] }`)
+
+	symbols := map[string]string{"User": "model", "Post": "model"}
+	bindings, err := value.Resolve(&v, func(name string) (string, bool) {
+		kind, ok := symbols[name]
+		return kind, ok
+	})
+
+	if len(bindings) != 2 || bindings[0].Reference.Text != "User" || bindings[1].Symbol != "model" {
+		t.Errorf("bindings = %+v, want User and Post", bindings)
+	}
+	var unresolved *value.UnresolvedError
+	if !errors.As(err, &unresolved) || unresolved.Reference.Text != "Missing" {
+		t.Fatalf("error = %v, want unresolved Missing", err)
+	}
+	if unresolved.Reference.Span.IsZero() {
+		t.Error("unresolved reference should carry its span")
+	}
+}
+
+func TestCall(t *testing.T) {
+	now := value.Value{Kind: value.Call, Text: "now"}
+
+	if got := now.String(); got != "now()" {
+		t.Errorf("String() = %s, want now()", got)
+	}
+	encoded, err := json.Marshal(now)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	if want := `{"kind":"call","name":"now"}`; string(encoded) != want {
+		t.Errorf("Marshal = %s, want %s", encoded, want)
+	}
+	if value.Equal(now, value.Value{Kind: value.Call, Text: "uuid"}) {
+		t.Error("calls to different functions should not be Equal")
+	}
+}
@@ -6,6 +6,7 @@
  * - External plugins: @analytics from "git:https://github.com/myorg/cdm-analytics.git" { }
  * - Local plugins: @custom from "./plugins/my-plugin" { }
  * - Template imports: import sql from "sql/postgres-types" { version: "^1.0.0" }
+ * - Selective imports: import { User, Email as AuthEmail } from "cdm/auth"
  * - Template extends: extends "cdm/auth" { version: "^2.0.0" }
  * - Qualified type references: sql.UUID, auth.types.Email
  * - Simple type aliases: Email: string
@@ -22,6 +23,12 @@
  * - Context extensions: extends "./base.cdm"
  * - Model removal: -ModelName
  * - Entity IDs: User { name: string #1 } #10
+ * - Function call defaults: created_at: string = now()
+ * - Generic models: Page<T> { items: T[] }, used as Page<User>
+ * - Model projections: UserPublic: pick User { id, name }
+ * - Inline object types: address: { street: string, city: string }
+ * - Literal types: priority: 1 | 2 | 3, enabled: true
+ * - Nullable types: bio: string | null
  *
  * Note: Model members (fields, plugin configs) must be on separate lines.
  * Single-line model definitions are not supported.
@@ -57,7 +64,12 @@ module.exports = grammar({
       ),
 
     _definition: ($) =>
-      choice($.model_removal, $.type_alias, $.model_definition),
+      choice(
+        $.model_removal,
+        $.model_projection,
+        $.type_alias,
+        $.model_definition
+      ),
 
     // Comments: // single line
     comment: ($) => /\/\/[^\n]*/,
@@ -89,20 +101,53 @@ module.exports = grammar({
     // =========================================================================
 
     // Template import: import <namespace> from "<source>" [{ config }]
+    // or import { Name, Name as Alias } from "<source>" [{ config }]
     // Examples:
     //   import sql from "sql/postgres-types"
     //   import auth from "cdm/auth" { version: "^2.0.0" }
     //   import custom from "git:https://github.com/org/repo.git" { git_ref: "v1.0.0" }
     //   import local from "./templates/shared"
+    //   import { User, Email as AuthEmail } from "cdm/auth"
     template_import: ($) =>
       seq(
         "import",
-        field("namespace", $.identifier),
+        choice(
+          field("namespace", $.identifier),
+          field("names", $.import_names)
+        ),
         "from",
         field("source", $.string_literal),
         optional(field("config", $.object_literal))
       ),
 
+    // Names listed in a selective import, separated by commas or newlines
+    import_names: ($) =>
+      seq(
+        "{",
+        optional($._nls),
+        optional(
+          seq(
+            field("name", $.import_name),
+            repeat(
+              seq(
+                choice(seq(",", optional($._nls)), $._nls),
+                field("name", $.import_name)
+              )
+            ),
+            optional(","),
+            optional($._nls)
+          )
+        ),
+        "}"
+      ),
+
+    // One selectively imported name, optionally renamed: Email as AuthEmail
+    import_name: ($) =>
+      seq(
+        field("name", $.identifier),
+        optional(seq("as", field("alias", $.identifier)))
+      ),
+
     // Extends directive: extends "<source>" [{ config }]
     // Unified syntax for both local files and templates
     // Examples:
@@ -154,11 +199,53 @@ module.exports = grammar({
         optional(field("id", $.entity_id))
       ),
 
+    // =========================================================================
+    // MODEL PROJECTIONS
+    // =========================================================================
+
+    // Projection: Name: pick|omit Source { fields } [{ plugins }] [#id]
+    // Derives a model from the listed fields of Source, or from all of its
+    // fields except the listed ones. Fields may be separated by commas or
+    // newlines.
+    // Examples:
+    //   UserPublic: pick User { id, name } #20
+    //   UserRow: omit User { password_hash } { @sql { skip: true } }
+    model_projection: ($) =>
+      seq(
+        field("name", $.identifier),
+        ":",
+        field("operator", choice("pick", "omit")),
+        field("source", $.identifier),
+        field("fields", $.projection_fields),
+        optional(field("plugins", $.plugin_block)),
+        optional(field("id", $.entity_id))
+      ),
+
+    projection_fields: ($) =>
+      seq(
+        "{",
+        optional($._nls),
+        optional(
+          seq(
+            field("field", $.identifier),
+            repeat(
+              seq(
+                choice(seq(",", optional($._nls)), $._nls),
+                field("field", $.identifier)
+              )
+            ),
+            optional(","),
+            optional($._nls)
+          )
+        ),
+        "}"
+      ),
+
     // =========================================================================
     // MODEL DEFINITIONS
     // =========================================================================
 
-    // Model: Name [extends Parents] { members } [#id]
+    // Model: Name [<Params>] [extends Parents] { members } [#id]
     // Examples:
     //   User {
     //     name: string
@@ -169,11 +256,15 @@ module.exports = grammar({
     //   Article extends Timestamped {
     //     title: string
     //   } #11
+    //   Page<T> {
+    //     items: T[]
+    //   } #12
     //
     // Note: Model members must be on separate lines
     model_definition: ($) =>
       seq(
         field("name", $.identifier),
+        optional(field("parameters", $.type_parameters)),
         optional(field("extends", $.extends_clause)),
         field("body", $.model_body),
         optional(field("id", $.entity_id))
@@ -182,6 +273,10 @@ module.exports = grammar({
     extends_clause: ($) =>
       seq("extends", sep1(",", field("parent", $.identifier))),
 
+    // Type parameters of a generic model: Page<T>, Pair<A, B>
+    type_parameters: ($) =>
+      seq("<", sep1(",", field("parameter", $.identifier)), ">"),
+
     // Model body requires newlines between members
     // Empty models are allowed: User {}
     // Models with members require each on its own line:
@@ -256,6 +351,7 @@ module.exports = grammar({
     //   status: "draft" | "published" = "draft" #8 (inline union with default and ID)
     //   content: string { @sql { type: "TEXT" } } #9  (with plugins and ID)
     //   average_rating: decimal { @computed { from: "AVG(reviews.rating)" } } #10
+    //   created_at: string = now() #11         (function call default with ID)
     field_definition: ($) =>
       prec(
         1,
@@ -282,10 +378,13 @@ module.exports = grammar({
         $.boolean_literal,
         $.null_literal,
         $.array_literal,
-        $.object_literal
+        $.object_literal,
+        $.function_call
       ),
 
-    // Function call for defaults: now()
+    // Function call for defaults: now(), uuid(), autoincrement()
+    // Calls take no arguments; the function is evaluated when a record is
+    // created, so generators emit it as e.g. DEFAULT now()
     function_call: ($) => seq(field("name", $.identifier), "(", ")"),
 
     // =========================================================================
@@ -297,13 +396,17 @@ module.exports = grammar({
         $.union_type,
         $.map_type,
         $.array_type,
+        $.generic_type,
+        $.object_type,
         $.type_identifier,
         $.string_literal,
-        $.number_literal
+        $.number_literal,
+        $.boolean_literal
       ),
 
-    // Union type: "a" | "b" | "c" or Type1 | Type2 | "literal"
-    // Supports both string literals and type references
+    // Union type: "a" | "b" | "c", 1 | 2 | 3 or Type1 | Type2 | "literal"
+    // Supports string, number and boolean literals and type references;
+    // a null member makes the type nullable: string | null
     union_type: ($) =>
       prec.left(1, seq($._union_member, repeat1(seq("|", $._union_member)))),
 
@@ -311,11 +414,47 @@ module.exports = grammar({
       choice(
         $.string_literal,
         $.number_literal,
+        $.boolean_literal,
+        $.null_literal,
         $.map_type,
         $.array_type,
+        $.generic_type,
+        $.object_type,
         $.type_identifier
       ),
 
+    // Instantiation of a generic model: Page<User>, Pair<string, Post[]>
+    generic_type: ($) =>
+      seq(
+        field("name", $.type_identifier),
+        "<",
+        sep1(",", field("argument", $._type_expression)),
+        ">"
+      ),
+
+    // Inline object type: { street: string, city: string }
+    // Members are field definitions, separated by commas or newlines, and
+    // may carry defaults, plugin blocks and entity IDs.
+    object_type: ($) =>
+      seq(
+        "{",
+        optional($._nls),
+        optional(
+          seq(
+            field("field", $.field_definition),
+            repeat(
+              seq(
+                choice(seq(",", optional($._nls)), $._nls),
+                field("field", $.field_definition)
+              )
+            ),
+            optional(","),
+            optional($._nls)
+          )
+        ),
+        "}"
+      ),
+
     // Type identifier: simple name or qualified name (namespace.Type)
     // Examples: string, User, sql.UUID, auth.types.Email
     type_identifier: ($) =>
@@ -335,7 +474,14 @@ module.exports = grammar({
       choice($.qualified_identifier, $.identifier),
 
     // Base type for map/array value (can be nested map, array, or simple identifier)
-    _base_type: ($) => choice($.map_type, $.array_type, $.type_identifier),
+    _base_type: ($) =>
+      choice(
+        $.map_type,
+        $.array_type,
+        $.generic_type,
+        $.object_type,
+        $.type_identifier
+      ),
 
     // Map type: ValueType[KeyType]
     // Examples: User[string], Prize[1 | 2 | 3], string[string][Locale]
@@ -7,16 +7,16 @@
 #endif
 
 #define LANGUAGE_VERSION 15
-#define STATE_COUNT 201
+#define STATE_COUNT 478
 #define LARGE_STATE_COUNT 2
-#define SYMBOL_COUNT 77
+#define SYMBOL_COUNT 97
 #define ALIAS_COUNT 0
-#define TOKEN_COUNT 28
+#define TOKEN_COUNT 35
 #define EXTERNAL_TOKEN_COUNT 0
-#define FIELD_COUNT 16
+#define FIELD_COUNT 24
 #define MAX_ALIAS_SEQUENCE_LENGTH 8
 #define MAX_RESERVED_WORD_SET_SIZE 0
-#define PRODUCTION_ID_COUNT 40
+#define PRODUCTION_ID_COUNT 67
 #define SUPERTYPE_COUNT 0
 
 enum ts_symbol_identifiers {
@@ -26,76 +26,96 @@ enum ts_symbol_identifiers {
   anon_sym_AT = 4,
   anon_sym_from = 5,
   anon_sym_import = 6,
-  anon_sym_extends = 7,
-  anon_sym_DASH = 8,
-  anon_sym_POUND = 9,
-  aux_sym_entity_id_token1 = 10,
-  anon_sym_COLON = 11,
-  anon_sym_COMMA = 12,
-  anon_sym_LBRACE = 13,
-  anon_sym_RBRACE = 14,
-  anon_sym_QMARK = 15,
-  anon_sym_EQ = 16,
-  anon_sym_PIPE = 17,
-  anon_sym_DOT = 18,
-  anon_sym_LBRACK = 19,
-  anon_sym_RBRACK = 20,
-  anon_sym_DQUOTE = 21,
-  sym_string_content = 22,
-  sym_escape_sequence = 23,
-  sym_number_literal = 24,
-  anon_sym_true = 25,
-  anon_sym_false = 26,
-  sym_null_literal = 27,
-  sym_source_file = 28,
-  sym__directive = 29,
-  sym__definition = 30,
-  aux_sym__nls = 31,
-  sym_plugin_import = 32,
-  sym_template_import = 33,
-  sym_extends_template = 34,
-  sym_model_removal = 35,
-  sym_entity_id = 36,
-  sym_type_alias = 37,
-  sym_model_definition = 38,
-  sym_extends_clause = 39,
-  sym_model_body = 40,
-  sym__model_member = 41,
-  sym_field_removal = 42,
-  sym_field_override = 43,
-  sym_field_definition = 44,
-  sym__default_value = 45,
-  sym__type_expression = 46,
-  sym_union_type = 47,
-  sym__union_member = 48,
-  sym_type_identifier = 49,
-  sym_qualified_identifier = 50,
-  sym__qualified_name_rest = 51,
-  sym__base_type = 52,
-  sym_map_type = 53,
-  sym__key_type_expression = 54,
-  sym_key_union_type = 55,
-  sym__key_union_member = 56,
-  sym_array_type = 57,
-  sym__value = 58,
-  sym_identifier_value = 59,
-  sym_array_literal = 60,
-  sym_object_literal = 61,
-  sym_object_entry = 62,
-  sym_plugin_block = 63,
-  sym_plugin_config = 64,
-  sym_string_literal = 65,
-  sym_boolean_literal = 66,
-  aux_sym_source_file_repeat1 = 67,
-  aux_sym_source_file_repeat2 = 68,
-  aux_sym_extends_clause_repeat1 = 69,
-  aux_sym_model_body_repeat1 = 70,
-  aux_sym_union_type_repeat1 = 71,
-  aux_sym_key_union_type_repeat1 = 72,
-  aux_sym_array_literal_repeat1 = 73,
-  aux_sym_object_literal_repeat1 = 74,
-  aux_sym_plugin_block_repeat1 = 75,
-  aux_sym_string_literal_repeat1 = 76,
+  anon_sym_LBRACE = 7,
+  anon_sym_COMMA = 8,
+  anon_sym_RBRACE = 9,
+  anon_sym_as = 10,
+  anon_sym_extends = 11,
+  anon_sym_DASH = 12,
+  anon_sym_POUND = 13,
+  aux_sym_entity_id_token1 = 14,
+  anon_sym_COLON = 15,
+  anon_sym_pick = 16,
+  anon_sym_omit = 17,
+  anon_sym_LT = 18,
+  anon_sym_GT = 19,
+  anon_sym_QMARK = 20,
+  anon_sym_EQ = 21,
+  anon_sym_LPAREN = 22,
+  anon_sym_RPAREN = 23,
+  anon_sym_PIPE = 24,
+  anon_sym_DOT = 25,
+  anon_sym_LBRACK = 26,
+  anon_sym_RBRACK = 27,
+  anon_sym_DQUOTE = 28,
+  sym_string_content = 29,
+  sym_escape_sequence = 30,
+  sym_number_literal = 31,
+  anon_sym_true = 32,
+  anon_sym_false = 33,
+  sym_null_literal = 34,
+  sym_source_file = 35,
+  sym__directive = 36,
+  sym__definition = 37,
+  aux_sym__nls = 38,
+  sym_plugin_import = 39,
+  sym_template_import = 40,
+  sym_import_names = 41,
+  sym_import_name = 42,
+  sym_extends_template = 43,
+  sym_model_removal = 44,
+  sym_entity_id = 45,
+  sym_type_alias = 46,
+  sym_model_projection = 47,
+  sym_projection_fields = 48,
+  sym_model_definition = 49,
+  sym_extends_clause = 50,
+  sym_type_parameters = 51,
+  sym_model_body = 52,
+  sym__model_member = 53,
+  sym_field_removal = 54,
+  sym_field_override = 55,
+  sym_field_definition = 56,
+  sym__default_value = 57,
+  sym_function_call = 58,
+  sym__type_expression = 59,
+  sym_union_type = 60,
+  sym__union_member = 61,
+  sym_generic_type = 62,
+  sym_object_type = 63,
+  sym_type_identifier = 64,
+  sym_qualified_identifier = 65,
+  sym__qualified_name_rest = 66,
+  sym__base_type = 67,
+  sym_map_type = 68,
+  sym__key_type_expression = 69,
+  sym_key_union_type = 70,
+  sym__key_union_member = 71,
+  sym_array_type = 72,
+  sym__value = 73,
+  sym_identifier_value = 74,
+  sym_array_literal = 75,
+  sym_object_literal = 76,
+  sym_object_entry = 77,
+  sym_plugin_block = 78,
+  sym_plugin_config = 79,
+  sym_string_literal = 80,
+  sym_boolean_literal = 81,
+  aux_sym_source_file_repeat1 = 82,
+  aux_sym_source_file_repeat2 = 83,
+  aux_sym_import_names_repeat1 = 84,
+  aux_sym_projection_fields_repeat1 = 85,
+  aux_sym_extends_clause_repeat1 = 86,
+  aux_sym_type_parameters_repeat1 = 87,
+  aux_sym_model_body_repeat1 = 88,
+  aux_sym_union_type_repeat1 = 89,
+  aux_sym_generic_type_repeat1 = 90,
+  aux_sym_object_type_repeat1 = 91,
+  aux_sym_key_union_type_repeat1 = 92,
+  aux_sym_array_literal_repeat1 = 93,
+  aux_sym_object_literal_repeat1 = 94,
+  aux_sym_plugin_block_repeat1 = 95,
+  aux_sym_string_literal_repeat1 = 96,
 };
 
 static const char * const ts_symbol_names[] = {
@@ -106,16 +126,23 @@ static const char * const ts_symbol_names[] = {
   [anon_sym_AT] = "@",
   [anon_sym_from] = "from",
   [anon_sym_import] = "import",
+  [anon_sym_LBRACE] = "{",
+  [anon_sym_COMMA] = ",",
+  [anon_sym_RBRACE] = "}",
+  [anon_sym_as] = "as",
   [anon_sym_extends] = "extends",
   [anon_sym_DASH] = "-",
   [anon_sym_POUND] = "#",
   [aux_sym_entity_id_token1] = "entity_id_token1",
   [anon_sym_COLON] = ":",
-  [anon_sym_COMMA] = ",",
-  [anon_sym_LBRACE] = "{",
-  [anon_sym_RBRACE] = "}",
+  [anon_sym_pick] = "pick",
+  [anon_sym_omit] = "omit",
+  [anon_sym_LT] = "<",
+  [anon_sym_GT] = ">",
   [anon_sym_QMARK] = "\?",
   [anon_sym_EQ] = "=",
+  [anon_sym_LPAREN] = "(",
+  [anon_sym_RPAREN] = ")",
   [anon_sym_PIPE] = "|",
   [anon_sym_DOT] = ".",
   [anon_sym_LBRACK] = "[",
@@ -133,21 +160,29 @@ static const char * const ts_symbol_names[] = {
   [aux_sym__nls] = "_nls",
   [sym_plugin_import] = "plugin_import",
   [sym_template_import] = "template_import",
+  [sym_import_names] = "import_names",
+  [sym_import_name] = "import_name",
   [sym_extends_template] = "extends_template",
   [sym_model_removal] = "model_removal",
   [sym_entity_id] = "entity_id",
   [sym_type_alias] = "type_alias",
+  [sym_model_projection] = "model_projection",
+  [sym_projection_fields] = "projection_fields",
   [sym_model_definition] = "model_definition",
   [sym_extends_clause] = "extends_clause",
+  [sym_type_parameters] = "type_parameters",
   [sym_model_body] = "model_body",
   [sym__model_member] = "_model_member",
   [sym_field_removal] = "field_removal",
   [sym_field_override] = "field_override",
   [sym_field_definition] = "field_definition",
   [sym__default_value] = "_default_value",
+  [sym_function_call] = "function_call",
   [sym__type_expression] = "_type_expression",
   [sym_union_type] = "union_type",
   [sym__union_member] = "_union_member",
+  [sym_generic_type] = "generic_type",
+  [sym_object_type] = "object_type",
   [sym_type_identifier] = "type_identifier",
   [sym_qualified_identifier] = "qualified_identifier",
   [sym__qualified_name_rest] = "_qualified_name_rest",
@@ -168,9 +203,14 @@ static const char * const ts_symbol_names[] = {
   [sym_boolean_literal] = "boolean_literal",
   [aux_sym_source_file_repeat1] = "source_file_repeat1",
   [aux_sym_source_file_repeat2] = "source_file_repeat2",
+  [aux_sym_import_names_repeat1] = "import_names_repeat1",
+  [aux_sym_projection_fields_repeat1] = "projection_fields_repeat1",
   [aux_sym_extends_clause_repeat1] = "extends_clause_repeat1",
+  [aux_sym_type_parameters_repeat1] = "type_parameters_repeat1",
   [aux_sym_model_body_repeat1] = "model_body_repeat1",
   [aux_sym_union_type_repeat1] = "union_type_repeat1",
+  [aux_sym_generic_type_repeat1] = "generic_type_repeat1",
+  [aux_sym_object_type_repeat1] = "object_type_repeat1",
   [aux_sym_key_union_type_repeat1] = "key_union_type_repeat1",
   [aux_sym_array_literal_repeat1] = "array_literal_repeat1",
   [aux_sym_object_literal_repeat1] = "object_literal_repeat1",
@@ -186,16 +226,23 @@ static const TSSymbol ts_symbol_map[] = {
   [anon_sym_AT] = anon_sym_AT,
   [anon_sym_from] = anon_sym_from,
   [anon_sym_import] = anon_sym_import,
+  [anon_sym_LBRACE] = anon_sym_LBRACE,
+  [anon_sym_COMMA] = anon_sym_COMMA,
+  [anon_sym_RBRACE] = anon_sym_RBRACE,
+  [anon_sym_as] = anon_sym_as,
   [anon_sym_extends] = anon_sym_extends,
   [anon_sym_DASH] = anon_sym_DASH,
   [anon_sym_POUND] = anon_sym_POUND,
   [aux_sym_entity_id_token1] = aux_sym_entity_id_token1,
   [anon_sym_COLON] = anon_sym_COLON,
-  [anon_sym_COMMA] = anon_sym_COMMA,
-  [anon_sym_LBRACE] = anon_sym_LBRACE,
-  [anon_sym_RBRACE] = anon_sym_RBRACE,
+  [anon_sym_pick] = anon_sym_pick,
+  [anon_sym_omit] = anon_sym_omit,
+  [anon_sym_LT] = anon_sym_LT,
+  [anon_sym_GT] = anon_sym_GT,
   [anon_sym_QMARK] = anon_sym_QMARK,
   [anon_sym_EQ] = anon_sym_EQ,
+  [anon_sym_LPAREN] = anon_sym_LPAREN,
+  [anon_sym_RPAREN] = anon_sym_RPAREN,
   [anon_sym_PIPE] = anon_sym_PIPE,
   [anon_sym_DOT] = anon_sym_DOT,
   [anon_sym_LBRACK] = anon_sym_LBRACK,
@@ -213,21 +260,29 @@ static const TSSymbol ts_symbol_map[] = {
   [aux_sym__nls] = aux_sym__nls,
   [sym_plugin_import] = sym_plugin_import,
   [sym_template_import] = sym_template_import,
+  [sym_import_names] = sym_import_names,
+  [sym_import_name] = sym_import_name,
   [sym_extends_template] = sym_extends_template,
   [sym_model_removal] = sym_model_removal,
   [sym_entity_id] = sym_entity_id,
   [sym_type_alias] = sym_type_alias,
+  [sym_model_projection] = sym_model_projection,
+  [sym_projection_fields] = sym_projection_fields,
   [sym_model_definition] = sym_model_definition,
   [sym_extends_clause] = sym_extends_clause,
+  [sym_type_parameters] = sym_type_parameters,
   [sym_model_body] = sym_model_body,
   [sym__model_member] = sym__model_member,
   [sym_field_removal] = sym_field_removal,
   [sym_field_override] = sym_field_override,
   [sym_field_definition] = sym_field_definition,
   [sym__default_value] = sym__default_value,
+  [sym_function_call] = sym_function_call,
   [sym__type_expression] = sym__type_expression,
   [sym_union_type] = sym_union_type,
   [sym__union_member] = sym__union_member,
+  [sym_generic_type] = sym_generic_type,
+  [sym_object_type] = sym_object_type,
   [sym_type_identifier] = sym_type_identifier,
   [sym_qualified_identifier] = sym_qualified_identifier,
   [sym__qualified_name_rest] = sym__qualified_name_rest,
@@ -248,9 +303,14 @@ static const TSSymbol ts_symbol_map[] = {
   [sym_boolean_literal] = sym_boolean_literal,
   [aux_sym_source_file_repeat1] = aux_sym_source_file_repeat1,
   [aux_sym_source_file_repeat2] = aux_sym_source_file_repeat2,
+  [aux_sym_import_names_repeat1] = aux_sym_import_names_repeat1,
+  [aux_sym_projection_fields_repeat1] = aux_sym_projection_fields_repeat1,
   [aux_sym_extends_clause_repeat1] = aux_sym_extends_clause_repeat1,
+  [aux_sym_type_parameters_repeat1] = aux_sym_type_parameters_repeat1,
   [aux_sym_model_body_repeat1] = aux_sym_model_body_repeat1,
   [aux_sym_union_type_repeat1] = aux_sym_union_type_repeat1,
+  [aux_sym_generic_type_repeat1] = aux_sym_generic_type_repeat1,
+  [aux_sym_object_type_repeat1] = aux_sym_object_type_repeat1,
   [aux_sym_key_union_type_repeat1] = aux_sym_key_union_type_repeat1,
   [aux_sym_array_literal_repeat1] = aux_sym_array_literal_repeat1,
   [aux_sym_object_literal_repeat1] = aux_sym_object_literal_repeat1,
@@ -287,6 +347,22 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = false,
   },
+  [anon_sym_LBRACE] = {
+    .visible = true,
+    .named = false,
+  },
+  [anon_sym_COMMA] = {
+    .visible = true,
+    .named = false,
+  },
+  [anon_sym_RBRACE] = {
+    .visible = true,
+    .named = false,
+  },
+  [anon_sym_as] = {
+    .visible = true,
+    .named = false,
+  },
   [anon_sym_extends] = {
     .visible = true,
     .named = false,
@@ -307,15 +383,19 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = false,
   },
-  [anon_sym_COMMA] = {
+  [anon_sym_pick] = {
     .visible = true,
     .named = false,
   },
-  [anon_sym_LBRACE] = {
+  [anon_sym_omit] = {
     .visible = true,
     .named = false,
   },
-  [anon_sym_RBRACE] = {
+  [anon_sym_LT] = {
+    .visible = true,
+    .named = false,
+  },
+  [anon_sym_GT] = {
     .visible = true,
     .named = false,
   },
@@ -327,6 +407,14 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = false,
   },
+  [anon_sym_LPAREN] = {
+    .visible = true,
+    .named = false,
+  },
+  [anon_sym_RPAREN] = {
+    .visible = true,
+    .named = false,
+  },
   [anon_sym_PIPE] = {
     .visible = true,
     .named = false,
@@ -395,6 +483,14 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = true,
   },
+  [sym_import_names] = {
+    .visible = true,
+    .named = true,
+  },
+  [sym_import_name] = {
+    .visible = true,
+    .named = true,
+  },
   [sym_extends_template] = {
     .visible = true,
     .named = true,
@@ -411,6 +507,14 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = true,
   },
+  [sym_model_projection] = {
+    .visible = true,
+    .named = true,
+  },
+  [sym_projection_fields] = {
+    .visible = true,
+    .named = true,
+  },
   [sym_model_definition] = {
     .visible = true,
     .named = true,
@@ -419,6 +523,10 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = true,
   },
+  [sym_type_parameters] = {
+    .visible = true,
+    .named = true,
+  },
   [sym_model_body] = {
     .visible = true,
     .named = true,
@@ -443,6 +551,10 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = false,
     .named = true,
   },
+  [sym_function_call] = {
+    .visible = true,
+    .named = true,
+  },
   [sym__type_expression] = {
     .visible = false,
     .named = true,
@@ -455,6 +567,14 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = false,
     .named = true,
   },
+  [sym_generic_type] = {
+    .visible = true,
+    .named = true,
+  },
+  [sym_object_type] = {
+    .visible = true,
+    .named = true,
+  },
   [sym_type_identifier] = {
     .visible = true,
     .named = true,
@@ -535,10 +655,22 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = false,
     .named = false,
   },
+  [aux_sym_import_names_repeat1] = {
+    .visible = false,
+    .named = false,
+  },
+  [aux_sym_projection_fields_repeat1] = {
+    .visible = false,
+    .named = false,
+  },
   [aux_sym_extends_clause_repeat1] = {
     .visible = false,
     .named = false,
   },
+  [aux_sym_type_parameters_repeat1] = {
+    .visible = false,
+    .named = false,
+  },
   [aux_sym_model_body_repeat1] = {
     .visible = false,
     .named = false,
@@ -547,6 +679,14 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = false,
     .named = false,
   },
+  [aux_sym_generic_type_repeat1] = {
+    .visible = false,
+    .named = false,
+  },
+  [aux_sym_object_type_repeat1] = {
+    .visible = false,
+    .named = false,
+  },
   [aux_sym_key_union_type_repeat1] = {
     .visible = false,
     .named = false,
@@ -570,36 +710,52 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
 };
 
 enum ts_field_identifiers {
-  field_body = 1,
-  field_config = 2,
-  field_default = 3,
-  field_extends = 4,
-  field_id = 5,
-  field_key = 6,
-  field_key_type = 7,
-  field_name = 8,
-  field_namespace = 9,
-  field_optional = 10,
-  field_parent = 11,
-  field_plugins = 12,
-  field_source = 13,
-  field_type = 14,
-  field_value = 15,
-  field_value_type = 16,
+  field_alias = 1,
+  field_argument = 2,
+  field_body = 3,
+  field_config = 4,
+  field_default = 5,
+  field_extends = 6,
+  field_field = 7,
+  field_fields = 8,
+  field_id = 9,
+  field_key = 10,
+  field_key_type = 11,
+  field_name = 12,
+  field_names = 13,
+  field_namespace = 14,
+  field_operator = 15,
+  field_optional = 16,
+  field_parameter = 17,
+  field_parameters = 18,
+  field_parent = 19,
+  field_plugins = 20,
+  field_source = 21,
+  field_type = 22,
+  field_value = 23,
+  field_value_type = 24,
 };
 
 static const char * const ts_field_names[] = {
   [0] = NULL,
+  [field_alias] = "alias",
+  [field_argument] = "argument",
   [field_body] = "body",
   [field_config] = "config",
   [field_default] = "default",
   [field_extends] = "extends",
+  [field_field] = "field",
+  [field_fields] = "fields",
   [field_id] = "id",
   [field_key] = "key",
   [field_key_type] = "key_type",
   [field_name] = "name",
+  [field_names] = "names",
   [field_namespace] = "namespace",
+  [field_operator] = "operator",
   [field_optional] = "optional",
+  [field_parameter] = "parameter",
+  [field_parameters] = "parameters",
   [field_parent] = "parent",
   [field_plugins] = "plugins",
   [field_source] = "source",
@@ -609,194 +765,319 @@ static const char * const ts_field_names[] = {
 };
 
 static const TSMapSlice ts_field_map_slices[PRODUCTION_ID_COUNT] = {
-  [1] = {.index = 0, .length = 1},
-  [2] = {.index = 1, .length = 1},
-  [3] = {.index = 2, .length = 2},
-  [4] = {.index = 4, .length = 2},
-  [5] = {.index = 6, .length = 2},
-  [6] = {.index = 8, .length = 1},
-  [7] = {.index = 9, .length = 2},
-  [8] = {.index = 11, .length = 1},
-  [9] = {.index = 12, .length = 3},
-  [10] = {.index = 15, .length = 3},
-  [11] = {.index = 18, .length = 2},
-  [12] = {.index = 20, .length = 2},
-  [13] = {.index = 22, .length = 2},
-  [14] = {.index = 24, .length = 3},
-  [15] = {.index = 27, .length = 3},
-  [16] = {.index = 30, .length = 2},
-  [17] = {.index = 32, .length = 2},
-  [18] = {.index = 34, .length = 2},
+  [1] = {.index = 0, .length = 2},
+  [2] = {.index = 2, .length = 1},
+  [3] = {.index = 3, .length = 1},
+  [4] = {.index = 4, .length = 1},
+  [5] = {.index = 5, .length = 1},
+  [6] = {.index = 6, .length = 2},
+  [7] = {.index = 8, .length = 3},
+  [8] = {.index = 11, .length = 3},
+  [9] = {.index = 14, .length = 3},
+  [10] = {.index = 17, .length = 2},
+  [11] = {.index = 19, .length = 2},
+  [12] = {.index = 21, .length = 2},
+  [13] = {.index = 23, .length = 2},
+  [14] = {.index = 25, .length = 2},
+  [15] = {.index = 27, .length = 2},
+  [16] = {.index = 29, .length = 3},
+  [17] = {.index = 32, .length = 3},
+  [18] = {.index = 35, .length = 1},
   [19] = {.index = 36, .length = 4},
-  [20] = {.index = 40, .length = 3},
-  [21] = {.index = 43, .length = 3},
-  [22] = {.index = 46, .length = 2},
-  [23] = {.index = 48, .length = 2},
-  [24] = {.index = 50, .length = 4},
+  [20] = {.index = 40, .length = 4},
+  [21] = {.index = 44, .length = 4},
+  [22] = {.index = 48, .length = 2},
+  [23] = {.index = 50, .length = 2},
+  [24] = {.index = 52, .length = 2},
   [25] = {.index = 54, .length = 3},
   [26] = {.index = 57, .length = 2},
   [27] = {.index = 59, .length = 2},
-  [28] = {.index = 61, .length = 3},
-  [29] = {.index = 64, .length = 3},
-  [30] = {.index = 67, .length = 4},
-  [31] = {.index = 71, .length = 4},
-  [32] = {.index = 75, .length = 4},
-  [33] = {.index = 79, .length = 4},
-  [34] = {.index = 83, .length = 4},
-  [35] = {.index = 87, .length = 5},
-  [36] = {.index = 92, .length = 5},
-  [37] = {.index = 97, .length = 5},
-  [38] = {.index = 102, .length = 5},
-  [39] = {.index = 107, .length = 6},
+  [28] = {.index = 61, .length = 1},
+  [29] = {.index = 62, .length = 4},
+  [30] = {.index = 66, .length = 4},
+  [31] = {.index = 70, .length = 2},
+  [32] = {.index = 72, .length = 2},
+  [33] = {.index = 74, .length = 5},
+  [34] = {.index = 79, .length = 3},
+  [35] = {.index = 82, .length = 3},
+  [36] = {.index = 85, .length = 2},
+  [37] = {.index = 87, .length = 1},
+  [38] = {.index = 88, .length = 2},
+  [39] = {.index = 90, .length = 2},
+  [40] = {.index = 92, .length = 3},
+  [41] = {.index = 95, .length = 3},
+  [42] = {.index = 98, .length = 1},
+  [43] = {.index = 99, .length = 2},
+  [44] = {.index = 101, .length = 2},
+  [45] = {.index = 103, .length = 5},
+  [46] = {.index = 108, .length = 5},
+  [47] = {.index = 113, .length = 2},
+  [48] = {.index = 115, .length = 2},
+  [49] = {.index = 117, .length = 2},
+  [50] = {.index = 119, .length = 2},
+  [51] = {.index = 121, .length = 3},
+  [52] = {.index = 124, .length = 4},
+  [53] = {.index = 128, .length = 4},
+  [54] = {.index = 132, .length = 2},
+  [55] = {.index = 134, .length = 6},
+  [56] = {.index = 140, .length = 1},
+  [57] = {.index = 141, .length = 3},
+  [58] = {.index = 144, .length = 2},
+  [59] = {.index = 146, .length = 4},
+  [60] = {.index = 150, .length = 4},
+  [61] = {.index = 154, .length = 4},
+  [62] = {.index = 158, .length = 5},
+  [63] = {.index = 163, .length = 5},
+  [64] = {.index = 168, .length = 5},
+  [65] = {.index = 173, .length = 5},
+  [66] = {.index = 178, .length = 6},
 };
 
 static const TSFieldMapEntry ts_field_map_entries[] = {
   [0] =
-    {field_name, 1},
-  [1] =
-    {field_source, 1},
-  [2] =
     {field_body, 1},
     {field_name, 0},
-  [4] =
-    {field_config, 2},
+  [2] =
     {field_name, 1},
-  [6] =
-    {field_config, 2},
+  [3] =
     {field_source, 1},
-  [8] =
+  [4] =
+    {field_name, 0},
+  [5] =
     {field_parent, 1},
-  [9] =
+  [6] =
     {field_name, 0},
     {field_type, 2},
-  [11] =
-    {field_name, 0},
-  [12] =
+  [8] =
     {field_body, 2},
     {field_extends, 1},
     {field_name, 0},
-  [15] =
+  [11] =
+    {field_body, 2},
+    {field_name, 0},
+    {field_parameters, 1},
+  [14] =
     {field_body, 1},
     {field_id, 2},
     {field_name, 0},
-  [18] =
+  [17] =
+    {field_config, 2},
     {field_name, 1},
-    {field_source, 3},
-  [20] =
-    {field_namespace, 1},
-    {field_source, 3},
-  [22] =
+  [19] =
+    {field_config, 2},
+    {field_source, 1},
+  [21] =
+    {field_name, 0},
+    {field_optional, 1},
+  [23] =
+    {field_id, 1},
+    {field_name, 0},
+  [25] =
+    {field_name, 0},
+    {field_plugins, 1},
+  [27] =
     {field_parent, 1},
     {field_parent, 2, .inherited = true},
-  [24] =
+  [29] =
     {field_id, 3},
     {field_name, 0},
     {field_type, 2},
-  [27] =
+  [32] =
     {field_name, 0},
     {field_plugins, 3},
     {field_type, 2},
-  [30] =
-    {field_name, 0},
-    {field_optional, 1},
-  [32] =
-    {field_id, 1},
-    {field_name, 0},
-  [34] =
-    {field_name, 0},
-    {field_plugins, 1},
+  [35] =
+    {field_parameter, 1},
   [36] =
     {field_body, 2},
     {field_extends, 1},
     {field_id, 3},
     {field_name, 0},
   [40] =
-    {field_config, 4},
+    {field_body, 3},
+    {field_extends, 2},
+    {field_name, 0},
+    {field_parameters, 1},
+  [44] =
+    {field_body, 2},
+    {field_id, 3},
+    {field_name, 0},
+    {field_parameters, 1},
+  [48] =
     {field_name, 1},
     {field_source, 3},
-  [43] =
-    {field_config, 4},
+  [50] =
     {field_namespace, 1},
     {field_source, 3},
-  [46] =
+  [52] =
+    {field_names, 1},
+    {field_source, 3},
+  [54] =
+    {field_id, 2},
+    {field_name, 0},
+    {field_optional, 1},
+  [57] =
     {field_parent, 0, .inherited = true},
     {field_parent, 1, .inherited = true},
-  [48] =
+  [59] =
     {field_name, 2},
     {field_namespace, 0},
-  [50] =
+  [61] =
+    {field_field, 1},
+  [62] =
+    {field_fields, 4},
+    {field_name, 0},
+    {field_operator, 2},
+    {field_source, 3},
+  [66] =
     {field_id, 4},
     {field_name, 0},
     {field_plugins, 3},
     {field_type, 2},
-  [54] =
-    {field_id, 2},
+  [70] =
+    {field_parameter, 1},
+    {field_parameter, 2, .inherited = true},
+  [72] =
+    {field_parameter, 0, .inherited = true},
+    {field_parameter, 1, .inherited = true},
+  [74] =
+    {field_body, 3},
+    {field_extends, 2},
+    {field_id, 4},
     {field_name, 0},
-    {field_optional, 1},
-  [57] =
-    {field_key, 0},
-    {field_value, 2},
-  [59] =
-    {field_key_type, 2},
-    {field_value_type, 0},
-  [61] =
+    {field_parameters, 1},
+  [79] =
+    {field_config, 4},
+    {field_name, 1},
+    {field_source, 3},
+  [82] =
+    {field_config, 4},
+    {field_namespace, 1},
+    {field_source, 3},
+  [85] =
+    {field_alias, 2},
+    {field_name, 0},
+  [87] =
+    {field_name, 2},
+  [88] =
+    {field_name, 1},
+    {field_name, 2, .inherited = true},
+  [90] =
+    {field_name, 0, .inherited = true},
+    {field_name, 1, .inherited = true},
+  [92] =
+    {field_config, 4},
+    {field_names, 1},
+    {field_source, 3},
+  [95] =
     {field_name, 0},
     {field_optional, 1},
     {field_type, 3},
-  [64] =
+  [98] =
+    {field_field, 2},
+  [99] =
+    {field_field, 1},
+    {field_field, 2, .inherited = true},
+  [101] =
+    {field_field, 0, .inherited = true},
+    {field_field, 1, .inherited = true},
+  [103] =
+    {field_fields, 4},
+    {field_id, 5},
+    {field_name, 0},
+    {field_operator, 2},
+    {field_source, 3},
+  [108] =
+    {field_fields, 4},
+    {field_name, 0},
+    {field_operator, 2},
+    {field_plugins, 5},
+    {field_source, 3},
+  [113] =
+    {field_argument, 2},
+    {field_name, 0},
+  [115] =
+    {field_key_type, 2},
+    {field_value_type, 0},
+  [117] =
+    {field_key, 0},
+    {field_value, 2},
+  [119] =
+    {field_name, 2},
+    {field_name, 3, .inherited = true},
+  [121] =
     {field_default, 4},
     {field_name, 0},
     {field_type, 2},
-  [67] =
+  [124] =
     {field_id, 4},
     {field_name, 0},
     {field_optional, 1},
     {field_type, 3},
-  [71] =
+  [128] =
     {field_name, 0},
     {field_optional, 1},
     {field_plugins, 4},
     {field_type, 3},
-  [75] =
+  [132] =
+    {field_field, 2},
+    {field_field, 3, .inherited = true},
+  [134] =
+    {field_fields, 4},
+    {field_id, 6},
+    {field_name, 0},
+    {field_operator, 2},
+    {field_plugins, 5},
+    {field_source, 3},
+  [140] =
+    {field_argument, 1},
+  [141] =
+    {field_argument, 2},
+    {field_argument, 3, .inherited = true},
+    {field_name, 0},
+  [144] =
+    {field_argument, 0, .inherited = true},
+    {field_argument, 1, .inherited = true},
+  [146] =
     {field_default, 4},
     {field_id, 5},
     {field_name, 0},
     {field_type, 2},
-  [79] =
+  [150] =
     {field_default, 4},
     {field_name, 0},
     {field_plugins, 5},
     {field_type, 2},
-  [83] =
+  [154] =
     {field_default, 5},
     {field_name, 0},
     {field_optional, 1},
     {field_type, 3},
-  [87] =
+  [158] =
     {field_id, 5},
     {field_name, 0},
     {field_optional, 1},
     {field_plugins, 4},
     {field_type, 3},
-  [92] =
+  [163] =
     {field_default, 4},
     {field_id, 6},
     {field_name, 0},
     {field_plugins, 5},
     {field_type, 2},
-  [97] =
+  [168] =
     {field_default, 5},
     {field_id, 6},
     {field_name, 0},
     {field_optional, 1},
     {field_type, 3},
-  [102] =
+  [173] =
     {field_default, 5},
     {field_name, 0},
     {field_optional, 1},
     {field_plugins, 6},
     {field_type, 3},
-  [107] =
+  [178] =
     {field_default, 5},
     {field_id, 7},
     {field_name, 0},
@@ -945,7 +1226,7 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [128] = 128,
   [129] = 129,
   [130] = 130,
-  [131] = 131,
+  [131] = 3,
   [132] = 132,
   [133] = 133,
   [134] = 134,
@@ -1015,6 +1296,283 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [198] = 198,
   [199] = 199,
   [200] = 200,
+  [201] = 201,
+  [202] = 202,
+  [203] = 203,
+  [204] = 37,
+  [205] = 205,
+  [206] = 206,
+  [207] = 207,
+  [208] = 208,
+  [209] = 209,
+  [210] = 210,
+  [211] = 211,
+  [212] = 212,
+  [213] = 213,
+  [214] = 214,
+  [215] = 215,
+  [216] = 216,
+  [217] = 217,
+  [218] = 218,
+  [219] = 219,
+  [220] = 220,
+  [221] = 221,
+  [222] = 222,
+  [223] = 223,
+  [224] = 224,
+  [225] = 225,
+  [226] = 226,
+  [227] = 227,
+  [228] = 228,
+  [229] = 229,
+  [230] = 230,
+  [231] = 231,
+  [232] = 232,
+  [233] = 233,
+  [234] = 234,
+  [235] = 235,
+  [236] = 236,
+  [237] = 237,
+  [238] = 238,
+  [239] = 239,
+  [240] = 240,
+  [241] = 241,
+  [242] = 242,
+  [243] = 243,
+  [244] = 244,
+  [245] = 245,
+  [246] = 246,
+  [247] = 247,
+  [248] = 248,
+  [249] = 249,
+  [250] = 250,
+  [251] = 251,
+  [252] = 252,
+  [253] = 253,
+  [254] = 254,
+  [255] = 255,
+  [256] = 256,
+  [257] = 257,
+  [258] = 258,
+  [259] = 259,
+  [260] = 260,
+  [261] = 261,
+  [262] = 262,
+  [263] = 263,
+  [264] = 264,
+  [265] = 265,
+  [266] = 266,
+  [267] = 267,
+  [268] = 268,
+  [269] = 269,
+  [270] = 270,
+  [271] = 271,
+  [272] = 272,
+  [273] = 273,
+  [274] = 274,
+  [275] = 275,
+  [276] = 276,
+  [277] = 277,
+  [278] = 278,
+  [279] = 279,
+  [280] = 280,
+  [281] = 281,
+  [282] = 282,
+  [283] = 283,
+  [284] = 284,
+  [285] = 285,
+  [286] = 286,
+  [287] = 287,
+  [288] = 288,
+  [289] = 289,
+  [290] = 290,
+  [291] = 291,
+  [292] = 292,
+  [293] = 293,
+  [294] = 294,
+  [295] = 295,
+  [296] = 296,
+  [297] = 297,
+  [298] = 298,
+  [299] = 299,
+  [300] = 300,
+  [301] = 301,
+  [302] = 302,
+  [303] = 303,
+  [304] = 304,
+  [305] = 305,
+  [306] = 306,
+  [307] = 307,
+  [308] = 308,
+  [309] = 309,
+  [310] = 310,
+  [311] = 311,
+  [312] = 312,
+  [313] = 313,
+  [314] = 314,
+  [315] = 315,
+  [316] = 316,
+  [317] = 317,
+  [318] = 318,
+  [319] = 319,
+  [320] = 320,
+  [321] = 321,
+  [322] = 322,
+  [323] = 323,
+  [324] = 324,
+  [325] = 325,
+  [326] = 326,
+  [327] = 327,
+  [328] = 328,
+  [329] = 329,
+  [330] = 330,
+  [331] = 331,
+  [332] = 332,
+  [333] = 333,
+  [334] = 334,
+  [335] = 335,
+  [336] = 336,
+  [337] = 337,
+  [338] = 338,
+  [339] = 339,
+  [340] = 340,
+  [341] = 341,
+  [342] = 342,
+  [343] = 343,
+  [344] = 344,
+  [345] = 345,
+  [346] = 346,
+  [347] = 347,
+  [348] = 348,
+  [349] = 349,
+  [350] = 350,
+  [351] = 351,
+  [352] = 352,
+  [353] = 353,
+  [354] = 354,
+  [355] = 355,
+  [356] = 356,
+  [357] = 357,
+  [358] = 358,
+  [359] = 359,
+  [360] = 360,
+  [361] = 361,
+  [362] = 362,
+  [363] = 363,
+  [364] = 364,
+  [365] = 365,
+  [366] = 366,
+  [367] = 367,
+  [368] = 368,
+  [369] = 369,
+  [370] = 370,
+  [371] = 371,
+  [372] = 372,
+  [373] = 373,
+  [374] = 374,
+  [375] = 375,
+  [376] = 376,
+  [377] = 377,
+  [378] = 378,
+  [379] = 379,
+  [380] = 380,
+  [381] = 381,
+  [382] = 382,
+  [383] = 383,
+  [384] = 384,
+  [385] = 385,
+  [386] = 386,
+  [387] = 387,
+  [388] = 388,
+  [389] = 389,
+  [390] = 390,
+  [391] = 391,
+  [392] = 392,
+  [393] = 393,
+  [394] = 394,
+  [395] = 395,
+  [396] = 396,
+  [397] = 397,
+  [398] = 398,
+  [399] = 399,
+  [400] = 400,
+  [401] = 401,
+  [402] = 402,
+  [403] = 403,
+  [404] = 404,
+  [405] = 405,
+  [406] = 406,
+  [407] = 407,
+  [408] = 408,
+  [409] = 409,
+  [410] = 410,
+  [411] = 411,
+  [412] = 412,
+  [413] = 413,
+  [414] = 414,
+  [415] = 415,
+  [416] = 416,
+  [417] = 417,
+  [418] = 418,
+  [419] = 419,
+  [420] = 420,
+  [421] = 421,
+  [422] = 422,
+  [423] = 423,
+  [424] = 424,
+  [425] = 425,
+  [426] = 426,
+  [427] = 427,
+  [428] = 428,
+  [429] = 429,
+  [430] = 430,
+  [431] = 431,
+  [432] = 432,
+  [433] = 433,
+  [434] = 434,
+  [435] = 435,
+  [436] = 436,
+  [437] = 437,
+  [438] = 438,
+  [439] = 439,
+  [440] = 440,
+  [441] = 441,
+  [442] = 442,
+  [443] = 443,
+  [444] = 444,
+  [445] = 445,
+  [446] = 446,
+  [447] = 447,
+  [448] = 448,
+  [449] = 449,
+  [450] = 450,
+  [451] = 451,
+  [452] = 452,
+  [453] = 453,
+  [454] = 454,
+  [455] = 455,
+  [456] = 456,
+  [457] = 457,
+  [458] = 458,
+  [459] = 459,
+  [460] = 460,
+  [461] = 461,
+  [462] = 462,
+  [463] = 463,
+  [464] = 464,
+  [465] = 465,
+  [466] = 466,
+  [467] = 467,
+  [468] = 468,
+  [469] = 469,
+  [470] = 470,
+  [471] = 471,
+  [472] = 472,
+  [473] = 473,
+  [474] = 474,
+  [475] = 475,
+  [476] = 476,
+  [477] = 477,
 };
 
 static bool ts_lex(TSLexer *lexer, TSStateId state) {
@@ -1022,3391 +1580,8371 @@ static bool ts_lex(TSLexer *lexer, TSStateId state) {
   eof = lexer->eof(lexer);
   switch (state) {
     case 0:
-      if (eof) ADVANCE(15);
+      if (eof) ADVANCE(27);
       ADVANCE_MAP(
-        '\n', 17,
-        '\r', 1,
-        '"', 34,
-        '#', 21,
-        ',', 25,
-        '-', 20,
-        '.', 31,
-        '/', 4,
-        '0', 40,
-        ':', 24,
-        '=', 29,
-        '?', 28,
-        '@', 18,
-        '[', 32,
-        '\\', 5,
-        ']', 33,
-        '{', 26,
-        '|', 30,
-        '}', 27,
+        '\n', 2,
+        '\r', 3,
+        '"', 4,
+        '#', 5,
+        '(', 6,
+        ')', 7,
+        ',', 8,
+        '-', 9,
+        '.', 10,
+        '/', 11,
+        '0', 12,
+        ':', 14,
+        '<', 15,
+        '=', 16,
+        '>', 17,
+        '?', 18,
+        '@', 19,
+        '[', 21,
+        '\\', 22,
+        ']', 23,
+        '{', 24,
+        '|', 25,
+        '}', 26,
       );
+      if (('1' <= lookahead && lookahead <= '9')) ADVANCE(13);
       if (lookahead == '\t' ||
-          lookahead == ' ') SKIP(12);
-      if (('1' <= lookahead && lookahead <= '9')) ADVANCE(22);
+          lookahead == ' ') SKIP(1);
       if (('A' <= lookahead && lookahead <= 'Z') ||
           lookahead == '_' ||
-          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(42);
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
     case 1:
-      if (lookahead == '\n') ADVANCE(17);
-      END_STATE();
-    case 2:
       ADVANCE_MAP(
-        '\n', 17,
-        '\r', 1,
-        '"', 34,
-        '-', 6,
-        '/', 4,
-        '[', 32,
-        ']', 33,
-        '{', 26,
-        '}', 27,
+        '\n', 2,
+        '\r', 3,
+        '"', 4,
+        '#', 5,
+        '(', 6,
+        ')', 7,
+        ',', 8,
+        '-', 9,
+        '.', 10,
+        '/', 11,
+        '0', 12,
+        ':', 14,
+        '<', 15,
+        '=', 16,
+        '>', 17,
+        '?', 18,
+        '@', 19,
+        '[', 21,
+        ']', 23,
+        '{', 24,
+        '|', 25,
+        '}', 26,
       );
+      if (('1' <= lookahead && lookahead <= '9')) ADVANCE(13);
       if (lookahead == '\t' ||
-          lookahead == ' ') SKIP(2);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(40);
+          lookahead == ' ') SKIP(1);
       if (('A' <= lookahead && lookahead <= 'Z') ||
           lookahead == '_' ||
-          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(42);
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 2:
+      ACCEPT_TOKEN(aux_sym__nls_token1);
       END_STATE();
     case 3:
-      if (lookahead == '"') ADVANCE(34);
-      if (lookahead == '/') ADVANCE(37);
-      if (lookahead == '\\') ADVANCE(5);
-      if (lookahead == '\t' ||
-          lookahead == ' ') ADVANCE(36);
-      if (lookahead != 0) ADVANCE(38);
+      if (lookahead == '\n') ADVANCE(2);
       END_STATE();
     case 4:
-      if (lookahead == '/') ADVANCE(16);
+      ACCEPT_TOKEN(anon_sym_DQUOTE);
       END_STATE();
     case 5:
-      ADVANCE_MAP(
-        'u', 11,
-        '"', 39,
-        '/', 39,
-        '\\', 39,
-        'b', 39,
-        'f', 39,
-        'n', 39,
-        'r', 39,
-        't', 39,
-      );
+      ACCEPT_TOKEN(anon_sym_POUND);
       END_STATE();
     case 6:
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(40);
+      ACCEPT_TOKEN(anon_sym_LPAREN);
       END_STATE();
     case 7:
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(41);
+      ACCEPT_TOKEN(anon_sym_RPAREN);
       END_STATE();
     case 8:
-      if (('0' <= lookahead && lookahead <= '9') ||
-          ('A' <= lookahead && lookahead <= 'F') ||
-          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(39);
+      ACCEPT_TOKEN(anon_sym_COMMA);
       END_STATE();
     case 9:
-      if (('0' <= lookahead && lookahead <= '9') ||
-          ('A' <= lookahead && lookahead <= 'F') ||
-          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(8);
+      ACCEPT_TOKEN(anon_sym_DASH);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(12);
       END_STATE();
     case 10:
-      if (('0' <= lookahead && lookahead <= '9') ||
-          ('A' <= lookahead && lookahead <= 'F') ||
-          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(9);
+      ACCEPT_TOKEN(anon_sym_DOT);
       END_STATE();
     case 11:
-      if (('0' <= lookahead && lookahead <= '9') ||
-          ('A' <= lookahead && lookahead <= 'F') ||
-          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(10);
+      if (lookahead == '/') ADVANCE(28);
       END_STATE();
     case 12:
-      if (eof) ADVANCE(15);
-      ADVANCE_MAP(
-        '\n', 17,
-        '\r', 1,
-        '"', 34,
-        '#', 21,
-        ',', 25,
-        '-', 20,
-        '.', 31,
-        '/', 4,
-        '0', 40,
-        ':', 24,
-        '=', 29,
-        '?', 28,
-        '@', 18,
-        '[', 32,
-        ']', 33,
-        '{', 26,
-        '|', 30,
-        '}', 27,
-      );
-      if (lookahead == '\t' ||
-          lookahead == ' ') SKIP(12);
-      if (('1' <= lookahead && lookahead <= '9')) ADVANCE(22);
-      if (('A' <= lookahead && lookahead <= 'Z') ||
-          lookahead == '_' ||
-          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(42);
+      ACCEPT_TOKEN(sym_number_literal);
+      if (lookahead == '.') ADVANCE(29);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(12);
       END_STATE();
     case 13:
-      if (eof) ADVANCE(15);
-      ADVANCE_MAP(
-        '\n', 17,
-        '\r', 1,
-        '"', 34,
-        '-', 20,
-        '/', 4,
-        '@', 18,
-        '[', 32,
-        ']', 33,
-        '{', 26,
-        '}', 27,
-      );
-      if (lookahead == '\t' ||
-          lookahead == ' ') SKIP(13);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(40);
-      if (('A' <= lookahead && lookahead <= 'Z') ||
-          lookahead == '_' ||
-          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(42);
+      ACCEPT_TOKEN(aux_sym_entity_id_token1);
+      if (lookahead == '.') ADVANCE(29);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(13);
       END_STATE();
     case 14:
-      if (eof) ADVANCE(15);
-      ADVANCE_MAP(
-        '\n', 17,
-        '\r', 1,
-        '#', 21,
-        ',', 25,
-        '-', 19,
-        '.', 31,
-        '/', 4,
-        ':', 24,
-        '=', 29,
-        '@', 18,
-        '[', 32,
-        ']', 33,
-        '{', 26,
-        '|', 30,
-        '}', 27,
-      );
-      if (lookahead == '\t' ||
-          lookahead == ' ') SKIP(14);
-      if (('1' <= lookahead && lookahead <= '9')) ADVANCE(23);
-      if (('A' <= lookahead && lookahead <= 'Z') ||
-          lookahead == '_' ||
-          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(42);
+      ACCEPT_TOKEN(anon_sym_COLON);
       END_STATE();
     case 15:
-      ACCEPT_TOKEN(ts_builtin_sym_end);
+      ACCEPT_TOKEN(anon_sym_LT);
       END_STATE();
     case 16:
-      ACCEPT_TOKEN(sym_comment);
-      if (lookahead != 0 &&
-          lookahead != '\n') ADVANCE(16);
+      ACCEPT_TOKEN(anon_sym_EQ);
       END_STATE();
     case 17:
-      ACCEPT_TOKEN(aux_sym__nls_token1);
+      ACCEPT_TOKEN(anon_sym_GT);
       END_STATE();
     case 18:
-      ACCEPT_TOKEN(anon_sym_AT);
+      ACCEPT_TOKEN(anon_sym_QMARK);
       END_STATE();
     case 19:
-      ACCEPT_TOKEN(anon_sym_DASH);
+      ACCEPT_TOKEN(anon_sym_AT);
       END_STATE();
     case 20:
-      ACCEPT_TOKEN(anon_sym_DASH);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(40);
+      ACCEPT_TOKEN(sym_identifier);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
     case 21:
-      ACCEPT_TOKEN(anon_sym_POUND);
+      ACCEPT_TOKEN(anon_sym_LBRACK);
       END_STATE();
     case 22:
-      ACCEPT_TOKEN(aux_sym_entity_id_token1);
-      if (lookahead == '.') ADVANCE(7);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(22);
+      if (lookahead == 'u') ADVANCE(31);
+      if (lookahead == '"' ||
+          lookahead == '/' ||
+          lookahead == '\\' ||
+          lookahead == 'b' ||
+          lookahead == 'f' ||
+          lookahead == 'n' ||
+          lookahead == 'r' ||
+          lookahead == 't') ADVANCE(30);
       END_STATE();
     case 23:
-      ACCEPT_TOKEN(aux_sym_entity_id_token1);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(23);
+      ACCEPT_TOKEN(anon_sym_RBRACK);
       END_STATE();
     case 24:
-      ACCEPT_TOKEN(anon_sym_COLON);
+      ACCEPT_TOKEN(anon_sym_LBRACE);
       END_STATE();
     case 25:
-      ACCEPT_TOKEN(anon_sym_COMMA);
+      ACCEPT_TOKEN(anon_sym_PIPE);
       END_STATE();
     case 26:
-      ACCEPT_TOKEN(anon_sym_LBRACE);
+      ACCEPT_TOKEN(anon_sym_RBRACE);
       END_STATE();
     case 27:
-      ACCEPT_TOKEN(anon_sym_RBRACE);
+      ACCEPT_TOKEN(ts_builtin_sym_end);
       END_STATE();
     case 28:
-      ACCEPT_TOKEN(anon_sym_QMARK);
+      ACCEPT_TOKEN(sym_comment);
+      if (lookahead != 0 &&
+          lookahead != '\n') ADVANCE(28);
       END_STATE();
     case 29:
-      ACCEPT_TOKEN(anon_sym_EQ);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(32);
       END_STATE();
     case 30:
-      ACCEPT_TOKEN(anon_sym_PIPE);
+      ACCEPT_TOKEN(sym_escape_sequence);
       END_STATE();
     case 31:
-      ACCEPT_TOKEN(anon_sym_DOT);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(33);
       END_STATE();
     case 32:
-      ACCEPT_TOKEN(anon_sym_LBRACK);
+      ACCEPT_TOKEN(sym_number_literal);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(32);
       END_STATE();
     case 33:
-      ACCEPT_TOKEN(anon_sym_RBRACK);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(34);
       END_STATE();
     case 34:
-      ACCEPT_TOKEN(anon_sym_DQUOTE);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(35);
       END_STATE();
     case 35:
-      ACCEPT_TOKEN(sym_string_content);
-      if (lookahead == '\n') ADVANCE(38);
-      if (lookahead != 0 &&
-          lookahead != '"' &&
-          lookahead != '\\') ADVANCE(35);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(30);
       END_STATE();
     case 36:
-      ACCEPT_TOKEN(sym_string_content);
-      if (lookahead == '/') ADVANCE(37);
+      if (eof) ADVANCE(27);
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '-') ADVANCE(38);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '@') ADVANCE(19);
       if (lookahead == '\t' ||
-          lookahead == ' ') ADVANCE(36);
-      if (lookahead != 0 &&
-          lookahead != '"' &&
-          lookahead != '\\') ADVANCE(38);
+          lookahead == ' ') SKIP(37);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
     case 37:
-      ACCEPT_TOKEN(sym_string_content);
-      if (lookahead == '/') ADVANCE(35);
-      if (lookahead != 0 &&
-          lookahead != '"' &&
-          lookahead != '\\') ADVANCE(38);
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '-') ADVANCE(38);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '@') ADVANCE(19);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(37);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
     case 38:
-      ACCEPT_TOKEN(sym_string_content);
-      if (lookahead != 0 &&
-          lookahead != '"' &&
-          lookahead != '\\') ADVANCE(38);
+      ACCEPT_TOKEN(anon_sym_DASH);
       END_STATE();
     case 39:
-      ACCEPT_TOKEN(sym_escape_sequence);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == ':') ADVANCE(14);
+      if (lookahead == '<') ADVANCE(15);
+      if (lookahead == '{') ADVANCE(24);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(39);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
     case 40:
-      ACCEPT_TOKEN(sym_number_literal);
-      if (lookahead == '.') ADVANCE(7);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(40);
+      if (eof) ADVANCE(27);
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '-') ADVANCE(38);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '@') ADVANCE(19);
+      if (lookahead == ']') ADVANCE(23);
+      if (lookahead == '}') ADVANCE(26);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(41);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
     case 41:
-      ACCEPT_TOKEN(sym_number_literal);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(41);
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '-') ADVANCE(38);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '@') ADVANCE(19);
+      if (lookahead == ']') ADVANCE(23);
+      if (lookahead == '}') ADVANCE(26);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(41);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
     case 42:
-      ACCEPT_TOKEN(sym_identifier);
-      if (('0' <= lookahead && lookahead <= '9') ||
-          ('A' <= lookahead && lookahead <= 'Z') ||
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(42);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
           lookahead == '_' ||
-          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(42);
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
-    default:
-      return false;
-  }
-}
-
-static bool ts_lex_keywords(TSLexer *lexer, TSStateId state) {
-  START_LEXER();
-  eof = lexer->eof(lexer);
-  switch (state) {
-    case 0:
-      if (lookahead == 'e') ADVANCE(1);
-      if (lookahead == 'f') ADVANCE(2);
-      if (lookahead == 'i') ADVANCE(3);
-      if (lookahead == 'n') ADVANCE(4);
-      if (lookahead == 't') ADVANCE(5);
+    case 43:
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '{') ADVANCE(24);
       if (lookahead == '\t' ||
-          lookahead == ' ') SKIP(0);
+          lookahead == ' ') SKIP(43);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
-    case 1:
-      if (lookahead == 'x') ADVANCE(6);
+    case 44:
+      if (lookahead == '"') ADVANCE(4);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(44);
       END_STATE();
-    case 2:
-      if (lookahead == 'a') ADVANCE(7);
-      if (lookahead == 'r') ADVANCE(8);
+    case 45:
+      if (eof) ADVANCE(27);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(46);
       END_STATE();
-    case 3:
-      if (lookahead == 'm') ADVANCE(9);
+    case 46:
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(46);
       END_STATE();
-    case 4:
-      if (lookahead == 'u') ADVANCE(10);
+    case 47:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(47);
       END_STATE();
-    case 5:
-      if (lookahead == 'r') ADVANCE(11);
+    case 48:
+      if (eof) ADVANCE(27);
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '-') ADVANCE(38);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(49);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
-    case 6:
-      if (lookahead == 't') ADVANCE(12);
+    case 49:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '-') ADVANCE(38);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(49);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
-    case 7:
-      if (lookahead == 'l') ADVANCE(13);
+    case 50:
+      if (eof) ADVANCE(27);
+      if (lookahead == '-') ADVANCE(38);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '@') ADVANCE(19);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(51);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
-    case 8:
-      if (lookahead == 'o') ADVANCE(14);
+    case 51:
+      if (lookahead == '-') ADVANCE(38);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '@') ADVANCE(19);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(51);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
-    case 9:
-      if (lookahead == 'p') ADVANCE(15);
+    case 52:
+      if (eof) ADVANCE(27);
+      if (lookahead == '-') ADVANCE(38);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(53);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
-    case 10:
-      if (lookahead == 'l') ADVANCE(16);
+    case 53:
+      if (lookahead == '-') ADVANCE(38);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(53);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
-    case 11:
-      if (lookahead == 'u') ADVANCE(17);
+    case 54:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '-') ADVANCE(38);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '@') ADVANCE(19);
+      if (lookahead == '}') ADVANCE(26);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(54);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
-    case 12:
-      if (lookahead == 'e') ADVANCE(18);
+    case 55:
+      if (lookahead == '"') ADVANCE(4);
+      if (lookahead == '-') ADVANCE(56);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '{') ADVANCE(24);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(12);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(55);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
-    case 13:
-      if (lookahead == 's') ADVANCE(19);
+    case 56:
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(12);
       END_STATE();
-    case 14:
-      if (lookahead == 'm') ADVANCE(20);
+    case 57:
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '{') ADVANCE(24);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(57);
       END_STATE();
-    case 15:
-      if (lookahead == 'o') ADVANCE(21);
+    case 58:
+      if (eof) ADVANCE(27);
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '#') ADVANCE(5);
+      if (lookahead == '-') ADVANCE(38);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(59);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
-    case 16:
-      if (lookahead == 'l') ADVANCE(22);
+    case 59:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '#') ADVANCE(5);
+      if (lookahead == '-') ADVANCE(38);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(59);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
-    case 17:
-      if (lookahead == 'e') ADVANCE(23);
+    case 60:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '{') ADVANCE(24);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(60);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
-    case 18:
-      if (lookahead == 'n') ADVANCE(24);
+    case 61:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '}') ADVANCE(26);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(61);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
-    case 19:
-      if (lookahead == 'e') ADVANCE(25);
+    case 62:
+      if (lookahead == '"') ADVANCE(4);
+      if (lookahead == '\\') ADVANCE(22);
+      if (lookahead != 0 &&
+          lookahead != '"' &&
+          lookahead != '\\') ADVANCE(63);
       END_STATE();
-    case 20:
-      ACCEPT_TOKEN(anon_sym_from);
+    case 63:
+      ACCEPT_TOKEN(sym_string_content);
+      if (lookahead != 0 &&
+          lookahead != '"' &&
+          lookahead != '\\') ADVANCE(63);
       END_STATE();
-    case 21:
-      if (lookahead == 'r') ADVANCE(26);
+    case 64:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '{') ADVANCE(24);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(64);
       END_STATE();
-    case 22:
-      ACCEPT_TOKEN(sym_null_literal);
+    case 65:
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '#', 5,
+        '/', 11,
+        ':', 14,
+        '?', 18,
+        '{', 24,
+        '}', 26,
+      );
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(65);
       END_STATE();
-    case 23:
-      ACCEPT_TOKEN(anon_sym_true);
+    case 66:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '}') ADVANCE(26);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(66);
       END_STATE();
-    case 24:
-      if (lookahead == 'd') ADVANCE(27);
+    case 67:
+      if (lookahead == ',') ADVANCE(8);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '{') ADVANCE(24);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(67);
       END_STATE();
-    case 25:
-      ACCEPT_TOKEN(anon_sym_false);
+    case 68:
+      if (eof) ADVANCE(27);
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '#', 5,
+        ',', 8,
+        '-', 38,
+        '.', 10,
+        '/', 11,
+        '<', 15,
+        '=', 16,
+        '>', 17,
+        '[', 21,
+        ']', 23,
+        '{', 24,
+        '|', 25,
+        '}', 26,
+      );
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(69);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
-    case 26:
-      if (lookahead == 't') ADVANCE(28);
+    case 69:
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '#', 5,
+        ',', 8,
+        '-', 38,
+        '.', 10,
+        '/', 11,
+        '<', 15,
+        '=', 16,
+        '>', 17,
+        '[', 21,
+        ']', 23,
+        '{', 24,
+        '|', 25,
+        '}', 26,
+      );
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(69);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
-    case 27:
-      if (lookahead == 's') ADVANCE(29);
+    case 70:
+      if (eof) ADVANCE(27);
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '#', 5,
+        ',', 8,
+        '-', 38,
+        '/', 11,
+        '=', 16,
+        '>', 17,
+        '{', 24,
+        '|', 25,
+        '}', 26,
+      );
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(71);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
-    case 28:
-      ACCEPT_TOKEN(anon_sym_import);
+    case 71:
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '#', 5,
+        ',', 8,
+        '-', 38,
+        '/', 11,
+        '=', 16,
+        '>', 17,
+        '{', 24,
+        '|', 25,
+        '}', 26,
+      );
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(71);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
-    case 29:
-      ACCEPT_TOKEN(anon_sym_extends);
+    case 72:
+      if (eof) ADVANCE(27);
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '#', 5,
+        ',', 8,
+        '-', 38,
+        '/', 11,
+        '=', 16,
+        '>', 17,
+        ']', 23,
+        '{', 24,
+        '|', 25,
+        '}', 26,
+      );
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(73);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
       END_STATE();
-    default:
-      return false;
-  }
-}
-
-static const TSLexerMode ts_lex_modes[STATE_COUNT] = {
-  [0] = {.lex_state = 0},
-  [1] = {.lex_state = 14},
-  [2] = {.lex_state = 14},
-  [3] = {.lex_state = 2},
-  [4] = {.lex_state = 2},
-  [5] = {.lex_state = 13},
-  [6] = {.lex_state = 2},
-  [7] = {.lex_state = 2},
-  [8] = {.lex_state = 2},
-  [9] = {.lex_state = 2},
-  [10] = {.lex_state = 2},
-  [11] = {.lex_state = 2},
-  [12] = {.lex_state = 14},
-  [13] = {.lex_state = 14},
-  [14] = {.lex_state = 2},
-  [15] = {.lex_state = 2},
-  [16] = {.lex_state = 2},
-  [17] = {.lex_state = 2},
-  [18] = {.lex_state = 14},
-  [19] = {.lex_state = 2},
-  [20] = {.lex_state = 14},
-  [21] = {.lex_state = 2},
-  [22] = {.lex_state = 2},
-  [23] = {.lex_state = 2},
-  [24] = {.lex_state = 14},
-  [25] = {.lex_state = 14},
-  [26] = {.lex_state = 14},
-  [27] = {.lex_state = 14},
-  [28] = {.lex_state = 14},
-  [29] = {.lex_state = 14},
-  [30] = {.lex_state = 14},
-  [31] = {.lex_state = 14},
-  [32] = {.lex_state = 14},
-  [33] = {.lex_state = 14},
-  [34] = {.lex_state = 14},
-  [35] = {.lex_state = 14},
-  [36] = {.lex_state = 14},
-  [37] = {.lex_state = 14},
-  [38] = {.lex_state = 2},
-  [39] = {.lex_state = 14},
-  [40] = {.lex_state = 14},
-  [41] = {.lex_state = 14},
-  [42] = {.lex_state = 2},
-  [43] = {.lex_state = 14},
-  [44] = {.lex_state = 14},
-  [45] = {.lex_state = 14},
-  [46] = {.lex_state = 0},
-  [47] = {.lex_state = 14},
-  [48] = {.lex_state = 2},
-  [49] = {.lex_state = 2},
-  [50] = {.lex_state = 14},
-  [51] = {.lex_state = 14},
-  [52] = {.lex_state = 14},
-  [53] = {.lex_state = 2},
-  [54] = {.lex_state = 2},
-  [55] = {.lex_state = 14},
-  [56] = {.lex_state = 2},
-  [57] = {.lex_state = 2},
-  [58] = {.lex_state = 2},
-  [59] = {.lex_state = 2},
-  [60] = {.lex_state = 0},
-  [61] = {.lex_state = 0},
-  [62] = {.lex_state = 0},
-  [63] = {.lex_state = 0},
-  [64] = {.lex_state = 0},
-  [65] = {.lex_state = 2},
-  [66] = {.lex_state = 0},
-  [67] = {.lex_state = 2},
-  [68] = {.lex_state = 2},
-  [69] = {.lex_state = 0},
-  [70] = {.lex_state = 0},
-  [71] = {.lex_state = 14},
-  [72] = {.lex_state = 0},
-  [73] = {.lex_state = 14},
-  [74] = {.lex_state = 0},
-  [75] = {.lex_state = 0},
-  [76] = {.lex_state = 14},
-  [77] = {.lex_state = 14},
-  [78] = {.lex_state = 0},
-  [79] = {.lex_state = 0},
-  [80] = {.lex_state = 0},
-  [81] = {.lex_state = 14},
-  [82] = {.lex_state = 0},
-  [83] = {.lex_state = 0},
-  [84] = {.lex_state = 0},
-  [85] = {.lex_state = 0},
-  [86] = {.lex_state = 14},
-  [87] = {.lex_state = 14},
-  [88] = {.lex_state = 0},
-  [89] = {.lex_state = 0},
-  [90] = {.lex_state = 14},
-  [91] = {.lex_state = 0},
-  [92] = {.lex_state = 0},
-  [93] = {.lex_state = 0},
-  [94] = {.lex_state = 14},
-  [95] = {.lex_state = 0},
-  [96] = {.lex_state = 14},
-  [97] = {.lex_state = 0},
-  [98] = {.lex_state = 0},
-  [99] = {.lex_state = 14},
-  [100] = {.lex_state = 0},
-  [101] = {.lex_state = 0},
-  [102] = {.lex_state = 14},
-  [103] = {.lex_state = 0},
-  [104] = {.lex_state = 14},
-  [105] = {.lex_state = 14},
-  [106] = {.lex_state = 0},
-  [107] = {.lex_state = 0},
-  [108] = {.lex_state = 0},
-  [109] = {.lex_state = 14},
-  [110] = {.lex_state = 0},
-  [111] = {.lex_state = 0},
-  [112] = {.lex_state = 14},
-  [113] = {.lex_state = 0},
-  [114] = {.lex_state = 0},
-  [115] = {.lex_state = 0},
-  [116] = {.lex_state = 0},
-  [117] = {.lex_state = 14},
-  [118] = {.lex_state = 3},
-  [119] = {.lex_state = 3},
-  [120] = {.lex_state = 0},
-  [121] = {.lex_state = 14},
-  [122] = {.lex_state = 0},
-  [123] = {.lex_state = 0},
-  [124] = {.lex_state = 0},
-  [125] = {.lex_state = 0},
-  [126] = {.lex_state = 14},
-  [127] = {.lex_state = 0},
-  [128] = {.lex_state = 0},
-  [129] = {.lex_state = 0},
-  [130] = {.lex_state = 0},
-  [131] = {.lex_state = 3},
-  [132] = {.lex_state = 0},
-  [133] = {.lex_state = 0},
-  [134] = {.lex_state = 14},
-  [135] = {.lex_state = 0},
-  [136] = {.lex_state = 0},
-  [137] = {.lex_state = 14},
-  [138] = {.lex_state = 0},
-  [139] = {.lex_state = 0},
-  [140] = {.lex_state = 0},
-  [141] = {.lex_state = 0},
-  [142] = {.lex_state = 0},
-  [143] = {.lex_state = 0},
-  [144] = {.lex_state = 0},
-  [145] = {.lex_state = 0},
-  [146] = {.lex_state = 0},
-  [147] = {.lex_state = 0},
-  [148] = {.lex_state = 0},
-  [149] = {.lex_state = 0},
-  [150] = {.lex_state = 0},
-  [151] = {.lex_state = 0},
-  [152] = {.lex_state = 0},
-  [153] = {.lex_state = 0},
-  [154] = {.lex_state = 0},
-  [155] = {.lex_state = 0},
-  [156] = {.lex_state = 0},
-  [157] = {.lex_state = 0},
-  [158] = {.lex_state = 0},
-  [159] = {.lex_state = 0},
-  [160] = {.lex_state = 0},
-  [161] = {.lex_state = 0},
-  [162] = {.lex_state = 0},
-  [163] = {.lex_state = 0},
-  [164] = {.lex_state = 0},
-  [165] = {.lex_state = 0},
-  [166] = {.lex_state = 0},
-  [167] = {.lex_state = 0},
-  [168] = {.lex_state = 0},
-  [169] = {.lex_state = 0},
-  [170] = {.lex_state = 0},
-  [171] = {.lex_state = 0},
-  [172] = {.lex_state = 0},
-  [173] = {.lex_state = 0},
-  [174] = {.lex_state = 0},
-  [175] = {.lex_state = 0},
-  [176] = {.lex_state = 0},
-  [177] = {.lex_state = 0},
-  [178] = {.lex_state = 0},
-  [179] = {.lex_state = 0},
-  [180] = {.lex_state = 0},
-  [181] = {.lex_state = 0},
-  [182] = {.lex_state = 0},
-  [183] = {.lex_state = 0},
-  [184] = {.lex_state = 0},
-  [185] = {.lex_state = 0},
-  [186] = {.lex_state = 0},
-  [187] = {.lex_state = 0},
-  [188] = {.lex_state = 0},
-  [189] = {.lex_state = 0},
-  [190] = {.lex_state = 14},
-  [191] = {.lex_state = 0},
-  [192] = {.lex_state = 0},
-  [193] = {.lex_state = 0},
-  [194] = {.lex_state = 0},
-  [195] = {.lex_state = 0},
-  [196] = {.lex_state = 0},
-  [197] = {.lex_state = 0},
-  [198] = {.lex_state = 0},
-  [199] = {.lex_state = 0},
-  [200] = {.lex_state = 0},
-};
-
-static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
-  [STATE(0)] = {
-    [ts_builtin_sym_end] = ACTIONS(1),
-    [sym_identifier] = ACTIONS(1),
-    [sym_comment] = ACTIONS(3),
-    [aux_sym__nls_token1] = ACTIONS(1),
-    [anon_sym_AT] = ACTIONS(1),
-    [anon_sym_from] = ACTIONS(1),
-    [anon_sym_import] = ACTIONS(1),
-    [anon_sym_extends] = ACTIONS(1),
-    [anon_sym_DASH] = ACTIONS(1),
-    [anon_sym_POUND] = ACTIONS(1),
-    [aux_sym_entity_id_token1] = ACTIONS(1),
-    [anon_sym_COLON] = ACTIONS(1),
-    [anon_sym_COMMA] = ACTIONS(1),
-    [anon_sym_LBRACE] = ACTIONS(1),
-    [anon_sym_RBRACE] = ACTIONS(1),
-    [anon_sym_QMARK] = ACTIONS(1),
-    [anon_sym_EQ] = ACTIONS(1),
-    [anon_sym_PIPE] = ACTIONS(1),
-    [anon_sym_DOT] = ACTIONS(1),
-    [anon_sym_LBRACK] = ACTIONS(1),
-    [anon_sym_RBRACK] = ACTIONS(1),
-    [anon_sym_DQUOTE] = ACTIONS(1),
-    [sym_escape_sequence] = ACTIONS(1),
-    [sym_number_literal] = ACTIONS(1),
-    [anon_sym_true] = ACTIONS(1),
-    [anon_sym_false] = ACTIONS(1),
-    [sym_null_literal] = ACTIONS(1),
-  },
-  [STATE(1)] = {
-    [sym_source_file] = STATE(192),
-    [sym__directive] = STATE(182),
-    [sym__definition] = STATE(96),
-    [aux_sym__nls] = STATE(2),
-    [sym_plugin_import] = STATE(182),
-    [sym_template_import] = STATE(182),
-    [sym_extends_template] = STATE(182),
-    [sym_model_removal] = STATE(96),
-    [sym_type_alias] = STATE(96),
-    [sym_model_definition] = STATE(96),
-    [aux_sym_source_file_repeat1] = STATE(13),
-    [aux_sym_source_file_repeat2] = STATE(45),
-    [ts_builtin_sym_end] = ACTIONS(5),
-    [sym_identifier] = ACTIONS(7),
-    [sym_comment] = ACTIONS(3),
-    [aux_sym__nls_token1] = ACTIONS(9),
-    [anon_sym_AT] = ACTIONS(11),
-    [anon_sym_import] = ACTIONS(13),
-    [anon_sym_extends] = ACTIONS(15),
-    [anon_sym_DASH] = ACTIONS(17),
-  },
-};
-
-static const uint16_t ts_small_parse_table[] = {
-  [0] = 13,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(7), 1,
-      sym_identifier,
-    ACTIONS(11), 1,
-      anon_sym_AT,
-    ACTIONS(13), 1,
-      anon_sym_import,
-    ACTIONS(15), 1,
-      anon_sym_extends,
-    ACTIONS(17), 1,
-      anon_sym_DASH,
-    ACTIONS(19), 1,
-      ts_builtin_sym_end,
-    ACTIONS(21), 1,
-      aux_sym__nls_token1,
-    STATE(5), 1,
-      aux_sym__nls,
-    STATE(12), 1,
-      aux_sym_source_file_repeat1,
-    STATE(51), 1,
-      aux_sym_source_file_repeat2,
-    STATE(96), 4,
-      sym__definition,
-      sym_model_removal,
-      sym_type_alias,
-      sym_model_definition,
-    STATE(182), 4,
-      sym__directive,
-      sym_plugin_import,
-      sym_template_import,
-      sym_extends_template,
-  [46] = 12,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(21), 1,
+    case 73:
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '#', 5,
+        ',', 8,
+        '-', 38,
+        '/', 11,
+        '=', 16,
+        '>', 17,
+        ']', 23,
+        '{', 24,
+        '|', 25,
+        '}', 26,
+      );
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(73);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 74:
+      if (eof) ADVANCE(27);
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '#') ADVANCE(5);
+      if (lookahead == '-') ADVANCE(38);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '{') ADVANCE(24);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(75);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 75:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '#') ADVANCE(5);
+      if (lookahead == '-') ADVANCE(38);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '{') ADVANCE(24);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(75);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 76:
+      if (eof) ADVANCE(27);
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '#', 5,
+        ',', 8,
+        '-', 38,
+        '/', 11,
+        '=', 16,
+        '>', 17,
+        '{', 24,
+        '}', 26,
+      );
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(77);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 77:
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '#', 5,
+        ',', 8,
+        '-', 38,
+        '/', 11,
+        '=', 16,
+        '>', 17,
+        '{', 24,
+        '}', 26,
+      );
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(77);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 78:
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '|') ADVANCE(25);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(78);
+      END_STATE();
+    case 79:
+      if (eof) ADVANCE(27);
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '#', 5,
+        ',', 8,
+        '-', 38,
+        '/', 11,
+        '=', 16,
+        '>', 17,
+        '[', 21,
+        '{', 24,
+        '|', 25,
+        '}', 26,
+      );
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(80);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 80:
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '#', 5,
+        ',', 8,
+        '-', 38,
+        '/', 11,
+        '=', 16,
+        '>', 17,
+        '[', 21,
+        '{', 24,
+        '|', 25,
+        '}', 26,
+      );
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(80);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 81:
+      if (eof) ADVANCE(27);
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '#', 5,
+        ',', 8,
+        '-', 38,
+        '/', 11,
+        '<', 15,
+        '=', 16,
+        '>', 17,
+        '[', 21,
+        '{', 24,
+        '|', 25,
+        '}', 26,
+      );
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(82);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 82:
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '#', 5,
+        ',', 8,
+        '-', 38,
+        '/', 11,
+        '<', 15,
+        '=', 16,
+        '>', 17,
+        '[', 21,
+        '{', 24,
+        '|', 25,
+        '}', 26,
+      );
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(82);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 83:
+      if (eof) ADVANCE(27);
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '#', 5,
+        ',', 8,
+        '-', 38,
+        '/', 11,
+        '<', 15,
+        '=', 16,
+        '>', 17,
+        '[', 21,
+        ']', 23,
+        '{', 24,
+        '|', 25,
+        '}', 26,
+      );
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(84);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 84:
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '#', 5,
+        ',', 8,
+        '-', 38,
+        '/', 11,
+        '<', 15,
+        '=', 16,
+        '>', 17,
+        '[', 21,
+        ']', 23,
+        '{', 24,
+        '|', 25,
+        '}', 26,
+      );
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(84);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 85:
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '[') ADVANCE(21);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(85);
+      END_STATE();
+    case 86:
+      if (lookahead == ',') ADVANCE(8);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '>') ADVANCE(17);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(86);
+      END_STATE();
+    case 87:
+      if (lookahead == '/') ADVANCE(11);
+      if (('1' <= lookahead && lookahead <= '9')) ADVANCE(88);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(87);
+      END_STATE();
+    case 88:
+      ACCEPT_TOKEN(aux_sym_entity_id_token1);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(88);
+      END_STATE();
+    case 89:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '"') ADVANCE(4);
+      if (lookahead == '-') ADVANCE(56);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '}') ADVANCE(26);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(12);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(89);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 90:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == ',') ADVANCE(8);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '}') ADVANCE(26);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(90);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 91:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == ',') ADVANCE(8);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '}') ADVANCE(26);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(91);
+      END_STATE();
+    case 92:
+      if (eof) ADVANCE(27);
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '#', 5,
+        ',', 8,
+        '-', 38,
+        '/', 11,
+        ':', 14,
+        '=', 16,
+        '>', 17,
+        ']', 23,
+        '{', 24,
+        '|', 25,
+        '}', 26,
+      );
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(93);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 93:
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '#', 5,
+        ',', 8,
+        '-', 38,
+        '/', 11,
+        ':', 14,
+        '=', 16,
+        '>', 17,
+        ']', 23,
+        '{', 24,
+        '|', 25,
+        '}', 26,
+      );
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(93);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 94:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '@') ADVANCE(19);
+      if (lookahead == '}') ADVANCE(26);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(94);
+      END_STATE();
+    case 95:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '#') ADVANCE(5);
+      if (lookahead == ',') ADVANCE(8);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == ':') ADVANCE(14);
+      if (lookahead == '}') ADVANCE(26);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(95);
+      END_STATE();
+    case 96:
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '#', 5,
+        ',', 8,
+        '/', 11,
+        ':', 14,
+        '?', 18,
+        '}', 26,
+      );
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(96);
+      END_STATE();
+    case 97:
+      if (lookahead == '"') ADVANCE(4);
+      if (lookahead == '-') ADVANCE(56);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == ']') ADVANCE(23);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(12);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(97);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 98:
+      if (eof) ADVANCE(27);
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == ',') ADVANCE(8);
+      if (lookahead == '-') ADVANCE(38);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '}') ADVANCE(26);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(99);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 99:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == ',') ADVANCE(8);
+      if (lookahead == '-') ADVANCE(38);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '}') ADVANCE(26);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(99);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 100:
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == ':') ADVANCE(14);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(100);
+      END_STATE();
+    case 101:
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '"', 4,
+        '-', 56,
+        '/', 11,
+        '[', 21,
+        ']', 23,
+        '{', 24,
+        '}', 26,
+      );
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(12);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(101);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 102:
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '#', 5,
+        ',', 8,
+        '/', 11,
+        '@', 19,
+        ']', 23,
+        '{', 24,
+        '}', 26,
+      );
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(102);
+      END_STATE();
+    case 103:
+      if (eof) ADVANCE(27);
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '#') ADVANCE(5);
+      if (lookahead == ',') ADVANCE(8);
+      if (lookahead == '-') ADVANCE(38);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '}') ADVANCE(26);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(104);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 104:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '#') ADVANCE(5);
+      if (lookahead == ',') ADVANCE(8);
+      if (lookahead == '-') ADVANCE(38);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '}') ADVANCE(26);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(104);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 105:
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '#', 5,
+        ',', 8,
+        '/', 11,
+        '=', 16,
+        '{', 24,
+        '}', 26,
+      );
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(105);
+      END_STATE();
+    case 106:
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == ']') ADVANCE(23);
+      if (lookahead == '|') ADVANCE(25);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(106);
+      END_STATE();
+    case 107:
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == ']') ADVANCE(23);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(107);
+      END_STATE();
+    case 108:
+      if (lookahead == '"') ADVANCE(4);
+      if (lookahead == '-') ADVANCE(56);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '[') ADVANCE(21);
+      if (lookahead == '{') ADVANCE(24);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(12);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(108);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 109:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '#') ADVANCE(5);
+      if (lookahead == ',') ADVANCE(8);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '}') ADVANCE(26);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(109);
+      END_STATE();
+    case 110:
+      if (lookahead == '"') ADVANCE(4);
+      if (lookahead == '-') ADVANCE(56);
+      if (lookahead == '/') ADVANCE(11);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(12);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(110);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 111:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == ',') ADVANCE(8);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == ']') ADVANCE(23);
+      if (lookahead == '}') ADVANCE(26);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(111);
+      END_STATE();
+    case 112:
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '"', 4,
+        '-', 56,
+        '/', 11,
+        '[', 21,
+        ']', 23,
+        '{', 24,
+      );
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(12);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(112);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 113:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(113);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 114:
+      if (lookahead == '(') ADVANCE(6);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(114);
+      END_STATE();
+    case 115:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '#') ADVANCE(5);
+      if (lookahead == ',') ADVANCE(8);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '{') ADVANCE(24);
+      if (lookahead == '}') ADVANCE(26);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(115);
+      END_STATE();
+    case 116:
+      ADVANCE_MAP(
+        '\n', 2,
+        '\r', 3,
+        '#', 5,
+        ',', 8,
+        '/', 11,
+        ']', 23,
+        '{', 24,
+        '}', 26,
+      );
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(116);
+      END_STATE();
+    case 117:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == ',') ADVANCE(8);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == ']') ADVANCE(23);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(117);
+      END_STATE();
+    case 118:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '"') ADVANCE(4);
+      if (lookahead == '-') ADVANCE(56);
+      if (lookahead == '/') ADVANCE(11);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(12);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(118);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    case 119:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '@') ADVANCE(19);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(119);
+      END_STATE();
+    case 120:
+      if (lookahead == ')') ADVANCE(7);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(120);
+      END_STATE();
+    case 121:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == ']') ADVANCE(23);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(121);
+      END_STATE();
+    case 122:
+      if (lookahead == '\n') ADVANCE(2);
+      if (lookahead == '\r') ADVANCE(3);
+      if (lookahead == '"') ADVANCE(4);
+      if (lookahead == '-') ADVANCE(56);
+      if (lookahead == '/') ADVANCE(11);
+      if (lookahead == '[') ADVANCE(21);
+      if (lookahead == '{') ADVANCE(24);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(12);
+      if (lookahead == '\t' ||
+          lookahead == ' ') SKIP(122);
+      if (('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(20);
+      END_STATE();
+    default:
+      return false;
+  }
+}
+
+static bool ts_lex_keywords(TSLexer *lexer, TSStateId state) {
+  START_LEXER();
+  eof = lexer->eof(lexer);
+  switch (state) {
+    case 0:
+      ADVANCE_MAP(
+        'a', 1,
+        'e', 2,
+        'f', 3,
+        'i', 4,
+        'n', 5,
+        'o', 6,
+        'p', 7,
+        't', 8,
+      );
+      END_STATE();
+    case 1:
+      if (lookahead == 's') ADVANCE(9);
+      END_STATE();
+    case 2:
+      if (lookahead == 'x') ADVANCE(10);
+      END_STATE();
+    case 3:
+      if (lookahead == 'a') ADVANCE(11);
+      if (lookahead == 'r') ADVANCE(12);
+      END_STATE();
+    case 4:
+      if (lookahead == 'm') ADVANCE(13);
+      END_STATE();
+    case 5:
+      if (lookahead == 'u') ADVANCE(14);
+      END_STATE();
+    case 6:
+      if (lookahead == 'm') ADVANCE(15);
+      END_STATE();
+    case 7:
+      if (lookahead == 'i') ADVANCE(16);
+      END_STATE();
+    case 8:
+      if (lookahead == 'r') ADVANCE(17);
+      END_STATE();
+    case 9:
+      ACCEPT_TOKEN(anon_sym_as);
+      END_STATE();
+    case 10:
+      if (lookahead == 't') ADVANCE(18);
+      END_STATE();
+    case 11:
+      if (lookahead == 'l') ADVANCE(19);
+      END_STATE();
+    case 12:
+      if (lookahead == 'o') ADVANCE(20);
+      END_STATE();
+    case 13:
+      if (lookahead == 'p') ADVANCE(21);
+      END_STATE();
+    case 14:
+      if (lookahead == 'l') ADVANCE(22);
+      END_STATE();
+    case 15:
+      if (lookahead == 'i') ADVANCE(23);
+      END_STATE();
+    case 16:
+      if (lookahead == 'c') ADVANCE(24);
+      END_STATE();
+    case 17:
+      if (lookahead == 'u') ADVANCE(25);
+      END_STATE();
+    case 18:
+      if (lookahead == 'e') ADVANCE(26);
+      END_STATE();
+    case 19:
+      if (lookahead == 's') ADVANCE(27);
+      END_STATE();
+    case 20:
+      if (lookahead == 'm') ADVANCE(28);
+      END_STATE();
+    case 21:
+      if (lookahead == 'o') ADVANCE(29);
+      END_STATE();
+    case 22:
+      if (lookahead == 'l') ADVANCE(30);
+      END_STATE();
+    case 23:
+      if (lookahead == 't') ADVANCE(31);
+      END_STATE();
+    case 24:
+      if (lookahead == 'k') ADVANCE(32);
+      END_STATE();
+    case 25:
+      if (lookahead == 'e') ADVANCE(33);
+      END_STATE();
+    case 26:
+      if (lookahead == 'n') ADVANCE(34);
+      END_STATE();
+    case 27:
+      if (lookahead == 'e') ADVANCE(35);
+      END_STATE();
+    case 28:
+      ACCEPT_TOKEN(anon_sym_from);
+      END_STATE();
+    case 29:
+      if (lookahead == 'r') ADVANCE(36);
+      END_STATE();
+    case 30:
+      ACCEPT_TOKEN(sym_null_literal);
+      END_STATE();
+    case 31:
+      ACCEPT_TOKEN(anon_sym_omit);
+      END_STATE();
+    case 32:
+      ACCEPT_TOKEN(anon_sym_pick);
+      END_STATE();
+    case 33:
+      ACCEPT_TOKEN(anon_sym_true);
+      END_STATE();
+    case 34:
+      if (lookahead == 'd') ADVANCE(37);
+      END_STATE();
+    case 35:
+      ACCEPT_TOKEN(anon_sym_false);
+      END_STATE();
+    case 36:
+      if (lookahead == 't') ADVANCE(38);
+      END_STATE();
+    case 37:
+      if (lookahead == 's') ADVANCE(39);
+      END_STATE();
+    case 38:
+      ACCEPT_TOKEN(anon_sym_import);
+      END_STATE();
+    case 39:
+      ACCEPT_TOKEN(anon_sym_extends);
+      END_STATE();
+    default:
+      return false;
+  }
+}
+
+static const TSLexerMode ts_lex_modes[STATE_COUNT] = {
+  [0] = {.lex_state = 0},
+  [1] = {.lex_state = 36},
+  [2] = {.lex_state = 39},
+  [3] = {.lex_state = 40},
+  [4] = {.lex_state = 42},
+  [5] = {.lex_state = 43},
+  [6] = {.lex_state = 44},
+  [7] = {.lex_state = 42},
+  [8] = {.lex_state = 45},
+  [9] = {.lex_state = 47},
+  [10] = {.lex_state = 48},
+  [11] = {.lex_state = 36},
+  [12] = {.lex_state = 47},
+  [13] = {.lex_state = 47},
+  [14] = {.lex_state = 47},
+  [15] = {.lex_state = 48},
+  [16] = {.lex_state = 48},
+  [17] = {.lex_state = 48},
+  [18] = {.lex_state = 48},
+  [19] = {.lex_state = 50},
+  [20] = {.lex_state = 52},
+  [21] = {.lex_state = 54},
+  [22] = {.lex_state = 42},
+  [23] = {.lex_state = 55},
+  [24] = {.lex_state = 42},
+  [25] = {.lex_state = 57},
+  [26] = {.lex_state = 43},
+  [27] = {.lex_state = 58},
+  [28] = {.lex_state = 60},
+  [29] = {.lex_state = 42},
+  [30] = {.lex_state = 61},
+  [31] = {.lex_state = 42},
+  [32] = {.lex_state = 62},
+  [33] = {.lex_state = 64},
+  [34] = {.lex_state = 48},
+  [35] = {.lex_state = 36},
+  [36] = {.lex_state = 48},
+  [37] = {.lex_state = 40},
+  [38] = {.lex_state = 50},
+  [39] = {.lex_state = 52},
+  [40] = {.lex_state = 50},
+  [41] = {.lex_state = 52},
+  [42] = {.lex_state = 52},
+  [43] = {.lex_state = 65},
+  [44] = {.lex_state = 42},
+  [45] = {.lex_state = 58},
+  [46] = {.lex_state = 42},
+  [47] = {.lex_state = 54},
+  [48] = {.lex_state = 66},
+  [49] = {.lex_state = 66},
+  [50] = {.lex_state = 66},
+  [51] = {.lex_state = 66},
+  [52] = {.lex_state = 66},
+  [53] = {.lex_state = 67},
+  [54] = {.lex_state = 68},
+  [55] = {.lex_state = 61},
+  [56] = {.lex_state = 42},
+  [57] = {.lex_state = 42},
+  [58] = {.lex_state = 70},
+  [59] = {.lex_state = 72},
+  [60] = {.lex_state = 72},
+  [61] = {.lex_state = 70},
+  [62] = {.lex_state = 74},
+  [63] = {.lex_state = 76},
+  [64] = {.lex_state = 78},
+  [65] = {.lex_state = 79},
+  [66] = {.lex_state = 79},
+  [67] = {.lex_state = 81},
+  [68] = {.lex_state = 83},
+  [69] = {.lex_state = 85},
+  [70] = {.lex_state = 79},
+  [71] = {.lex_state = 79},
+  [72] = {.lex_state = 70},
+  [73] = {.lex_state = 70},
+  [74] = {.lex_state = 86},
+  [75] = {.lex_state = 58},
+  [76] = {.lex_state = 57},
+  [77] = {.lex_state = 58},
+  [78] = {.lex_state = 87},
+  [79] = {.lex_state = 48},
+  [80] = {.lex_state = 44},
+  [81] = {.lex_state = 89},
+  [82] = {.lex_state = 47},
+  [83] = {.lex_state = 44},
+  [84] = {.lex_state = 90},
+  [85] = {.lex_state = 42},
+  [86] = {.lex_state = 61},
+  [87] = {.lex_state = 91},
+  [88] = {.lex_state = 44},
+  [89] = {.lex_state = 92},
+  [90] = {.lex_state = 62},
+  [91] = {.lex_state = 62},
+  [92] = {.lex_state = 62},
+  [93] = {.lex_state = 47},
+  [94] = {.lex_state = 52},
+  [95] = {.lex_state = 94},
+  [96] = {.lex_state = 55},
+  [97] = {.lex_state = 95},
+  [98] = {.lex_state = 91},
+  [99] = {.lex_state = 66},
+  [100] = {.lex_state = 57},
+  [101] = {.lex_state = 66},
+  [102] = {.lex_state = 58},
+  [103] = {.lex_state = 66},
+  [104] = {.lex_state = 58},
+  [105] = {.lex_state = 54},
+  [106] = {.lex_state = 66},
+  [107] = {.lex_state = 42},
+  [108] = {.lex_state = 67},
+  [109] = {.lex_state = 42},
+  [110] = {.lex_state = 96},
+  [111] = {.lex_state = 79},
+  [112] = {.lex_state = 61},
+  [113] = {.lex_state = 91},
+  [114] = {.lex_state = 57},
+  [115] = {.lex_state = 57},
+  [116] = {.lex_state = 48},
+  [117] = {.lex_state = 58},
+  [118] = {.lex_state = 55},
+  [119] = {.lex_state = 70},
+  [120] = {.lex_state = 55},
+  [121] = {.lex_state = 97},
+  [122] = {.lex_state = 42},
+  [123] = {.lex_state = 43},
+  [124] = {.lex_state = 86},
+  [125] = {.lex_state = 48},
+  [126] = {.lex_state = 58},
+  [127] = {.lex_state = 48},
+  [128] = {.lex_state = 98},
+  [129] = {.lex_state = 64},
+  [130] = {.lex_state = 100},
+  [131] = {.lex_state = 101},
+  [132] = {.lex_state = 102},
+  [133] = {.lex_state = 100},
+  [134] = {.lex_state = 89},
+  [135] = {.lex_state = 91},
+  [136] = {.lex_state = 100},
+  [137] = {.lex_state = 64},
+  [138] = {.lex_state = 42},
+  [139] = {.lex_state = 42},
+  [140] = {.lex_state = 91},
+  [141] = {.lex_state = 61},
+  [142] = {.lex_state = 42},
+  [143] = {.lex_state = 61},
+  [144] = {.lex_state = 91},
+  [145] = {.lex_state = 64},
+  [146] = {.lex_state = 92},
+  [147] = {.lex_state = 62},
+  [148] = {.lex_state = 103},
+  [149] = {.lex_state = 94},
+  [150] = {.lex_state = 94},
+  [151] = {.lex_state = 105},
+  [152] = {.lex_state = 55},
+  [153] = {.lex_state = 91},
+  [154] = {.lex_state = 94},
+  [155] = {.lex_state = 58},
+  [156] = {.lex_state = 54},
+  [157] = {.lex_state = 66},
+  [158] = {.lex_state = 58},
+  [159] = {.lex_state = 66},
+  [160] = {.lex_state = 58},
+  [161] = {.lex_state = 54},
+  [162] = {.lex_state = 66},
+  [163] = {.lex_state = 67},
+  [164] = {.lex_state = 67},
+  [165] = {.lex_state = 68},
+  [166] = {.lex_state = 83},
+  [167] = {.lex_state = 83},
+  [168] = {.lex_state = 79},
+  [169] = {.lex_state = 91},
+  [170] = {.lex_state = 61},
+  [171] = {.lex_state = 79},
+  [172] = {.lex_state = 61},
+  [173] = {.lex_state = 91},
+  [174] = {.lex_state = 61},
+  [175] = {.lex_state = 74},
+  [176] = {.lex_state = 74},
+  [177] = {.lex_state = 48},
+  [178] = {.lex_state = 70},
+  [179] = {.lex_state = 70},
+  [180] = {.lex_state = 79},
+  [181] = {.lex_state = 79},
+  [182] = {.lex_state = 81},
+  [183] = {.lex_state = 79},
+  [184] = {.lex_state = 79},
+  [185] = {.lex_state = 70},
+  [186] = {.lex_state = 70},
+  [187] = {.lex_state = 70},
+  [188] = {.lex_state = 86},
+  [189] = {.lex_state = 79},
+  [190] = {.lex_state = 106},
+  [191] = {.lex_state = 106},
+  [192] = {.lex_state = 107},
+  [193] = {.lex_state = 107},
+  [194] = {.lex_state = 78},
+  [195] = {.lex_state = 106},
+  [196] = {.lex_state = 86},
+  [197] = {.lex_state = 43},
+  [198] = {.lex_state = 86},
+  [199] = {.lex_state = 48},
+  [200] = {.lex_state = 47},
+  [201] = {.lex_state = 108},
+  [202] = {.lex_state = 108},
+  [203] = {.lex_state = 102},
+  [204] = {.lex_state = 101},
+  [205] = {.lex_state = 91},
+  [206] = {.lex_state = 89},
+  [207] = {.lex_state = 102},
+  [208] = {.lex_state = 66},
+  [209] = {.lex_state = 91},
+  [210] = {.lex_state = 108},
+  [211] = {.lex_state = 47},
+  [212] = {.lex_state = 91},
+  [213] = {.lex_state = 61},
+  [214] = {.lex_state = 42},
+  [215] = {.lex_state = 61},
+  [216] = {.lex_state = 91},
+  [217] = {.lex_state = 42},
+  [218] = {.lex_state = 61},
+  [219] = {.lex_state = 91},
+  [220] = {.lex_state = 42},
+  [221] = {.lex_state = 91},
+  [222] = {.lex_state = 61},
+  [223] = {.lex_state = 42},
+  [224] = {.lex_state = 61},
+  [225] = {.lex_state = 91},
+  [226] = {.lex_state = 47},
+  [227] = {.lex_state = 103},
+  [228] = {.lex_state = 94},
+  [229] = {.lex_state = 103},
+  [230] = {.lex_state = 94},
+  [231] = {.lex_state = 94},
+  [232] = {.lex_state = 94},
+  [233] = {.lex_state = 108},
+  [234] = {.lex_state = 91},
+  [235] = {.lex_state = 109},
+  [236] = {.lex_state = 105},
+  [237] = {.lex_state = 58},
+  [238] = {.lex_state = 58},
+  [239] = {.lex_state = 54},
+  [240] = {.lex_state = 58},
+  [241] = {.lex_state = 37},
+  [242] = {.lex_state = 61},
+  [243] = {.lex_state = 79},
+  [244] = {.lex_state = 61},
+  [245] = {.lex_state = 91},
+  [246] = {.lex_state = 79},
+  [247] = {.lex_state = 61},
+  [248] = {.lex_state = 91},
+  [249] = {.lex_state = 79},
+  [250] = {.lex_state = 91},
+  [251] = {.lex_state = 61},
+  [252] = {.lex_state = 79},
+  [253] = {.lex_state = 61},
+  [254] = {.lex_state = 91},
+  [255] = {.lex_state = 91},
+  [256] = {.lex_state = 74},
+  [257] = {.lex_state = 61},
+  [258] = {.lex_state = 48},
+  [259] = {.lex_state = 58},
+  [260] = {.lex_state = 48},
+  [261] = {.lex_state = 58},
+  [262] = {.lex_state = 55},
+  [263] = {.lex_state = 79},
+  [264] = {.lex_state = 86},
+  [265] = {.lex_state = 79},
+  [266] = {.lex_state = 110},
+  [267] = {.lex_state = 106},
+  [268] = {.lex_state = 111},
+  [269] = {.lex_state = 112},
+  [270] = {.lex_state = 111},
+  [271] = {.lex_state = 111},
+  [272] = {.lex_state = 91},
+  [273] = {.lex_state = 111},
+  [274] = {.lex_state = 111},
+  [275] = {.lex_state = 111},
+  [276] = {.lex_state = 111},
+  [277] = {.lex_state = 111},
+  [278] = {.lex_state = 91},
+  [279] = {.lex_state = 89},
+  [280] = {.lex_state = 102},
+  [281] = {.lex_state = 66},
+  [282] = {.lex_state = 91},
+  [283] = {.lex_state = 102},
+  [284] = {.lex_state = 89},
+  [285] = {.lex_state = 91},
+  [286] = {.lex_state = 102},
+  [287] = {.lex_state = 89},
+  [288] = {.lex_state = 102},
+  [289] = {.lex_state = 66},
+  [290] = {.lex_state = 91},
+  [291] = {.lex_state = 91},
+  [292] = {.lex_state = 42},
+  [293] = {.lex_state = 61},
+  [294] = {.lex_state = 42},
+  [295] = {.lex_state = 61},
+  [296] = {.lex_state = 42},
+  [297] = {.lex_state = 61},
+  [298] = {.lex_state = 42},
+  [299] = {.lex_state = 91},
+  [300] = {.lex_state = 42},
+  [301] = {.lex_state = 61},
+  [302] = {.lex_state = 42},
+  [303] = {.lex_state = 113},
+  [304] = {.lex_state = 113},
+  [305] = {.lex_state = 103},
+  [306] = {.lex_state = 94},
+  [307] = {.lex_state = 94},
+  [308] = {.lex_state = 103},
+  [309] = {.lex_state = 94},
+  [310] = {.lex_state = 103},
+  [311] = {.lex_state = 94},
+  [312] = {.lex_state = 94},
+  [313] = {.lex_state = 114},
+  [314] = {.lex_state = 115},
+  [315] = {.lex_state = 115},
+  [316] = {.lex_state = 115},
+  [317] = {.lex_state = 115},
+  [318] = {.lex_state = 115},
+  [319] = {.lex_state = 115},
+  [320] = {.lex_state = 115},
+  [321] = {.lex_state = 115},
+  [322] = {.lex_state = 91},
+  [323] = {.lex_state = 108},
+  [324] = {.lex_state = 91},
+  [325] = {.lex_state = 109},
+  [326] = {.lex_state = 58},
+  [327] = {.lex_state = 79},
+  [328] = {.lex_state = 61},
+  [329] = {.lex_state = 79},
+  [330] = {.lex_state = 61},
+  [331] = {.lex_state = 79},
+  [332] = {.lex_state = 61},
+  [333] = {.lex_state = 79},
+  [334] = {.lex_state = 91},
+  [335] = {.lex_state = 79},
+  [336] = {.lex_state = 61},
+  [337] = {.lex_state = 79},
+  [338] = {.lex_state = 113},
+  [339] = {.lex_state = 113},
+  [340] = {.lex_state = 61},
+  [341] = {.lex_state = 74},
+  [342] = {.lex_state = 61},
+  [343] = {.lex_state = 91},
+  [344] = {.lex_state = 91},
+  [345] = {.lex_state = 74},
+  [346] = {.lex_state = 48},
+  [347] = {.lex_state = 48},
+  [348] = {.lex_state = 86},
+  [349] = {.lex_state = 79},
+  [350] = {.lex_state = 86},
+  [351] = {.lex_state = 106},
+  [352] = {.lex_state = 106},
+  [353] = {.lex_state = 106},
+  [354] = {.lex_state = 106},
+  [355] = {.lex_state = 106},
+  [356] = {.lex_state = 116},
+  [357] = {.lex_state = 112},
+  [358] = {.lex_state = 117},
+  [359] = {.lex_state = 102},
+  [360] = {.lex_state = 89},
+  [361] = {.lex_state = 102},
+  [362] = {.lex_state = 89},
+  [363] = {.lex_state = 102},
+  [364] = {.lex_state = 66},
+  [365] = {.lex_state = 102},
+  [366] = {.lex_state = 91},
+  [367] = {.lex_state = 102},
+  [368] = {.lex_state = 89},
+  [369] = {.lex_state = 102},
+  [370] = {.lex_state = 118},
+  [371] = {.lex_state = 42},
+  [372] = {.lex_state = 42},
+  [373] = {.lex_state = 61},
+  [374] = {.lex_state = 42},
+  [375] = {.lex_state = 42},
+  [376] = {.lex_state = 113},
+  [377] = {.lex_state = 103},
+  [378] = {.lex_state = 103},
+  [379] = {.lex_state = 94},
+  [380] = {.lex_state = 103},
+  [381] = {.lex_state = 119},
+  [382] = {.lex_state = 120},
+  [383] = {.lex_state = 91},
+  [384] = {.lex_state = 109},
+  [385] = {.lex_state = 115},
+  [386] = {.lex_state = 91},
+  [387] = {.lex_state = 79},
+  [388] = {.lex_state = 79},
+  [389] = {.lex_state = 61},
+  [390] = {.lex_state = 79},
+  [391] = {.lex_state = 79},
+  [392] = {.lex_state = 113},
+  [393] = {.lex_state = 91},
+  [394] = {.lex_state = 74},
+  [395] = {.lex_state = 61},
+  [396] = {.lex_state = 91},
+  [397] = {.lex_state = 74},
+  [398] = {.lex_state = 61},
+  [399] = {.lex_state = 74},
+  [400] = {.lex_state = 61},
+  [401] = {.lex_state = 91},
+  [402] = {.lex_state = 61},
+  [403] = {.lex_state = 74},
+  [404] = {.lex_state = 61},
+  [405] = {.lex_state = 91},
+  [406] = {.lex_state = 116},
+  [407] = {.lex_state = 117},
+  [408] = {.lex_state = 112},
+  [409] = {.lex_state = 116},
+  [410] = {.lex_state = 121},
+  [411] = {.lex_state = 117},
+  [412] = {.lex_state = 102},
+  [413] = {.lex_state = 102},
+  [414] = {.lex_state = 89},
+  [415] = {.lex_state = 102},
+  [416] = {.lex_state = 102},
+  [417] = {.lex_state = 118},
+  [418] = {.lex_state = 42},
+  [419] = {.lex_state = 103},
+  [420] = {.lex_state = 115},
+  [421] = {.lex_state = 91},
+  [422] = {.lex_state = 91},
+  [423] = {.lex_state = 109},
+  [424] = {.lex_state = 79},
+  [425] = {.lex_state = 91},
+  [426] = {.lex_state = 74},
+  [427] = {.lex_state = 74},
+  [428] = {.lex_state = 61},
+  [429] = {.lex_state = 74},
+  [430] = {.lex_state = 113},
+  [431] = {.lex_state = 113},
+  [432] = {.lex_state = 74},
+  [433] = {.lex_state = 61},
+  [434] = {.lex_state = 74},
+  [435] = {.lex_state = 61},
+  [436] = {.lex_state = 74},
+  [437] = {.lex_state = 61},
+  [438] = {.lex_state = 112},
+  [439] = {.lex_state = 116},
+  [440] = {.lex_state = 121},
+  [441] = {.lex_state = 117},
+  [442] = {.lex_state = 116},
+  [443] = {.lex_state = 112},
+  [444] = {.lex_state = 117},
+  [445] = {.lex_state = 116},
+  [446] = {.lex_state = 112},
+  [447] = {.lex_state = 116},
+  [448] = {.lex_state = 121},
+  [449] = {.lex_state = 117},
+  [450] = {.lex_state = 102},
+  [451] = {.lex_state = 91},
+  [452] = {.lex_state = 74},
+  [453] = {.lex_state = 113},
+  [454] = {.lex_state = 74},
+  [455] = {.lex_state = 74},
+  [456] = {.lex_state = 61},
+  [457] = {.lex_state = 74},
+  [458] = {.lex_state = 116},
+  [459] = {.lex_state = 112},
+  [460] = {.lex_state = 116},
+  [461] = {.lex_state = 112},
+  [462] = {.lex_state = 116},
+  [463] = {.lex_state = 121},
+  [464] = {.lex_state = 116},
+  [465] = {.lex_state = 117},
+  [466] = {.lex_state = 116},
+  [467] = {.lex_state = 112},
+  [468] = {.lex_state = 116},
+  [469] = {.lex_state = 122},
+  [470] = {.lex_state = 74},
+  [471] = {.lex_state = 116},
+  [472] = {.lex_state = 116},
+  [473] = {.lex_state = 112},
+  [474] = {.lex_state = 116},
+  [475] = {.lex_state = 116},
+  [476] = {.lex_state = 122},
+  [477] = {.lex_state = 116},
+};
+
+static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
+  [STATE(0)] = {
+    [ts_builtin_sym_end] = ACTIONS(1),
+    [sym_identifier] = ACTIONS(1),
+    [sym_comment] = ACTIONS(3),
+    [aux_sym__nls_token1] = ACTIONS(1),
+    [anon_sym_AT] = ACTIONS(1),
+    [anon_sym_from] = ACTIONS(1),
+    [anon_sym_import] = ACTIONS(1),
+    [anon_sym_LBRACE] = ACTIONS(1),
+    [anon_sym_COMMA] = ACTIONS(1),
+    [anon_sym_RBRACE] = ACTIONS(1),
+    [anon_sym_as] = ACTIONS(1),
+    [anon_sym_extends] = ACTIONS(1),
+    [anon_sym_DASH] = ACTIONS(1),
+    [anon_sym_POUND] = ACTIONS(1),
+    [aux_sym_entity_id_token1] = ACTIONS(1),
+    [anon_sym_COLON] = ACTIONS(1),
+    [anon_sym_pick] = ACTIONS(1),
+    [anon_sym_omit] = ACTIONS(1),
+    [anon_sym_LT] = ACTIONS(1),
+    [anon_sym_GT] = ACTIONS(1),
+    [anon_sym_QMARK] = ACTIONS(1),
+    [anon_sym_EQ] = ACTIONS(1),
+    [anon_sym_LPAREN] = ACTIONS(1),
+    [anon_sym_RPAREN] = ACTIONS(1),
+    [anon_sym_PIPE] = ACTIONS(1),
+    [anon_sym_DOT] = ACTIONS(1),
+    [anon_sym_LBRACK] = ACTIONS(1),
+    [anon_sym_RBRACK] = ACTIONS(1),
+    [anon_sym_DQUOTE] = ACTIONS(1),
+    [sym_escape_sequence] = ACTIONS(1),
+    [sym_number_literal] = ACTIONS(1),
+    [anon_sym_true] = ACTIONS(1),
+    [anon_sym_false] = ACTIONS(1),
+    [sym_null_literal] = ACTIONS(1),
+  },
+  [STATE(1)] = {
+    [sym_source_file] = STATE(8),
+    [sym__directive] = STATE(9),
+    [sym__definition] = STATE(10),
+    [aux_sym__nls] = STATE(11),
+    [sym_plugin_import] = STATE(12),
+    [sym_template_import] = STATE(13),
+    [sym_extends_template] = STATE(14),
+    [sym_model_removal] = STATE(15),
+    [sym_type_alias] = STATE(16),
+    [sym_model_projection] = STATE(17),
+    [sym_model_definition] = STATE(18),
+    [aux_sym_source_file_repeat1] = STATE(19),
+    [aux_sym_source_file_repeat2] = STATE(20),
+    [ts_builtin_sym_end] = ACTIONS(5),
+    [sym_identifier] = ACTIONS(7),
+    [sym_comment] = ACTIONS(3),
+    [aux_sym__nls_token1] = ACTIONS(9),
+    [anon_sym_AT] = ACTIONS(11),
+    [anon_sym_import] = ACTIONS(13),
+    [anon_sym_extends] = ACTIONS(15),
+    [anon_sym_DASH] = ACTIONS(17),
+  },
+};
+
+static const uint16_t ts_small_parse_table[] = {
+  [0] = 8,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(19), 1,
+      anon_sym_LBRACE,
+    ACTIONS(21), 1,
+      anon_sym_extends,
+    ACTIONS(23), 1,
+      anon_sym_COLON,
+    ACTIONS(25), 1,
+      anon_sym_LT,
+    STATE(25), 1,
+      sym_extends_clause,
+    STATE(26), 1,
+      sym_type_parameters,
+    STATE(27), 1,
+      sym_model_body,
+  [25] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(29), 3,
+      sym_identifier,
+      anon_sym_import,
+      anon_sym_extends,
+    ACTIONS(27), 6,
+      ts_builtin_sym_end,
+      aux_sym__nls_token1,
+      anon_sym_AT,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_RBRACK,
+  [42] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(31), 1,
+      sym_identifier,
+  [49] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(33), 1,
+      sym_identifier,
+    ACTIONS(35), 1,
+      anon_sym_LBRACE,
+    STATE(31), 1,
+      sym_import_names,
+  [62] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    STATE(33), 1,
+      sym_string_literal,
+  [72] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(39), 1,
+      sym_identifier,
+  [79] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(41), 1,
+      ts_builtin_sym_end,
+  [86] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    STATE(35), 1,
+      aux_sym__nls,
+  [96] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    STATE(36), 1,
+      aux_sym__nls,
+    ACTIONS(43), 3,
+      ts_builtin_sym_end,
+      sym_identifier,
+      anon_sym_DASH,
+  [111] = 20,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(7), 1,
+      sym_identifier,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(11), 1,
+      anon_sym_AT,
+    ACTIONS(13), 1,
+      anon_sym_import,
+    ACTIONS(15), 1,
+      anon_sym_extends,
+    ACTIONS(17), 1,
+      anon_sym_DASH,
+    ACTIONS(45), 1,
+      ts_builtin_sym_end,
+    STATE(9), 1,
+      sym__directive,
+    STATE(10), 1,
+      sym__definition,
+    STATE(12), 1,
+      sym_plugin_import,
+    STATE(13), 1,
+      sym_template_import,
+    STATE(14), 1,
+      sym_extends_template,
+    STATE(15), 1,
+      sym_model_removal,
+    STATE(16), 1,
+      sym_type_alias,
+    STATE(17), 1,
+      sym_model_projection,
+    STATE(18), 1,
+      sym_model_definition,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(38), 1,
+      aux_sym_source_file_repeat1,
+    STATE(39), 1,
+      aux_sym_source_file_repeat2,
+  [172] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(47), 1,
+      aux_sym__nls_token1,
+  [179] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(47), 1,
+      aux_sym__nls_token1,
+  [186] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(47), 1,
+      aux_sym__nls_token1,
+  [193] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(49), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [203] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(49), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [213] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(49), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [223] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(49), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [233] = 18,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(7), 1,
+      sym_identifier,
+    ACTIONS(11), 1,
+      anon_sym_AT,
+    ACTIONS(13), 1,
+      anon_sym_import,
+    ACTIONS(15), 1,
+      anon_sym_extends,
+    ACTIONS(17), 1,
+      anon_sym_DASH,
+    ACTIONS(45), 1,
+      ts_builtin_sym_end,
+    STATE(9), 1,
+      sym__directive,
+    STATE(10), 1,
+      sym__definition,
+    STATE(12), 1,
+      sym_plugin_import,
+    STATE(13), 1,
+      sym_template_import,
+    STATE(14), 1,
+      sym_extends_template,
+    STATE(15), 1,
+      sym_model_removal,
+    STATE(16), 1,
+      sym_type_alias,
+    STATE(17), 1,
+      sym_model_projection,
+    STATE(18), 1,
+      sym_model_definition,
+    STATE(40), 1,
+      aux_sym_source_file_repeat1,
+    STATE(41), 1,
+      aux_sym_source_file_repeat2,
+  [288] = 10,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(17), 1,
+      anon_sym_DASH,
+    ACTIONS(45), 1,
+      ts_builtin_sym_end,
+    ACTIONS(51), 1,
+      sym_identifier,
+    STATE(10), 1,
+      sym__definition,
+    STATE(15), 1,
+      sym_model_removal,
+    STATE(16), 1,
+      sym_type_alias,
+    STATE(17), 1,
+      sym_model_projection,
+    STATE(18), 1,
+      sym_model_definition,
+    STATE(42), 1,
+      aux_sym_source_file_repeat2,
+  [319] = 12,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(53), 1,
+      sym_identifier,
+    ACTIONS(55), 1,
+      anon_sym_AT,
+    ACTIONS(57), 1,
+      anon_sym_RBRACE,
+    ACTIONS(59), 1,
+      anon_sym_DASH,
+    STATE(47), 1,
+      aux_sym__nls,
+    STATE(48), 1,
+      sym__model_member,
+    STATE(49), 1,
+      sym_field_removal,
+    STATE(50), 1,
+      sym_field_override,
+    STATE(51), 1,
+      sym_field_definition,
+    STATE(52), 1,
+      sym_plugin_config,
+  [356] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(61), 1,
+      sym_identifier,
+  [363] = 22,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(63), 1,
+      sym_identifier,
+    ACTIONS(65), 1,
+      anon_sym_LBRACE,
+    ACTIONS(67), 1,
+      anon_sym_pick,
+    ACTIONS(69), 1,
+      anon_sym_omit,
+    ACTIONS(71), 1,
+      sym_number_literal,
+    ACTIONS(73), 1,
+      anon_sym_true,
+    ACTIONS(75), 1,
+      anon_sym_false,
+    ACTIONS(77), 1,
+      sym_null_literal,
+    STATE(62), 1,
+      sym__type_expression,
+    STATE(63), 1,
+      sym_union_type,
+    STATE(64), 1,
+      sym__union_member,
+    STATE(65), 1,
+      sym_generic_type,
+    STATE(66), 1,
+      sym_object_type,
+    STATE(67), 1,
+      sym_type_identifier,
+    STATE(68), 1,
+      sym_qualified_identifier,
+    STATE(69), 1,
+      sym__base_type,
+    STATE(70), 1,
+      sym_map_type,
+    STATE(71), 1,
+      sym_array_type,
+    STATE(72), 1,
+      sym_string_literal,
+    STATE(73), 1,
+      sym_boolean_literal,
+  [430] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(79), 1,
+      sym_identifier,
+  [437] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(19), 1,
+      anon_sym_LBRACE,
+    STATE(75), 1,
+      sym_model_body,
+  [447] = 5,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(19), 1,
+      anon_sym_LBRACE,
+    ACTIONS(21), 1,
+      anon_sym_extends,
+    STATE(76), 1,
+      sym_extends_clause,
+    STATE(77), 1,
+      sym_model_body,
+  [463] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(83), 1,
+      anon_sym_POUND,
+    STATE(79), 1,
+      sym_entity_id,
+    ACTIONS(81), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [479] = 5,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(85), 1,
+      aux_sym__nls_token1,
+    ACTIONS(87), 1,
+      anon_sym_from,
+    ACTIONS(89), 1,
+      anon_sym_LBRACE,
+    STATE(82), 1,
+      sym_object_literal,
+  [495] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(91), 1,
+      anon_sym_from,
+  [502] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(93), 1,
+      sym_identifier,
+    ACTIONS(95), 1,
+      anon_sym_RBRACE,
+    STATE(86), 1,
+      aux_sym__nls,
+    STATE(87), 1,
+      sym_import_name,
+  [521] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(97), 1,
+      anon_sym_from,
+  [528] = 5,
+    ACTIONS(99), 1,
+      sym_comment,
+    ACTIONS(101), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(103), 1,
+      sym_string_content,
+    ACTIONS(105), 1,
+      sym_escape_sequence,
+    STATE(92), 1,
+      aux_sym_string_literal_repeat1,
+  [544] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(89), 1,
+      anon_sym_LBRACE,
+    ACTIONS(107), 1,
+      aux_sym__nls_token1,
+    STATE(93), 1,
+      sym_object_literal,
+  [557] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(109), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [567] = 5,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    STATE(37), 1,
+      aux_sym__nls,
+    ACTIONS(111), 3,
+      ts_builtin_sym_end,
+      anon_sym_AT,
+      anon_sym_DASH,
+    ACTIONS(113), 3,
+      sym_identifier,
+      anon_sym_import,
+      anon_sym_extends,
+  [587] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    STATE(37), 1,
+      aux_sym__nls,
+    ACTIONS(115), 3,
+      ts_builtin_sym_end,
+      sym_identifier,
+      anon_sym_DASH,
+  [602] = 5,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(121), 1,
+      aux_sym__nls_token1,
+    STATE(37), 1,
+      aux_sym__nls,
+    ACTIONS(119), 3,
+      sym_identifier,
+      anon_sym_import,
+      anon_sym_extends,
+    ACTIONS(117), 5,
+      ts_builtin_sym_end,
+      anon_sym_AT,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_RBRACK,
+  [624] = 18,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(7), 1,
+      sym_identifier,
+    ACTIONS(11), 1,
+      anon_sym_AT,
+    ACTIONS(13), 1,
+      anon_sym_import,
+    ACTIONS(15), 1,
+      anon_sym_extends,
+    ACTIONS(17), 1,
+      anon_sym_DASH,
+    ACTIONS(124), 1,
+      ts_builtin_sym_end,
+    STATE(9), 1,
+      sym__directive,
+    STATE(10), 1,
+      sym__definition,
+    STATE(12), 1,
+      sym_plugin_import,
+    STATE(13), 1,
+      sym_template_import,
+    STATE(14), 1,
+      sym_extends_template,
+    STATE(15), 1,
+      sym_model_removal,
+    STATE(16), 1,
+      sym_type_alias,
+    STATE(17), 1,
+      sym_model_projection,
+    STATE(18), 1,
+      sym_model_definition,
+    STATE(40), 1,
+      aux_sym_source_file_repeat1,
+    STATE(94), 1,
+      aux_sym_source_file_repeat2,
+  [679] = 10,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(17), 1,
+      anon_sym_DASH,
+    ACTIONS(51), 1,
+      sym_identifier,
+    ACTIONS(124), 1,
+      ts_builtin_sym_end,
+    STATE(10), 1,
+      sym__definition,
+    STATE(15), 1,
+      sym_model_removal,
+    STATE(16), 1,
+      sym_type_alias,
+    STATE(17), 1,
+      sym_model_projection,
+    STATE(18), 1,
+      sym_model_definition,
+    STATE(42), 1,
+      aux_sym_source_file_repeat2,
+  [710] = 11,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(113), 1,
+      sym_identifier,
+    ACTIONS(126), 1,
+      anon_sym_AT,
+    ACTIONS(129), 1,
+      anon_sym_import,
+    ACTIONS(132), 1,
+      anon_sym_extends,
+    STATE(9), 1,
+      sym__directive,
+    STATE(12), 1,
+      sym_plugin_import,
+    STATE(13), 1,
+      sym_template_import,
+    STATE(14), 1,
+      sym_extends_template,
+    STATE(40), 1,
+      aux_sym_source_file_repeat1,
+    ACTIONS(111), 2,
+      ts_builtin_sym_end,
+      anon_sym_DASH,
+  [745] = 10,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(17), 1,
+      anon_sym_DASH,
+    ACTIONS(51), 1,
+      sym_identifier,
+    ACTIONS(124), 1,
+      ts_builtin_sym_end,
+    STATE(10), 1,
+      sym__definition,
+    STATE(15), 1,
+      sym_model_removal,
+    STATE(16), 1,
+      sym_type_alias,
+    STATE(17), 1,
+      sym_model_projection,
+    STATE(18), 1,
+      sym_model_definition,
+    STATE(42), 1,
+      aux_sym_source_file_repeat2,
+  [776] = 10,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(115), 1,
+      ts_builtin_sym_end,
+    ACTIONS(135), 1,
+      sym_identifier,
+    ACTIONS(138), 1,
+      anon_sym_DASH,
+    STATE(10), 1,
+      sym__definition,
+    STATE(15), 1,
+      sym_model_removal,
+    STATE(16), 1,
+      sym_type_alias,
+    STATE(17), 1,
+      sym_model_projection,
+    STATE(18), 1,
+      sym_model_definition,
+    STATE(42), 1,
+      aux_sym_source_file_repeat2,
+  [807] = 8,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(83), 1,
+      anon_sym_POUND,
+    ACTIONS(143), 1,
+      anon_sym_LBRACE,
+    ACTIONS(145), 1,
+      anon_sym_COLON,
+    ACTIONS(147), 1,
+      anon_sym_QMARK,
+    STATE(98), 1,
+      sym_entity_id,
+    STATE(99), 1,
+      sym_plugin_block,
+    ACTIONS(141), 2,
+      aux_sym__nls_token1,
+      anon_sym_RBRACE,
+  [833] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(149), 1,
+      sym_identifier,
+  [840] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(151), 5,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [851] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(153), 1,
+      sym_identifier,
+  [858] = 12,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(53), 1,
+      sym_identifier,
+    ACTIONS(55), 1,
+      anon_sym_AT,
+    ACTIONS(59), 1,
+      anon_sym_DASH,
+    ACTIONS(155), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(49), 1,
+      sym_field_removal,
+    STATE(50), 1,
+      sym_field_override,
+    STATE(51), 1,
+      sym_field_definition,
+    STATE(52), 1,
+      sym_plugin_config,
+    STATE(103), 1,
+      sym__model_member,
+  [895] = 5,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(157), 1,
+      anon_sym_RBRACE,
+    STATE(105), 1,
+      aux_sym__nls,
+    STATE(106), 1,
+      aux_sym_model_body_repeat1,
+  [911] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(159), 2,
+      aux_sym__nls_token1,
+      anon_sym_RBRACE,
+  [919] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(159), 2,
+      aux_sym__nls_token1,
+      anon_sym_RBRACE,
+  [927] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(159), 2,
+      aux_sym__nls_token1,
+      anon_sym_RBRACE,
+  [935] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(159), 2,
+      aux_sym__nls_token1,
+      anon_sym_RBRACE,
+  [943] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(161), 1,
+      anon_sym_LBRACE,
+    ACTIONS(163), 1,
+      anon_sym_COMMA,
+    STATE(108), 1,
+      aux_sym_extends_clause_repeat1,
+  [956] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(167), 1,
+      anon_sym_DOT,
+    ACTIONS(165), 14,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_LT,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+      anon_sym_RBRACK,
+  [979] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(169), 1,
+      sym_identifier,
+    ACTIONS(171), 1,
+      anon_sym_RBRACE,
+    STATE(112), 1,
+      aux_sym__nls,
+    STATE(113), 1,
+      sym_field_definition,
+  [998] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(173), 1,
+      sym_identifier,
+  [1005] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(175), 1,
+      sym_identifier,
+  [1012] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(179), 1,
+      anon_sym_PIPE,
+    ACTIONS(177), 10,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+  [1031] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(181), 12,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_RBRACK,
+  [1049] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(181), 12,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_RBRACK,
+  [1067] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(179), 11,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+  [1084] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(83), 1,
+      anon_sym_POUND,
+    ACTIONS(143), 1,
+      anon_sym_LBRACE,
+    STATE(116), 1,
+      sym_entity_id,
+    STATE(117), 1,
+      sym_plugin_block,
+    ACTIONS(183), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [1106] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(177), 10,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+  [1122] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(185), 1,
+      anon_sym_PIPE,
+    STATE(119), 1,
+      aux_sym_union_type_repeat1,
+  [1132] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(179), 1,
+      anon_sym_PIPE,
+    ACTIONS(187), 1,
+      anon_sym_LBRACK,
+    ACTIONS(177), 10,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+  [1154] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(179), 1,
+      anon_sym_PIPE,
+    ACTIONS(187), 1,
+      anon_sym_LBRACK,
+    ACTIONS(177), 10,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+  [1176] = 5,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(179), 1,
+      anon_sym_PIPE,
+    ACTIONS(187), 1,
+      anon_sym_LBRACK,
+    ACTIONS(189), 1,
+      anon_sym_LT,
+    ACTIONS(177), 10,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+  [1201] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(165), 14,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_LT,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+      anon_sym_RBRACK,
+  [1221] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(191), 1,
+      anon_sym_LBRACK,
+  [1228] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(179), 1,
+      anon_sym_PIPE,
+    ACTIONS(187), 1,
+      anon_sym_LBRACK,
+    ACTIONS(177), 10,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+  [1250] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(179), 1,
+      anon_sym_PIPE,
+    ACTIONS(187), 1,
+      anon_sym_LBRACK,
+    ACTIONS(177), 10,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+  [1272] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(179), 1,
+      anon_sym_PIPE,
+    ACTIONS(177), 10,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+  [1291] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(179), 1,
+      anon_sym_PIPE,
+    ACTIONS(177), 10,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+  [1310] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(193), 1,
+      anon_sym_COMMA,
+    ACTIONS(195), 1,
+      anon_sym_GT,
+    STATE(124), 1,
+      aux_sym_type_parameters_repeat1,
+  [1323] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(83), 1,
+      anon_sym_POUND,
+    STATE(125), 1,
+      sym_entity_id,
+    ACTIONS(197), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [1339] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(19), 1,
+      anon_sym_LBRACE,
+    STATE(126), 1,
+      sym_model_body,
+  [1349] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(83), 1,
+      anon_sym_POUND,
+    STATE(127), 1,
+      sym_entity_id,
+    ACTIONS(199), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [1365] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(201), 1,
+      aux_sym_entity_id_token1,
+  [1372] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(203), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [1382] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    STATE(129), 1,
+      sym_string_literal,
+  [1392] = 9,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(205), 1,
+      sym_identifier,
+    ACTIONS(207), 1,
+      aux_sym__nls_token1,
+    ACTIONS(209), 1,
+      anon_sym_RBRACE,
+    ACTIONS(211), 1,
+      sym_number_literal,
+    STATE(134), 1,
+      aux_sym__nls,
+    STATE(135), 1,
+      sym_object_entry,
+    STATE(136), 1,
+      sym_string_literal,
+  [1420] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(213), 1,
+      aux_sym__nls_token1,
+  [1427] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    STATE(137), 1,
+      sym_string_literal,
+  [1437] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(217), 1,
+      anon_sym_as,
+    ACTIONS(215), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [1449] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(219), 1,
+      anon_sym_from,
+  [1456] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(93), 1,
+      sym_identifier,
+    ACTIONS(221), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(140), 1,
+      sym_import_name,
+  [1475] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(223), 1,
+      anon_sym_COMMA,
+    ACTIONS(225), 1,
+      anon_sym_RBRACE,
+    STATE(143), 1,
+      aux_sym__nls,
+    STATE(144), 1,
+      aux_sym_import_names_repeat1,
+  [1494] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    STATE(145), 1,
+      sym_string_literal,
+  [1504] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(227), 13,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_COLON,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_RBRACK,
+  [1523] = 3,
+    ACTIONS(99), 1,
+      sym_comment,
+    ACTIONS(231), 1,
+      sym_string_content,
+    ACTIONS(229), 2,
+      anon_sym_DQUOTE,
+      sym_escape_sequence,
+  [1534] = 3,
+    ACTIONS(99), 1,
+      sym_comment,
+    ACTIONS(231), 1,
+      sym_string_content,
+    ACTIONS(229), 2,
+      anon_sym_DQUOTE,
+      sym_escape_sequence,
+  [1545] = 5,
+    ACTIONS(99), 1,
+      sym_comment,
+    ACTIONS(103), 1,
+      sym_string_content,
+    ACTIONS(105), 1,
+      sym_escape_sequence,
+    ACTIONS(233), 1,
+      anon_sym_DQUOTE,
+    STATE(147), 1,
+      aux_sym_string_literal_repeat1,
+  [1561] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(235), 1,
+      aux_sym__nls_token1,
+  [1568] = 10,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(17), 1,
+      anon_sym_DASH,
+    ACTIONS(51), 1,
+      sym_identifier,
+    ACTIONS(237), 1,
+      ts_builtin_sym_end,
+    STATE(10), 1,
+      sym__definition,
+    STATE(15), 1,
+      sym_model_removal,
+    STATE(16), 1,
+      sym_type_alias,
+    STATE(17), 1,
+      sym_model_projection,
+    STATE(18), 1,
+      sym_model_definition,
+    STATE(42), 1,
+      aux_sym_source_file_repeat2,
+  [1599] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(55), 1,
+      anon_sym_AT,
+    ACTIONS(239), 1,
+      anon_sym_RBRACE,
+    STATE(149), 1,
+      aux_sym__nls,
+    STATE(150), 1,
+      sym_plugin_config,
+  [1618] = 20,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(63), 1,
+      sym_identifier,
+    ACTIONS(65), 1,
+      anon_sym_LBRACE,
+    ACTIONS(71), 1,
+      sym_number_literal,
+    ACTIONS(73), 1,
+      anon_sym_true,
+    ACTIONS(75), 1,
+      anon_sym_false,
+    ACTIONS(77), 1,
+      sym_null_literal,
+    STATE(63), 1,
+      sym_union_type,
+    STATE(64), 1,
+      sym__union_member,
+    STATE(65), 1,
+      sym_generic_type,
+    STATE(66), 1,
+      sym_object_type,
+    STATE(67), 1,
+      sym_type_identifier,
+    STATE(68), 1,
+      sym_qualified_identifier,
+    STATE(69), 1,
+      sym__base_type,
+    STATE(70), 1,
+      sym_map_type,
+    STATE(71), 1,
+      sym_array_type,
+    STATE(72), 1,
+      sym_string_literal,
+    STATE(73), 1,
+      sym_boolean_literal,
+    STATE(151), 1,
+      sym__type_expression,
+  [1679] = 5,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(83), 1,
+      anon_sym_POUND,
+    ACTIONS(243), 1,
+      anon_sym_COLON,
+    STATE(153), 1,
+      sym_entity_id,
+    ACTIONS(241), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [1697] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(245), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [1706] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(247), 2,
+      aux_sym__nls_token1,
+      anon_sym_RBRACE,
+  [1714] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(89), 1,
+      anon_sym_LBRACE,
+    STATE(154), 1,
+      sym_object_literal,
+  [1724] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(249), 2,
+      aux_sym__nls_token1,
+      anon_sym_RBRACE,
+  [1732] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(251), 5,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [1743] = 5,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(253), 1,
+      anon_sym_RBRACE,
+    STATE(156), 1,
+      aux_sym__nls,
+    STATE(157), 1,
+      aux_sym_model_body_repeat1,
+  [1759] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(251), 5,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [1770] = 12,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(53), 1,
+      sym_identifier,
+    ACTIONS(55), 1,
+      anon_sym_AT,
+    ACTIONS(59), 1,
+      anon_sym_DASH,
+    ACTIONS(255), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(49), 1,
+      sym_field_removal,
+    STATE(50), 1,
+      sym_field_override,
+    STATE(51), 1,
+      sym_field_definition,
+    STATE(52), 1,
+      sym_plugin_config,
+    STATE(159), 1,
+      sym__model_member,
+  [1807] = 5,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(257), 1,
+      anon_sym_RBRACE,
+    STATE(161), 1,
+      aux_sym__nls,
+    STATE(162), 1,
+      aux_sym_model_body_repeat1,
+  [1823] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(259), 1,
+      sym_identifier,
+  [1830] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(163), 1,
+      anon_sym_COMMA,
+    ACTIONS(261), 1,
+      anon_sym_LBRACE,
+    STATE(164), 1,
+      aux_sym_extends_clause_repeat1,
+  [1843] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(263), 1,
+      sym_identifier,
+    STATE(166), 1,
+      sym_qualified_identifier,
+    STATE(167), 1,
+      sym__qualified_name_rest,
+  [1856] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(83), 1,
+      anon_sym_POUND,
+    ACTIONS(145), 1,
+      anon_sym_COLON,
+    ACTIONS(147), 1,
+      anon_sym_QMARK,
+    STATE(98), 1,
+      sym_entity_id,
+    ACTIONS(141), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [1877] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(265), 12,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+  [1895] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(169), 1,
+      sym_identifier,
+    ACTIONS(267), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(169), 1,
+      sym_field_definition,
+  [1914] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(269), 1,
+      anon_sym_COMMA,
+    ACTIONS(271), 1,
+      anon_sym_RBRACE,
+    STATE(172), 1,
+      aux_sym__nls,
+    STATE(173), 1,
+      aux_sym_object_type_repeat1,
+  [1933] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(273), 1,
+      anon_sym_LBRACE,
+    STATE(175), 1,
+      sym_projection_fields,
+  [1943] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(273), 1,
+      anon_sym_LBRACE,
+    STATE(176), 1,
+      sym_projection_fields,
+  [1953] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(275), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [1963] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(83), 1,
+      anon_sym_POUND,
+    STATE(177), 1,
+      sym_entity_id,
+    ACTIONS(277), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [1979] = 18,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(63), 1,
+      sym_identifier,
+    ACTIONS(65), 1,
+      anon_sym_LBRACE,
+    ACTIONS(73), 1,
+      anon_sym_true,
+    ACTIONS(75), 1,
+      anon_sym_false,
+    ACTIONS(77), 1,
+      sym_null_literal,
+    ACTIONS(279), 1,
+      sym_number_literal,
+    STATE(68), 1,
+      sym_qualified_identifier,
+    STATE(69), 1,
+      sym__base_type,
+    STATE(179), 1,
+      sym__union_member,
+    STATE(180), 1,
+      sym_generic_type,
+    STATE(181), 1,
+      sym_object_type,
+    STATE(182), 1,
+      sym_type_identifier,
+    STATE(183), 1,
+      sym_map_type,
+    STATE(184), 1,
+      sym_array_type,
+    STATE(185), 1,
+      sym_string_literal,
+    STATE(186), 1,
+      sym_boolean_literal,
+  [2034] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(185), 1,
+      anon_sym_PIPE,
+    STATE(187), 1,
+      aux_sym_union_type_repeat1,
+    ACTIONS(281), 10,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+  [2056] = 20,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(63), 1,
+      sym_identifier,
+    ACTIONS(65), 1,
+      anon_sym_LBRACE,
+    ACTIONS(71), 1,
+      sym_number_literal,
+    ACTIONS(73), 1,
+      anon_sym_true,
+    ACTIONS(75), 1,
+      anon_sym_false,
+    ACTIONS(77), 1,
+      sym_null_literal,
+    STATE(63), 1,
+      sym_union_type,
+    STATE(64), 1,
+      sym__union_member,
+    STATE(65), 1,
+      sym_generic_type,
+    STATE(66), 1,
+      sym_object_type,
+    STATE(67), 1,
+      sym_type_identifier,
+    STATE(68), 1,
+      sym_qualified_identifier,
+    STATE(69), 1,
+      sym__base_type,
+    STATE(70), 1,
+      sym_map_type,
+    STATE(71), 1,
+      sym_array_type,
+    STATE(72), 1,
+      sym_string_literal,
+    STATE(73), 1,
+      sym_boolean_literal,
+    STATE(188), 1,
+      sym__type_expression,
+  [2117] = 11,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(283), 1,
+      sym_identifier,
+    ACTIONS(285), 1,
+      anon_sym_RBRACK,
+    ACTIONS(287), 1,
+      sym_number_literal,
+    STATE(68), 1,
+      sym_qualified_identifier,
+    STATE(191), 1,
+      sym_type_identifier,
+    STATE(192), 1,
+      sym__key_type_expression,
+    STATE(193), 1,
+      sym_key_union_type,
+    STATE(194), 1,
+      sym__key_union_member,
+    STATE(195), 1,
+      sym_string_literal,
+  [2151] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(289), 1,
+      sym_identifier,
+  [2158] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(291), 2,
+      anon_sym_LBRACE,
+      anon_sym_extends,
+  [2166] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(193), 1,
+      anon_sym_COMMA,
+    ACTIONS(293), 1,
+      anon_sym_GT,
+    STATE(198), 1,
+      aux_sym_type_parameters_repeat1,
+  [2179] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(295), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [2189] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(83), 1,
+      anon_sym_POUND,
+    STATE(199), 1,
+      sym_entity_id,
+    ACTIONS(297), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [2205] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(299), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [2215] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(301), 6,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+  [2227] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(89), 1,
+      anon_sym_LBRACE,
+    ACTIONS(303), 1,
+      aux_sym__nls_token1,
+    STATE(200), 1,
+      sym_object_literal,
+  [2240] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(305), 1,
+      anon_sym_COLON,
+  [2247] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(29), 4,
+      sym_identifier,
+      anon_sym_true,
+      anon_sym_false,
+      sym_null_literal,
+    ACTIONS(27), 7,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_RBRACE,
+      anon_sym_LBRACK,
+      anon_sym_RBRACK,
+      anon_sym_DQUOTE,
+      sym_number_literal,
+  [2266] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(307), 7,
+      aux_sym__nls_token1,
+      anon_sym_AT,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [2279] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(309), 1,
+      anon_sym_COLON,
+  [2286] = 9,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(205), 1,
+      sym_identifier,
+    ACTIONS(207), 1,
+      aux_sym__nls_token1,
+    ACTIONS(211), 1,
+      sym_number_literal,
+    ACTIONS(311), 1,
+      anon_sym_RBRACE,
+    STATE(136), 1,
+      sym_string_literal,
+    STATE(204), 1,
+      aux_sym__nls,
+    STATE(205), 1,
+      sym_object_entry,
+  [2314] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(313), 1,
+      anon_sym_COMMA,
+    ACTIONS(315), 1,
+      anon_sym_RBRACE,
+    STATE(208), 1,
+      aux_sym__nls,
+    STATE(209), 1,
+      aux_sym_object_literal_repeat1,
+  [2333] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(317), 1,
+      anon_sym_COLON,
+  [2340] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(89), 1,
+      anon_sym_LBRACE,
+    ACTIONS(319), 1,
+      aux_sym__nls_token1,
+    STATE(211), 1,
+      sym_object_literal,
+  [2353] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(321), 1,
+      sym_identifier,
+  [2360] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(323), 1,
+      anon_sym_from,
+  [2367] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(325), 1,
+      anon_sym_COMMA,
+    ACTIONS(327), 1,
+      anon_sym_RBRACE,
+    STATE(215), 1,
+      aux_sym__nls,
+    STATE(216), 1,
+      aux_sym_import_names_repeat1,
+  [2386] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(93), 1,
+      sym_identifier,
+    ACTIONS(329), 1,
+      anon_sym_RBRACE,
+    STATE(218), 1,
+      aux_sym__nls,
+    STATE(219), 1,
+      sym_import_name,
+  [2405] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(331), 1,
+      anon_sym_from,
+  [2412] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(93), 1,
+      sym_identifier,
+    ACTIONS(333), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(221), 1,
+      sym_import_name,
+  [2431] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(335), 1,
+      anon_sym_COMMA,
+    ACTIONS(337), 1,
+      anon_sym_RBRACE,
+    STATE(224), 1,
+      aux_sym__nls,
+    STATE(225), 1,
+      aux_sym_import_names_repeat1,
+  [2450] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(89), 1,
+      anon_sym_LBRACE,
+    ACTIONS(339), 1,
+      aux_sym__nls_token1,
+    STATE(226), 1,
+      sym_object_literal,
+  [2463] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(341), 13,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_COLON,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_RBRACK,
+  [2482] = 5,
+    ACTIONS(99), 1,
+      sym_comment,
+    ACTIONS(343), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(345), 1,
+      sym_string_content,
+    ACTIONS(348), 1,
+      sym_escape_sequence,
+    STATE(147), 1,
+      aux_sym_string_literal_repeat1,
+  [2498] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(351), 7,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [2511] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(55), 1,
+      anon_sym_AT,
+    ACTIONS(353), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(228), 1,
+      sym_plugin_config,
+  [2530] = 7,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(55), 1,
+      anon_sym_AT,
+    ACTIONS(355), 1,
+      anon_sym_RBRACE,
+    STATE(230), 1,
+      aux_sym__nls,
+    STATE(231), 1,
+      sym_plugin_config,
+    STATE(232), 1,
+      aux_sym_plugin_block_repeat1,
+  [2552] = 7,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(83), 1,
+      anon_sym_POUND,
+    ACTIONS(143), 1,
+      anon_sym_LBRACE,
+    ACTIONS(359), 1,
+      anon_sym_EQ,
+    STATE(234), 1,
+      sym_entity_id,
+    STATE(235), 1,
+      sym_plugin_block,
+    ACTIONS(357), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [2576] = 20,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(63), 1,
+      sym_identifier,
+    ACTIONS(65), 1,
+      anon_sym_LBRACE,
+    ACTIONS(71), 1,
+      sym_number_literal,
+    ACTIONS(73), 1,
+      anon_sym_true,
+    ACTIONS(75), 1,
+      anon_sym_false,
+    ACTIONS(77), 1,
+      sym_null_literal,
+    STATE(63), 1,
+      sym_union_type,
+    STATE(64), 1,
+      sym__union_member,
+    STATE(65), 1,
+      sym_generic_type,
+    STATE(66), 1,
+      sym_object_type,
+    STATE(67), 1,
+      sym_type_identifier,
+    STATE(68), 1,
+      sym_qualified_identifier,
+    STATE(69), 1,
+      sym__base_type,
+    STATE(70), 1,
+      sym_map_type,
+    STATE(71), 1,
+      sym_array_type,
+    STATE(72), 1,
+      sym_string_literal,
+    STATE(73), 1,
+      sym_boolean_literal,
+    STATE(236), 1,
+      sym__type_expression,
+  [2637] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(361), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [2646] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(363), 3,
+      aux_sym__nls_token1,
+      anon_sym_AT,
+      anon_sym_RBRACE,
+  [2655] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(365), 5,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [2666] = 12,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(53), 1,
+      sym_identifier,
+    ACTIONS(55), 1,
+      anon_sym_AT,
+    ACTIONS(59), 1,
+      anon_sym_DASH,
+    ACTIONS(367), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(49), 1,
+      sym_field_removal,
+    STATE(50), 1,
+      sym_field_override,
+    STATE(51), 1,
+      sym_field_definition,
+    STATE(52), 1,
+      sym_plugin_config,
+    STATE(159), 1,
+      sym__model_member,
+  [2703] = 5,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(369), 1,
+      anon_sym_RBRACE,
+    STATE(162), 1,
+      aux_sym_model_body_repeat1,
+    STATE(239), 1,
+      aux_sym__nls,
+  [2719] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(365), 5,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [2730] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(371), 2,
+      aux_sym__nls_token1,
+      anon_sym_RBRACE,
+  [2738] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(365), 5,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [2749] = 12,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(53), 1,
+      sym_identifier,
+    ACTIONS(55), 1,
+      anon_sym_AT,
+    ACTIONS(59), 1,
+      anon_sym_DASH,
+    ACTIONS(373), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(49), 1,
+      sym_field_removal,
+    STATE(50), 1,
+      sym_field_override,
+    STATE(51), 1,
+      sym_field_definition,
+    STATE(52), 1,
+      sym_plugin_config,
+    STATE(159), 1,
+      sym__model_member,
+  [2786] = 5,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(371), 1,
+      anon_sym_RBRACE,
+    ACTIONS(375), 1,
+      aux_sym__nls_token1,
+    STATE(162), 1,
+      aux_sym_model_body_repeat1,
+    STATE(241), 1,
+      aux_sym__nls,
+  [2802] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(378), 2,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+  [2810] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(380), 1,
+      anon_sym_LBRACE,
+    ACTIONS(382), 1,
+      anon_sym_COMMA,
+    STATE(164), 1,
+      aux_sym_extends_clause_repeat1,
+  [2823] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(167), 1,
+      anon_sym_DOT,
+    ACTIONS(385), 14,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_LT,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+      anon_sym_RBRACK,
+  [2846] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(385), 14,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_LT,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+      anon_sym_RBRACK,
+  [2866] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(387), 14,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_LT,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+      anon_sym_RBRACK,
+  [2886] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(389), 12,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+  [2904] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(391), 1,
+      anon_sym_COMMA,
+    ACTIONS(393), 1,
+      anon_sym_RBRACE,
+    STATE(244), 1,
+      aux_sym__nls,
+    STATE(245), 1,
+      aux_sym_object_type_repeat1,
+  [2923] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(169), 1,
+      sym_identifier,
+    ACTIONS(395), 1,
+      anon_sym_RBRACE,
+    STATE(247), 1,
+      aux_sym__nls,
+    STATE(248), 1,
+      sym_field_definition,
+  [2942] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(397), 12,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+  [2960] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(169), 1,
+      sym_identifier,
+    ACTIONS(399), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(250), 1,
+      sym_field_definition,
+  [2979] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(401), 1,
+      anon_sym_COMMA,
+    ACTIONS(403), 1,
+      anon_sym_RBRACE,
+    STATE(253), 1,
+      aux_sym__nls,
+    STATE(254), 1,
+      aux_sym_object_type_repeat1,
+  [2998] = 5,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(405), 1,
+      sym_identifier,
+    ACTIONS(407), 1,
+      anon_sym_RBRACE,
+    STATE(257), 1,
+      aux_sym__nls,
+  [3014] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(83), 1,
+      anon_sym_POUND,
+    ACTIONS(143), 1,
+      anon_sym_LBRACE,
+    STATE(258), 1,
+      sym_entity_id,
+    STATE(259), 1,
+      sym_plugin_block,
+    ACTIONS(409), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [3036] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(83), 1,
+      anon_sym_POUND,
+    ACTIONS(143), 1,
+      anon_sym_LBRACE,
+    STATE(260), 1,
+      sym_entity_id,
+    STATE(261), 1,
+      sym_plugin_block,
+    ACTIONS(409), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [3058] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(411), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [3068] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(179), 11,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+  [3085] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(413), 11,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+  [3102] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(187), 1,
+      anon_sym_LBRACK,
+    ACTIONS(179), 11,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+  [3122] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(187), 1,
+      anon_sym_LBRACK,
+    ACTIONS(179), 11,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+  [3142] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(187), 1,
+      anon_sym_LBRACK,
+    ACTIONS(189), 1,
+      anon_sym_LT,
+    ACTIONS(179), 11,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+  [3165] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(187), 1,
+      anon_sym_LBRACK,
+    ACTIONS(179), 11,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+  [3185] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(187), 1,
+      anon_sym_LBRACK,
+    ACTIONS(179), 11,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+  [3205] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(179), 11,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+  [3222] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(179), 11,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+  [3239] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(415), 1,
+      anon_sym_PIPE,
+    STATE(187), 1,
+      aux_sym_union_type_repeat1,
+    ACTIONS(413), 10,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+  [3261] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(418), 1,
+      anon_sym_COMMA,
+    ACTIONS(420), 1,
+      anon_sym_GT,
+    STATE(264), 1,
+      aux_sym_generic_type_repeat1,
+  [3274] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(422), 12,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+  [3292] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(424), 1,
+      anon_sym_PIPE,
+    ACTIONS(426), 1,
+      anon_sym_RBRACK,
+  [3302] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(424), 1,
+      anon_sym_PIPE,
+    ACTIONS(426), 1,
+      anon_sym_RBRACK,
+  [3312] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(428), 1,
+      anon_sym_RBRACK,
+  [3319] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(426), 1,
+      anon_sym_RBRACK,
+  [3326] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(430), 1,
+      anon_sym_PIPE,
+    STATE(267), 1,
+      aux_sym_key_union_type_repeat1,
+  [3336] = 3,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(424), 1,
+      anon_sym_PIPE,
+    ACTIONS(426), 1,
+      anon_sym_RBRACK,
+  [3346] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(432), 2,
+      anon_sym_COMMA,
+      anon_sym_GT,
+  [3354] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(434), 2,
+      anon_sym_LBRACE,
+      anon_sym_extends,
+  [3362] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(436), 1,
+      anon_sym_COMMA,
+    ACTIONS(439), 1,
+      anon_sym_GT,
+    STATE(198), 1,
+      aux_sym_type_parameters_repeat1,
+  [3375] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(441), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [3385] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(443), 1,
+      aux_sym__nls_token1,
+  [3392] = 15,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(73), 1,
+      anon_sym_true,
+    ACTIONS(75), 1,
+      anon_sym_false,
+    ACTIONS(89), 1,
+      anon_sym_LBRACE,
+    ACTIONS(445), 1,
+      sym_identifier,
+    ACTIONS(447), 1,
+      anon_sym_LBRACK,
+    ACTIONS(449), 1,
+      sym_number_literal,
+    ACTIONS(451), 1,
+      sym_null_literal,
+    STATE(272), 1,
+      sym__value,
+    STATE(273), 1,
+      sym_identifier_value,
+    STATE(274), 1,
+      sym_array_literal,
+    STATE(275), 1,
+      sym_object_literal,
+    STATE(276), 1,
+      sym_string_literal,
+    STATE(277), 1,
+      sym_boolean_literal,
+  [3438] = 15,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(73), 1,
+      anon_sym_true,
+    ACTIONS(75), 1,
+      anon_sym_false,
+    ACTIONS(89), 1,
+      anon_sym_LBRACE,
+    ACTIONS(445), 1,
+      sym_identifier,
+    ACTIONS(447), 1,
+      anon_sym_LBRACK,
+    ACTIONS(449), 1,
+      sym_number_literal,
+    ACTIONS(451), 1,
+      sym_null_literal,
+    STATE(273), 1,
+      sym_identifier_value,
+    STATE(274), 1,
+      sym_array_literal,
+    STATE(275), 1,
+      sym_object_literal,
+    STATE(276), 1,
+      sym_string_literal,
+    STATE(277), 1,
+      sym_boolean_literal,
+    STATE(278), 1,
+      sym__value,
+  [3484] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(453), 7,
+      aux_sym__nls_token1,
+      anon_sym_AT,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [3497] = 5,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(455), 1,
+      aux_sym__nls_token1,
+    STATE(204), 1,
+      aux_sym__nls,
+    ACTIONS(119), 4,
+      sym_identifier,
+      anon_sym_true,
+      anon_sym_false,
+      sym_null_literal,
+    ACTIONS(117), 6,
+      anon_sym_LBRACE,
+      anon_sym_RBRACE,
+      anon_sym_LBRACK,
+      anon_sym_RBRACK,
+      anon_sym_DQUOTE,
+      sym_number_literal,
+  [3521] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(458), 1,
+      anon_sym_COMMA,
+    ACTIONS(460), 1,
+      anon_sym_RBRACE,
+    STATE(281), 1,
+      aux_sym__nls,
+    STATE(282), 1,
+      aux_sym_object_literal_repeat1,
+  [3540] = 9,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(205), 1,
+      sym_identifier,
+    ACTIONS(207), 1,
+      aux_sym__nls_token1,
+    ACTIONS(211), 1,
+      sym_number_literal,
+    ACTIONS(462), 1,
+      anon_sym_RBRACE,
+    STATE(136), 1,
+      sym_string_literal,
+    STATE(284), 1,
+      aux_sym__nls,
+    STATE(285), 1,
+      sym_object_entry,
+  [3568] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(453), 7,
+      aux_sym__nls_token1,
+      anon_sym_AT,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [3581] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(464), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+  [3594] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(466), 1,
+      anon_sym_COMMA,
+    ACTIONS(468), 1,
+      anon_sym_RBRACE,
+    STATE(289), 1,
+      aux_sym__nls,
+    STATE(290), 1,
+      aux_sym_object_literal_repeat1,
+  [3613] = 15,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(73), 1,
+      anon_sym_true,
+    ACTIONS(75), 1,
+      anon_sym_false,
+    ACTIONS(89), 1,
+      anon_sym_LBRACE,
+    ACTIONS(445), 1,
+      sym_identifier,
+    ACTIONS(447), 1,
+      anon_sym_LBRACK,
+    ACTIONS(449), 1,
+      sym_number_literal,
+    ACTIONS(451), 1,
+      sym_null_literal,
+    STATE(273), 1,
+      sym_identifier_value,
+    STATE(274), 1,
+      sym_array_literal,
+    STATE(275), 1,
+      sym_object_literal,
+    STATE(276), 1,
+      sym_string_literal,
+    STATE(277), 1,
+      sym_boolean_literal,
+    STATE(291), 1,
+      sym__value,
+  [3659] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(470), 1,
+      aux_sym__nls_token1,
+  [3666] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(472), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [3675] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(93), 1,
+      sym_identifier,
+    ACTIONS(474), 1,
+      anon_sym_RBRACE,
+    STATE(219), 1,
+      sym_import_name,
+    STATE(293), 1,
+      aux_sym__nls,
+  [3694] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(476), 1,
+      anon_sym_from,
+  [3701] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(93), 1,
+      sym_identifier,
+    ACTIONS(478), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(221), 1,
+      sym_import_name,
+  [3720] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(480), 1,
+      anon_sym_COMMA,
+    ACTIONS(482), 1,
+      anon_sym_RBRACE,
+    STATE(225), 1,
+      aux_sym_import_names_repeat1,
+    STATE(297), 1,
+      aux_sym__nls,
+  [3739] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(484), 1,
+      anon_sym_from,
+  [3746] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(93), 1,
+      sym_identifier,
+    ACTIONS(486), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(299), 1,
+      sym_import_name,
+  [3765] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(488), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [3774] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(484), 1,
+      anon_sym_from,
+  [3781] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(488), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [3790] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(93), 1,
+      sym_identifier,
+    ACTIONS(490), 1,
+      anon_sym_RBRACE,
+    STATE(219), 1,
+      sym_import_name,
+    STATE(301), 1,
+      aux_sym__nls,
+  [3809] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(492), 1,
+      anon_sym_from,
+  [3816] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(93), 1,
+      sym_identifier,
+    ACTIONS(494), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(221), 1,
+      sym_import_name,
+  [3835] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(496), 1,
+      aux_sym__nls_token1,
+    ACTIONS(499), 1,
+      anon_sym_COMMA,
+    ACTIONS(502), 1,
+      anon_sym_RBRACE,
+    STATE(225), 1,
+      aux_sym_import_names_repeat1,
+    STATE(304), 1,
+      aux_sym__nls,
+  [3854] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(504), 1,
+      aux_sym__nls_token1,
+  [3861] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(506), 7,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [3874] = 7,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(55), 1,
+      anon_sym_AT,
+    ACTIONS(508), 1,
+      anon_sym_RBRACE,
+    STATE(231), 1,
+      sym_plugin_config,
+    STATE(306), 1,
+      aux_sym__nls,
+    STATE(307), 1,
+      aux_sym_plugin_block_repeat1,
+  [3896] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(506), 7,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [3909] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(55), 1,
+      anon_sym_AT,
+    ACTIONS(510), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(309), 1,
+      sym_plugin_config,
+  [3928] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(512), 3,
+      aux_sym__nls_token1,
+      anon_sym_AT,
+      anon_sym_RBRACE,
+  [3937] = 7,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(55), 1,
+      anon_sym_AT,
+    ACTIONS(514), 1,
+      anon_sym_RBRACE,
+    STATE(231), 1,
+      sym_plugin_config,
+    STATE(311), 1,
+      aux_sym__nls,
+    STATE(312), 1,
+      aux_sym_plugin_block_repeat1,
+  [3959] = 15,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(73), 1,
+      anon_sym_true,
+    ACTIONS(75), 1,
+      anon_sym_false,
+    ACTIONS(89), 1,
+      anon_sym_LBRACE,
+    ACTIONS(447), 1,
+      anon_sym_LBRACK,
+    ACTIONS(516), 1,
+      sym_identifier,
+    ACTIONS(518), 1,
+      sym_number_literal,
+    ACTIONS(520), 1,
+      sym_null_literal,
+    STATE(316), 1,
+      sym__default_value,
+    STATE(317), 1,
+      sym_function_call,
+    STATE(318), 1,
+      sym_array_literal,
+    STATE(319), 1,
+      sym_object_literal,
+    STATE(320), 1,
+      sym_string_literal,
+    STATE(321), 1,
+      sym_boolean_literal,
+  [4005] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(522), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [4014] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(83), 1,
+      anon_sym_POUND,
+    STATE(322), 1,
+      sym_entity_id,
+    ACTIONS(524), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [4029] = 7,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(83), 1,
+      anon_sym_POUND,
+    ACTIONS(143), 1,
+      anon_sym_LBRACE,
+    ACTIONS(528), 1,
+      anon_sym_EQ,
+    STATE(324), 1,
+      sym_entity_id,
+    STATE(325), 1,
+      sym_plugin_block,
+    ACTIONS(526), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [4053] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(530), 5,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [4064] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(530), 5,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [4075] = 12,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(53), 1,
+      sym_identifier,
+    ACTIONS(55), 1,
+      anon_sym_AT,
+    ACTIONS(59), 1,
+      anon_sym_DASH,
+    ACTIONS(532), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(49), 1,
+      sym_field_removal,
+    STATE(50), 1,
+      sym_field_override,
+    STATE(51), 1,
+      sym_field_definition,
+    STATE(52), 1,
+      sym_plugin_config,
+    STATE(159), 1,
+      sym__model_member,
+  [4112] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(530), 5,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [4123] = 11,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(53), 1,
+      sym_identifier,
+    ACTIONS(55), 1,
+      anon_sym_AT,
+    ACTIONS(59), 1,
+      anon_sym_DASH,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(49), 1,
+      sym_field_removal,
+    STATE(50), 1,
+      sym_field_override,
+    STATE(51), 1,
+      sym_field_definition,
+    STATE(52), 1,
+      sym_plugin_config,
+    STATE(159), 1,
+      sym__model_member,
+  [4157] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(169), 1,
+      sym_identifier,
+    ACTIONS(534), 1,
+      anon_sym_RBRACE,
+    STATE(248), 1,
+      sym_field_definition,
+    STATE(328), 1,
+      aux_sym__nls,
+  [4176] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(536), 12,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+  [4194] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(169), 1,
+      sym_identifier,
+    ACTIONS(538), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(250), 1,
+      sym_field_definition,
+  [4213] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(540), 1,
+      anon_sym_COMMA,
+    ACTIONS(542), 1,
+      anon_sym_RBRACE,
+    STATE(254), 1,
+      aux_sym_object_type_repeat1,
+    STATE(332), 1,
+      aux_sym__nls,
+  [4232] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(544), 12,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+  [4250] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(169), 1,
+      sym_identifier,
+    ACTIONS(546), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(334), 1,
+      sym_field_definition,
+  [4269] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(548), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [4278] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(544), 12,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+  [4296] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(548), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [4305] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(169), 1,
+      sym_identifier,
+    ACTIONS(550), 1,
+      anon_sym_RBRACE,
+    STATE(248), 1,
+      sym_field_definition,
+    STATE(336), 1,
+      aux_sym__nls,
+  [4324] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(552), 12,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+  [4342] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(169), 1,
+      sym_identifier,
+    ACTIONS(554), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(250), 1,
+      sym_field_definition,
+  [4361] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(556), 1,
       aux_sym__nls_token1,
-    ACTIONS(23), 1,
+    ACTIONS(559), 1,
+      anon_sym_COMMA,
+    ACTIONS(562), 1,
+      anon_sym_RBRACE,
+    STATE(254), 1,
+      aux_sym_object_type_repeat1,
+    STATE(339), 1,
+      aux_sym__nls,
+  [4380] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(564), 1,
+      anon_sym_COMMA,
+    ACTIONS(566), 1,
+      anon_sym_RBRACE,
+    STATE(342), 1,
+      aux_sym__nls,
+    STATE(343), 1,
+      aux_sym_projection_fields_repeat1,
+  [4399] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(568), 6,
+      ts_builtin_sym_end,
       sym_identifier,
-    ACTIONS(25), 1,
+      aux_sym__nls_token1,
       anon_sym_LBRACE,
-    ACTIONS(27), 1,
-      anon_sym_LBRACK,
-    ACTIONS(29), 1,
-      anon_sym_RBRACK,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(33), 1,
-      sym_number_literal,
-    ACTIONS(37), 1,
-      sym_null_literal,
-    STATE(5), 1,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [4411] = 5,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(570), 1,
+      sym_identifier,
+    ACTIONS(572), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
       aux_sym__nls,
-    ACTIONS(35), 2,
-      anon_sym_true,
-      anon_sym_false,
-    STATE(107), 6,
-      sym__value,
-      sym_identifier_value,
-      sym_array_literal,
-      sym_object_literal,
-      sym_string_literal,
-      sym_boolean_literal,
-  [89] = 12,
+  [4427] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(574), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
-    ACTIONS(23), 1,
+      anon_sym_DASH,
+  [4437] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(83), 1,
+      anon_sym_POUND,
+    STATE(346), 1,
+      sym_entity_id,
+    ACTIONS(576), 4,
+      ts_builtin_sym_end,
       sym_identifier,
-    ACTIONS(25), 1,
-      anon_sym_LBRACE,
-    ACTIONS(27), 1,
-      anon_sym_LBRACK,
-    ACTIONS(31), 1,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [4453] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(574), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [4463] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(83), 1,
+      anon_sym_POUND,
+    STATE(347), 1,
+      sym_entity_id,
+    ACTIONS(576), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [4479] = 20,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
       anon_sym_DQUOTE,
-    ACTIONS(39), 1,
-      anon_sym_RBRACK,
-    ACTIONS(41), 1,
+    ACTIONS(63), 1,
+      sym_identifier,
+    ACTIONS(65), 1,
+      anon_sym_LBRACE,
+    ACTIONS(71), 1,
       sym_number_literal,
-    ACTIONS(43), 1,
-      sym_null_literal,
-    STATE(5), 1,
-      aux_sym__nls,
-    ACTIONS(35), 2,
+    ACTIONS(73), 1,
       anon_sym_true,
+    ACTIONS(75), 1,
       anon_sym_false,
-    STATE(159), 6,
-      sym__value,
-      sym_identifier_value,
-      sym_array_literal,
-      sym_object_literal,
+    ACTIONS(77), 1,
+      sym_null_literal,
+    STATE(63), 1,
+      sym_union_type,
+    STATE(64), 1,
+      sym__union_member,
+    STATE(65), 1,
+      sym_generic_type,
+    STATE(66), 1,
+      sym_object_type,
+    STATE(67), 1,
+      sym_type_identifier,
+    STATE(68), 1,
+      sym_qualified_identifier,
+    STATE(69), 1,
+      sym__base_type,
+    STATE(70), 1,
+      sym_map_type,
+    STATE(71), 1,
+      sym_array_type,
+    STATE(72), 1,
       sym_string_literal,
+    STATE(73), 1,
       sym_boolean_literal,
-  [132] = 5,
+    STATE(348), 1,
+      sym__type_expression,
+  [4540] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(49), 1,
+    ACTIONS(578), 12,
+      ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
-    STATE(5), 1,
-      aux_sym__nls,
-    ACTIONS(47), 7,
-      anon_sym_import,
-      anon_sym_extends,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
       anon_sym_DASH,
-      anon_sym_true,
-      anon_sym_false,
-      sym_null_literal,
-      sym_identifier,
-    ACTIONS(45), 8,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+  [4558] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(418), 1,
+      anon_sym_COMMA,
+    ACTIONS(580), 1,
+      anon_sym_GT,
+    STATE(350), 1,
+      aux_sym_generic_type_repeat1,
+  [4571] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(582), 12,
       ts_builtin_sym_end,
-      anon_sym_AT,
+      sym_identifier,
+      aux_sym__nls_token1,
       anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
       anon_sym_LBRACK,
-      anon_sym_RBRACK,
+  [4589] = 8,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
       anon_sym_DQUOTE,
+    ACTIONS(283), 1,
+      sym_identifier,
+    ACTIONS(584), 1,
       sym_number_literal,
-  [161] = 12,
+    STATE(68), 1,
+      sym_qualified_identifier,
+    STATE(352), 1,
+      sym_type_identifier,
+    STATE(353), 1,
+      sym__key_union_member,
+    STATE(354), 1,
+      sym_string_literal,
+  [4614] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(430), 1,
+      anon_sym_PIPE,
+    ACTIONS(586), 1,
+      anon_sym_RBRACK,
+    STATE(355), 1,
+      aux_sym_key_union_type_repeat1,
+  [4627] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(588), 4,
       aux_sym__nls_token1,
-    ACTIONS(23), 1,
-      sym_identifier,
-    ACTIONS(25), 1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_RBRACK,
+  [4637] = 18,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(73), 1,
+      anon_sym_true,
+    ACTIONS(75), 1,
+      anon_sym_false,
+    ACTIONS(89), 1,
       anon_sym_LBRACE,
-    ACTIONS(27), 1,
+    ACTIONS(207), 1,
+      aux_sym__nls_token1,
+    ACTIONS(445), 1,
+      sym_identifier,
+    ACTIONS(447), 1,
       anon_sym_LBRACK,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(41), 1,
+    ACTIONS(449), 1,
       sym_number_literal,
-    ACTIONS(43), 1,
+    ACTIONS(451), 1,
       sym_null_literal,
-    ACTIONS(52), 1,
+    ACTIONS(590), 1,
       anon_sym_RBRACK,
-    STATE(5), 1,
-      aux_sym__nls,
-    ACTIONS(35), 2,
-      anon_sym_true,
-      anon_sym_false,
-    STATE(159), 6,
-      sym__value,
+    STATE(273), 1,
       sym_identifier_value,
+    STATE(274), 1,
       sym_array_literal,
+    STATE(275), 1,
       sym_object_literal,
+    STATE(276), 1,
       sym_string_literal,
+    STATE(277), 1,
       sym_boolean_literal,
-  [204] = 12,
+    STATE(357), 1,
+      aux_sym__nls,
+    STATE(358), 1,
+      sym__value,
+  [4692] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(23), 1,
-      sym_identifier,
-    ACTIONS(25), 1,
-      anon_sym_LBRACE,
-    ACTIONS(27), 1,
-      anon_sym_LBRACK,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(54), 1,
+    ACTIONS(592), 4,
       aux_sym__nls_token1,
-    ACTIONS(56), 1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
       anon_sym_RBRACK,
-    ACTIONS(58), 1,
-      sym_number_literal,
-    ACTIONS(60), 1,
-      sym_null_literal,
-    STATE(6), 1,
-      aux_sym__nls,
-    ACTIONS(35), 2,
-      anon_sym_true,
-      anon_sym_false,
-    STATE(156), 6,
-      sym__value,
-      sym_identifier_value,
-      sym_array_literal,
-      sym_object_literal,
-      sym_string_literal,
-      sym_boolean_literal,
-  [247] = 12,
+  [4702] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(23), 1,
-      sym_identifier,
-    ACTIONS(25), 1,
-      anon_sym_LBRACE,
-    ACTIONS(27), 1,
-      anon_sym_LBRACK,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(62), 1,
+    ACTIONS(592), 4,
       aux_sym__nls_token1,
-    ACTIONS(64), 1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
       anon_sym_RBRACK,
-    ACTIONS(66), 1,
-      sym_number_literal,
-    ACTIONS(68), 1,
-      sym_null_literal,
-    STATE(3), 1,
-      aux_sym__nls,
-    ACTIONS(35), 2,
-      anon_sym_true,
-      anon_sym_false,
-    STATE(93), 6,
-      sym__value,
-      sym_identifier_value,
-      sym_array_literal,
-      sym_object_literal,
-      sym_string_literal,
-      sym_boolean_literal,
-  [290] = 12,
+  [4712] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(23), 1,
-      sym_identifier,
-    ACTIONS(25), 1,
-      anon_sym_LBRACE,
-    ACTIONS(27), 1,
-      anon_sym_LBRACK,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(52), 1,
+    ACTIONS(594), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [4721] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(592), 4,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
       anon_sym_RBRACK,
-    ACTIONS(58), 1,
-      sym_number_literal,
-    ACTIONS(60), 1,
-      sym_null_literal,
-    ACTIONS(70), 1,
+  [4731] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(592), 4,
       aux_sym__nls_token1,
-    STATE(4), 1,
-      aux_sym__nls,
-    ACTIONS(35), 2,
-      anon_sym_true,
-      anon_sym_false,
-    STATE(156), 6,
-      sym__value,
-      sym_identifier_value,
-      sym_array_literal,
-      sym_object_literal,
-      sym_string_literal,
-      sym_boolean_literal,
-  [333] = 12,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_RBRACK,
+  [4741] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(23), 1,
-      sym_identifier,
-    ACTIONS(25), 1,
-      anon_sym_LBRACE,
-    ACTIONS(27), 1,
-      anon_sym_LBRACK,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(58), 1,
-      sym_number_literal,
-    ACTIONS(60), 1,
-      sym_null_literal,
-    ACTIONS(72), 1,
+    ACTIONS(592), 4,
       aux_sym__nls_token1,
-    ACTIONS(74), 1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_RBRACK,
+  [4751] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(592), 4,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
       anon_sym_RBRACK,
-    STATE(11), 1,
-      aux_sym__nls,
-    ACTIONS(35), 2,
-      anon_sym_true,
-      anon_sym_false,
-    STATE(156), 6,
-      sym__value,
-      sym_identifier_value,
-      sym_array_literal,
-      sym_object_literal,
-      sym_string_literal,
-      sym_boolean_literal,
-  [376] = 12,
+  [4761] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(592), 4,
       aux_sym__nls_token1,
-    ACTIONS(23), 1,
-      sym_identifier,
-    ACTIONS(25), 1,
-      anon_sym_LBRACE,
-    ACTIONS(27), 1,
-      anon_sym_LBRACK,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(41), 1,
-      sym_number_literal,
-    ACTIONS(43), 1,
-      sym_null_literal,
-    ACTIONS(56), 1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
       anon_sym_RBRACK,
-    STATE(5), 1,
-      aux_sym__nls,
-    ACTIONS(35), 2,
-      anon_sym_true,
-      anon_sym_false,
-    STATE(159), 6,
-      sym__value,
-      sym_identifier_value,
-      sym_array_literal,
-      sym_object_literal,
-      sym_string_literal,
-      sym_boolean_literal,
-  [419] = 11,
+  [4771] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(7), 1,
-      sym_identifier,
-    ACTIONS(11), 1,
-      anon_sym_AT,
-    ACTIONS(13), 1,
-      anon_sym_import,
-    ACTIONS(15), 1,
-      anon_sym_extends,
-    ACTIONS(17), 1,
-      anon_sym_DASH,
-    ACTIONS(76), 1,
-      ts_builtin_sym_end,
-    STATE(26), 1,
-      aux_sym_source_file_repeat1,
-    STATE(47), 1,
-      aux_sym_source_file_repeat2,
-    STATE(96), 4,
-      sym__definition,
-      sym_model_removal,
-      sym_type_alias,
-      sym_model_definition,
-    STATE(182), 4,
-      sym__directive,
-      sym_plugin_import,
-      sym_template_import,
-      sym_extends_template,
-  [459] = 11,
+    ACTIONS(594), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [4780] = 9,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(7), 1,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(205), 1,
       sym_identifier,
-    ACTIONS(11), 1,
-      anon_sym_AT,
-    ACTIONS(13), 1,
-      anon_sym_import,
-    ACTIONS(15), 1,
-      anon_sym_extends,
-    ACTIONS(17), 1,
-      anon_sym_DASH,
-    ACTIONS(19), 1,
-      ts_builtin_sym_end,
-    STATE(26), 1,
-      aux_sym_source_file_repeat1,
-    STATE(51), 1,
-      aux_sym_source_file_repeat2,
-    STATE(96), 4,
-      sym__definition,
-      sym_model_removal,
-      sym_type_alias,
-      sym_model_definition,
-    STATE(182), 4,
-      sym__directive,
-      sym_plugin_import,
-      sym_template_import,
-      sym_extends_template,
-  [499] = 11,
+    ACTIONS(207), 1,
+      aux_sym__nls_token1,
+    ACTIONS(211), 1,
+      sym_number_literal,
+    ACTIONS(596), 1,
+      anon_sym_RBRACE,
+    STATE(136), 1,
+      sym_string_literal,
+    STATE(285), 1,
+      sym_object_entry,
+    STATE(360), 1,
+      aux_sym__nls,
+  [4808] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(598), 7,
       aux_sym__nls_token1,
-    ACTIONS(23), 1,
-      sym_identifier,
-    ACTIONS(25), 1,
+      anon_sym_AT,
       anon_sym_LBRACE,
-    ACTIONS(27), 1,
-      anon_sym_LBRACK,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(41), 1,
-      sym_number_literal,
-    ACTIONS(43), 1,
-      sym_null_literal,
-    STATE(5), 1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [4821] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(600), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
       aux_sym__nls,
-    ACTIONS(35), 2,
-      anon_sym_true,
-      anon_sym_false,
-    STATE(159), 6,
-      sym__value,
-      sym_identifier_value,
-      sym_array_literal,
-      sym_object_literal,
-      sym_string_literal,
-      sym_boolean_literal,
-  [539] = 11,
+  [4834] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(23), 1,
-      sym_identifier,
-    ACTIONS(25), 1,
-      anon_sym_LBRACE,
-    ACTIONS(27), 1,
-      anon_sym_LBRACK,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(58), 1,
-      sym_number_literal,
-    ACTIONS(60), 1,
-      sym_null_literal,
-    ACTIONS(78), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    STATE(14), 1,
+    ACTIONS(602), 1,
+      anon_sym_COMMA,
+    ACTIONS(604), 1,
+      anon_sym_RBRACE,
+    STATE(290), 1,
+      aux_sym_object_literal_repeat1,
+    STATE(364), 1,
       aux_sym__nls,
-    ACTIONS(35), 2,
-      anon_sym_true,
-      anon_sym_false,
-    STATE(156), 6,
-      sym__value,
-      sym_identifier_value,
-      sym_array_literal,
-      sym_object_literal,
-      sym_string_literal,
-      sym_boolean_literal,
-  [579] = 9,
+  [4853] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(23), 1,
-      sym_identifier,
-    ACTIONS(25), 1,
+    ACTIONS(598), 7,
+      aux_sym__nls_token1,
+      anon_sym_AT,
       anon_sym_LBRACE,
-    ACTIONS(27), 1,
-      anon_sym_LBRACK,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(80), 1,
-      sym_number_literal,
-    ACTIONS(82), 1,
-      sym_null_literal,
-    ACTIONS(35), 2,
-      anon_sym_true,
-      anon_sym_false,
-    STATE(150), 6,
-      sym__value,
-      sym_identifier_value,
-      sym_array_literal,
-      sym_object_literal,
-      sym_string_literal,
-      sym_boolean_literal,
-  [613] = 10,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [4866] = 9,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(31), 1,
+    ACTIONS(37), 1,
       anon_sym_DQUOTE,
-    ACTIONS(84), 1,
+    ACTIONS(205), 1,
       sym_identifier,
-    ACTIONS(86), 1,
+    ACTIONS(207), 1,
+      aux_sym__nls_token1,
+    ACTIONS(211), 1,
       sym_number_literal,
-    STATE(32), 1,
-      sym_qualified_identifier,
-    STATE(44), 1,
+    ACTIONS(606), 1,
+      anon_sym_RBRACE,
+    STATE(136), 1,
       sym_string_literal,
-    STATE(179), 1,
-      sym__union_member,
-    STATE(198), 1,
-      sym__base_type,
-    STATE(55), 2,
-      sym__type_expression,
-      sym_union_type,
-    STATE(40), 3,
-      sym_type_identifier,
-      sym_map_type,
-      sym_array_type,
-  [647] = 2,
+    STATE(204), 1,
+      aux_sym__nls,
+    STATE(366), 1,
+      sym_object_entry,
+  [4894] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(88), 12,
-      ts_builtin_sym_end,
+    ACTIONS(608), 3,
       aux_sym__nls_token1,
-      anon_sym_DASH,
-      anon_sym_POUND,
-      anon_sym_COLON,
       anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [4903] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(598), 7,
+      aux_sym__nls_token1,
+      anon_sym_AT,
       anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-      anon_sym_EQ,
-      anon_sym_PIPE,
+      anon_sym_POUND,
       anon_sym_RBRACK,
-      sym_identifier,
-  [665] = 10,
+  [4916] = 9,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(31), 1,
+    ACTIONS(37), 1,
       anon_sym_DQUOTE,
-    ACTIONS(84), 1,
+    ACTIONS(205), 1,
       sym_identifier,
-    ACTIONS(86), 1,
+    ACTIONS(207), 1,
+      aux_sym__nls_token1,
+    ACTIONS(211), 1,
       sym_number_literal,
-    STATE(32), 1,
-      sym_qualified_identifier,
-    STATE(44), 1,
+    ACTIONS(610), 1,
+      anon_sym_RBRACE,
+    STATE(136), 1,
       sym_string_literal,
-    STATE(179), 1,
-      sym__union_member,
-    STATE(198), 1,
-      sym__base_type,
-    STATE(62), 2,
-      sym__type_expression,
-      sym_union_type,
-    STATE(40), 3,
-      sym_type_identifier,
-      sym_map_type,
-      sym_array_type,
-  [699] = 3,
+    STATE(285), 1,
+      sym_object_entry,
+    STATE(368), 1,
+      aux_sym__nls,
+  [4944] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(92), 1,
-      anon_sym_DOT,
-    ACTIONS(90), 11,
-      ts_builtin_sym_end,
+    ACTIONS(598), 7,
       aux_sym__nls_token1,
-      anon_sym_DASH,
-      anon_sym_POUND,
+      anon_sym_AT,
       anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-      anon_sym_EQ,
-      anon_sym_PIPE,
-      anon_sym_LBRACK,
+      anon_sym_POUND,
       anon_sym_RBRACK,
+  [4957] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(612), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+  [4970] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(614), 1,
+      anon_sym_COMMA,
+    STATE(290), 1,
+      aux_sym_object_literal_repeat1,
+    ACTIONS(608), 2,
+      aux_sym__nls_token1,
+      anon_sym_RBRACE,
+  [4984] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(594), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [4993] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(617), 1,
+      anon_sym_from,
+  [5000] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(93), 1,
+      sym_identifier,
+    ACTIONS(619), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(299), 1,
+      sym_import_name,
+  [5019] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(617), 1,
+      anon_sym_from,
+  [5026] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(93), 1,
+      sym_identifier,
+    ACTIONS(621), 1,
+      anon_sym_RBRACE,
+    STATE(219), 1,
+      sym_import_name,
+    STATE(373), 1,
+      aux_sym__nls,
+  [5045] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(623), 1,
+      anon_sym_from,
+  [5052] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(93), 1,
       sym_identifier,
-  [719] = 10,
+    ACTIONS(625), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(221), 1,
+      sym_import_name,
+  [5071] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(84), 1,
-      sym_identifier,
-    ACTIONS(86), 1,
-      sym_number_literal,
-    STATE(32), 1,
-      sym_qualified_identifier,
-    STATE(44), 1,
-      sym_string_literal,
-    STATE(179), 1,
-      sym__union_member,
-    STATE(198), 1,
-      sym__base_type,
-    STATE(64), 2,
-      sym__type_expression,
-      sym_union_type,
-    STATE(40), 3,
-      sym_type_identifier,
-      sym_map_type,
-      sym_array_type,
-  [753] = 7,
+    ACTIONS(627), 1,
+      anon_sym_from,
+  [5078] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(25), 1,
-      anon_sym_LBRACE,
-    ACTIONS(27), 1,
-      anon_sym_LBRACK,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(94), 2,
-      sym_number_literal,
-      sym_null_literal,
-    ACTIONS(96), 2,
-      anon_sym_true,
-      anon_sym_false,
-    STATE(88), 5,
-      sym__default_value,
-      sym_array_literal,
-      sym_object_literal,
-      sym_string_literal,
-      sym_boolean_literal,
-  [781] = 7,
+    ACTIONS(629), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [5087] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(25), 1,
-      anon_sym_LBRACE,
-    ACTIONS(27), 1,
-      anon_sym_LBRACK,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(96), 2,
-      anon_sym_true,
-      anon_sym_false,
-    ACTIONS(98), 2,
-      sym_number_literal,
-      sym_null_literal,
-    STATE(84), 5,
-      sym__default_value,
-      sym_array_literal,
-      sym_object_literal,
-      sym_string_literal,
-      sym_boolean_literal,
-  [809] = 2,
+    ACTIONS(631), 1,
+      anon_sym_from,
+  [5094] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(100), 12,
-      ts_builtin_sym_end,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-      anon_sym_DASH,
-      anon_sym_POUND,
-      anon_sym_COLON,
-      anon_sym_COMMA,
-      anon_sym_LBRACE,
-      anon_sym_RBRACE,
-      anon_sym_EQ,
-      anon_sym_PIPE,
-      anon_sym_RBRACK,
+    ACTIONS(93), 1,
       sym_identifier,
-  [827] = 3,
+    ACTIONS(633), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(299), 1,
+      sym_import_name,
+  [5113] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(92), 1,
-      anon_sym_DOT,
-    ACTIONS(102), 11,
-      ts_builtin_sym_end,
+    ACTIONS(631), 1,
+      anon_sym_from,
+  [5120] = 5,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-      anon_sym_DASH,
-      anon_sym_POUND,
-      anon_sym_LBRACE,
-      anon_sym_RBRACE,
-      anon_sym_EQ,
-      anon_sym_PIPE,
-      anon_sym_LBRACK,
-      anon_sym_RBRACK,
+    ACTIONS(93), 1,
       sym_identifier,
-  [847] = 8,
+    STATE(219), 1,
+      sym_import_name,
+    STATE(376), 1,
+      aux_sym__nls,
+  [5136] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(106), 1,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(93), 1,
       sym_identifier,
-    ACTIONS(108), 1,
-      anon_sym_AT,
-    ACTIONS(111), 1,
-      anon_sym_import,
-    ACTIONS(114), 1,
-      anon_sym_extends,
-    STATE(26), 1,
-      aux_sym_source_file_repeat1,
-    ACTIONS(104), 2,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(221), 1,
+      sym_import_name,
+  [5152] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(635), 7,
       ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
       anon_sym_DASH,
-    STATE(182), 4,
-      sym__directive,
-      sym_plugin_import,
-      sym_template_import,
-      sym_extends_template,
-  [876] = 8,
+      anon_sym_POUND,
+  [5165] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(117), 1,
-      sym_identifier,
-    ACTIONS(119), 1,
+    ACTIONS(55), 1,
       anon_sym_AT,
-    ACTIONS(121), 1,
-      anon_sym_DASH,
-    ACTIONS(123), 1,
+    ACTIONS(637), 1,
       anon_sym_RBRACE,
-    STATE(5), 1,
+    STATE(37), 1,
       aux_sym__nls,
-    STATE(174), 5,
-      sym__model_member,
-      sym_field_removal,
-      sym_field_override,
-      sym_field_definition,
+    STATE(309), 1,
       sym_plugin_config,
-  [905] = 8,
+  [5184] = 7,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(117), 1,
-      sym_identifier,
-    ACTIONS(119), 1,
-      anon_sym_AT,
-    ACTIONS(121), 1,
-      anon_sym_DASH,
-    ACTIONS(125), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(127), 1,
+    ACTIONS(55), 1,
+      anon_sym_AT,
+    ACTIONS(639), 1,
       anon_sym_RBRACE,
-    STATE(33), 1,
-      aux_sym__nls,
-    STATE(128), 5,
-      sym__model_member,
-      sym_field_removal,
-      sym_field_override,
-      sym_field_definition,
+    STATE(231), 1,
       sym_plugin_config,
-  [934] = 2,
+    STATE(312), 1,
+      aux_sym_plugin_block_repeat1,
+    STATE(379), 1,
+      aux_sym__nls,
+  [5206] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(129), 11,
+    ACTIONS(635), 7,
       ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
       anon_sym_DASH,
       anon_sym_POUND,
-      anon_sym_LBRACE,
+  [5219] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(641), 3,
+      aux_sym__nls_token1,
+      anon_sym_AT,
       anon_sym_RBRACE,
-      anon_sym_EQ,
-      anon_sym_PIPE,
-      anon_sym_LBRACK,
-      anon_sym_RBRACK,
+  [5228] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(635), 7,
+      ts_builtin_sym_end,
       sym_identifier,
-  [951] = 8,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [5241] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(117), 1,
-      sym_identifier,
-    ACTIONS(119), 1,
+    ACTIONS(55), 1,
       anon_sym_AT,
-    ACTIONS(121), 1,
-      anon_sym_DASH,
-    ACTIONS(131), 1,
+    ACTIONS(643), 1,
       anon_sym_RBRACE,
-    STATE(5), 1,
+    STATE(37), 1,
       aux_sym__nls,
-    STATE(174), 5,
-      sym__model_member,
-      sym_field_removal,
-      sym_field_override,
-      sym_field_definition,
+    STATE(309), 1,
       sym_plugin_config,
-  [980] = 8,
+  [5260] = 7,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(641), 1,
+      anon_sym_RBRACE,
+    ACTIONS(645), 1,
       aux_sym__nls_token1,
-    ACTIONS(117), 1,
-      sym_identifier,
-    ACTIONS(119), 1,
+    ACTIONS(648), 1,
       anon_sym_AT,
-    ACTIONS(121), 1,
-      anon_sym_DASH,
-    ACTIONS(133), 1,
-      anon_sym_RBRACE,
-    STATE(5), 1,
-      aux_sym__nls,
-    STATE(174), 5,
-      sym__model_member,
-      sym_field_removal,
-      sym_field_override,
-      sym_field_definition,
+    STATE(231), 1,
       sym_plugin_config,
-  [1009] = 2,
+    STATE(312), 1,
+      aux_sym_plugin_block_repeat1,
+    STATE(381), 1,
+      aux_sym__nls,
+  [5282] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(102), 11,
-      ts_builtin_sym_end,
+    ACTIONS(651), 1,
+      anon_sym_LPAREN,
+  [5289] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(653), 5,
       aux_sym__nls_token1,
-      anon_sym_DASH,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
       anon_sym_POUND,
+  [5300] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(653), 5,
+      aux_sym__nls_token1,
       anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-      anon_sym_EQ,
-      anon_sym_PIPE,
-      anon_sym_LBRACK,
-      anon_sym_RBRACK,
-      sym_identifier,
-  [1026] = 8,
+      anon_sym_POUND,
+  [5311] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(83), 1,
+      anon_sym_POUND,
+    ACTIONS(143), 1,
+      anon_sym_LBRACE,
+    STATE(383), 1,
+      sym_entity_id,
+    STATE(384), 1,
+      sym_plugin_block,
+    ACTIONS(655), 3,
       aux_sym__nls_token1,
-    ACTIONS(117), 1,
-      sym_identifier,
-    ACTIONS(119), 1,
-      anon_sym_AT,
-    ACTIONS(121), 1,
-      anon_sym_DASH,
-    ACTIONS(135), 1,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-    STATE(5), 1,
-      aux_sym__nls,
-    STATE(129), 5,
-      sym__model_member,
-      sym_field_removal,
-      sym_field_override,
-      sym_field_definition,
-      sym_plugin_config,
-  [1055] = 4,
+  [5332] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(139), 1,
-      anon_sym_PIPE,
-    STATE(34), 1,
-      aux_sym_union_type_repeat1,
-    ACTIONS(137), 8,
-      ts_builtin_sym_end,
+    ACTIONS(653), 5,
       aux_sym__nls_token1,
-      anon_sym_DASH,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
       anon_sym_POUND,
+  [5343] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(653), 5,
+      aux_sym__nls_token1,
       anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-      anon_sym_EQ,
-      sym_identifier,
-  [1075] = 2,
+      anon_sym_POUND,
+  [5354] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(142), 10,
-      ts_builtin_sym_end,
+    ACTIONS(653), 5,
       aux_sym__nls_token1,
-      anon_sym_DASH,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
       anon_sym_POUND,
+  [5365] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(653), 5,
+      aux_sym__nls_token1,
       anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-      anon_sym_EQ,
-      anon_sym_PIPE,
+      anon_sym_POUND,
+  [5376] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(653), 5,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_POUND,
+  [5387] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(657), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [5396] = 15,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(73), 1,
+      anon_sym_true,
+    ACTIONS(75), 1,
+      anon_sym_false,
+    ACTIONS(89), 1,
+      anon_sym_LBRACE,
+    ACTIONS(447), 1,
       anon_sym_LBRACK,
+    ACTIONS(516), 1,
       sym_identifier,
-  [1091] = 3,
+    ACTIONS(518), 1,
+      sym_number_literal,
+    ACTIONS(520), 1,
+      sym_null_literal,
+    STATE(317), 1,
+      sym_function_call,
+    STATE(318), 1,
+      sym_array_literal,
+    STATE(319), 1,
+      sym_object_literal,
+    STATE(320), 1,
+      sym_string_literal,
+    STATE(321), 1,
+      sym_boolean_literal,
+    STATE(385), 1,
+      sym__default_value,
+  [5442] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(146), 1,
-      anon_sym_LBRACK,
-    ACTIONS(144), 9,
+    ACTIONS(659), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [5451] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(83), 1,
+      anon_sym_POUND,
+    STATE(386), 1,
+      sym_entity_id,
+    ACTIONS(661), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [5466] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(663), 5,
       ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
       anon_sym_DASH,
       anon_sym_POUND,
-      anon_sym_LBRACE,
-      anon_sym_RBRACE,
-      anon_sym_EQ,
-      anon_sym_PIPE,
-      sym_identifier,
-  [1109] = 2,
+  [5477] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(148), 10,
+    ACTIONS(665), 12,
       ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
-      anon_sym_DASH,
-      anon_sym_POUND,
       anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
       anon_sym_EQ,
       anon_sym_PIPE,
       anon_sym_LBRACK,
-      sym_identifier,
-  [1125] = 8,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(84), 1,
-      sym_identifier,
-    ACTIONS(150), 1,
-      sym_number_literal,
-    STATE(32), 1,
-      sym_qualified_identifier,
-    STATE(198), 1,
-      sym__base_type,
-    STATE(43), 2,
-      sym__union_member,
-      sym_string_literal,
-    STATE(36), 3,
-      sym_type_identifier,
-      sym_map_type,
-      sym_array_type,
-  [1153] = 7,
+  [5495] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(117), 1,
+    ACTIONS(169), 1,
       sym_identifier,
-    ACTIONS(119), 1,
-      anon_sym_AT,
-    ACTIONS(121), 1,
-      anon_sym_DASH,
-    STATE(5), 1,
+    ACTIONS(667), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
       aux_sym__nls,
-    STATE(174), 5,
-      sym__model_member,
-      sym_field_removal,
-      sym_field_override,
+    STATE(334), 1,
       sym_field_definition,
-      sym_plugin_config,
-  [1179] = 4,
+  [5514] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(144), 1,
-      anon_sym_PIPE,
-    ACTIONS(146), 1,
-      anon_sym_LBRACK,
-    ACTIONS(152), 8,
+    ACTIONS(665), 12,
       ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
-      anon_sym_DASH,
-      anon_sym_POUND,
       anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
       anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+  [5532] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(169), 1,
       sym_identifier,
-  [1199] = 4,
+    ACTIONS(669), 1,
+      anon_sym_RBRACE,
+    STATE(248), 1,
+      sym_field_definition,
+    STATE(389), 1,
+      aux_sym__nls,
+  [5551] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(156), 1,
-      anon_sym_PIPE,
-    STATE(34), 1,
-      aux_sym_union_type_repeat1,
-    ACTIONS(154), 8,
+    ACTIONS(671), 12,
       ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
-      anon_sym_DASH,
-      anon_sym_POUND,
       anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
       anon_sym_EQ,
-      sym_identifier,
-  [1219] = 9,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+  [5569] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(84), 1,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(169), 1,
       sym_identifier,
-    ACTIONS(158), 1,
-      anon_sym_RBRACK,
-    ACTIONS(160), 1,
-      sym_number_literal,
-    STATE(32), 1,
-      sym_qualified_identifier,
-    STATE(163), 1,
-      sym__key_union_member,
-    STATE(168), 2,
-      sym_type_identifier,
-      sym_string_literal,
-    STATE(186), 2,
-      sym__key_type_expression,
-      sym_key_union_type,
-  [1249] = 2,
+    ACTIONS(673), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(250), 1,
+      sym_field_definition,
+  [5588] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(137), 9,
+    ACTIONS(675), 12,
       ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
-      anon_sym_DASH,
-      anon_sym_POUND,
       anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
       anon_sym_EQ,
       anon_sym_PIPE,
-      sym_identifier,
-  [1264] = 3,
+      anon_sym_LBRACK,
+  [5606] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(144), 1,
-      anon_sym_PIPE,
-    ACTIONS(152), 8,
-      ts_builtin_sym_end,
+    ACTIONS(677), 3,
       aux_sym__nls_token1,
-      anon_sym_DASH,
-      anon_sym_POUND,
-      anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-      anon_sym_EQ,
-      sym_identifier,
-  [1281] = 6,
+  [5615] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(17), 1,
-      anon_sym_DASH,
-    ACTIONS(19), 1,
+    ACTIONS(679), 12,
       ts_builtin_sym_end,
-    ACTIONS(162), 1,
       sym_identifier,
-    STATE(52), 1,
-      aux_sym_source_file_repeat2,
-    STATE(96), 4,
-      sym__definition,
-      sym_model_removal,
-      sym_type_alias,
-      sym_model_definition,
-  [1303] = 8,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(166), 1,
-      anon_sym_POUND,
-    ACTIONS(168), 1,
-      anon_sym_COLON,
-    ACTIONS(170), 1,
-      anon_sym_LBRACE,
-    ACTIONS(172), 1,
-      anon_sym_QMARK,
-    STATE(172), 1,
-      sym_entity_id,
-    STATE(183), 1,
-      sym_plugin_block,
-    ACTIONS(164), 2,
       aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-  [1329] = 6,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(17), 1,
       anon_sym_DASH,
-    ACTIONS(162), 1,
-      sym_identifier,
-    ACTIONS(174), 1,
-      ts_builtin_sym_end,
-    STATE(52), 1,
-      aux_sym_source_file_repeat2,
-    STATE(96), 4,
-      sym__definition,
-      sym_model_removal,
-      sym_type_alias,
-      sym_model_definition,
-  [1351] = 8,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+  [5633] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(178), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(180), 1,
-      anon_sym_RBRACE,
-    STATE(56), 1,
-      aux_sym__nls,
-    STATE(153), 1,
-      sym_object_entry,
-    STATE(197), 1,
-      sym_string_literal,
-    ACTIONS(176), 2,
-      sym_number_literal,
+    ACTIONS(169), 1,
       sym_identifier,
-  [1377] = 8,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(21), 1,
-      aux_sym__nls_token1,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(182), 1,
+    ACTIONS(681), 1,
       anon_sym_RBRACE,
-    STATE(5), 1,
+    STATE(37), 1,
       aux_sym__nls,
-    STATE(114), 1,
-      sym_object_entry,
-    STATE(197), 1,
-      sym_string_literal,
-    ACTIONS(176), 2,
-      sym_number_literal,
-      sym_identifier,
-  [1403] = 5,
+    STATE(334), 1,
+      sym_field_definition,
+  [5652] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
-      aux_sym__nls_token1,
-    STATE(5), 1,
-      aux_sym__nls,
-    ACTIONS(104), 3,
+    ACTIONS(679), 12,
       ts_builtin_sym_end,
-      anon_sym_AT,
-      anon_sym_DASH,
-    ACTIONS(106), 3,
-      anon_sym_import,
-      anon_sym_extends,
       sym_identifier,
-  [1423] = 6,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(17), 1,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
       anon_sym_DASH,
-    ACTIONS(76), 1,
-      ts_builtin_sym_end,
-    ACTIONS(162), 1,
-      sym_identifier,
-    STATE(52), 1,
-      aux_sym_source_file_repeat2,
-    STATE(96), 4,
-      sym__definition,
-      sym_model_removal,
-      sym_type_alias,
-      sym_model_definition,
-  [1445] = 6,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+  [5670] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(184), 1,
-      ts_builtin_sym_end,
-    ACTIONS(186), 1,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(169), 1,
       sym_identifier,
-    ACTIONS(189), 1,
-      anon_sym_DASH,
-    STATE(52), 1,
-      aux_sym_source_file_repeat2,
-    STATE(96), 4,
-      sym__definition,
-      sym_model_removal,
-      sym_type_alias,
-      sym_model_definition,
-  [1467] = 8,
+    STATE(248), 1,
+      sym_field_definition,
+    STATE(392), 1,
+      aux_sym__nls,
+  [5686] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(192), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(194), 1,
-      anon_sym_RBRACE,
-    STATE(49), 1,
-      aux_sym__nls,
-    STATE(100), 1,
-      sym_object_entry,
-    STATE(197), 1,
-      sym_string_literal,
-    ACTIONS(176), 2,
-      sym_number_literal,
+    ACTIONS(169), 1,
       sym_identifier,
-  [1493] = 8,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(250), 1,
+      sym_field_definition,
+  [5702] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(196), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(198), 1,
+    ACTIONS(683), 1,
+      sym_identifier,
+    ACTIONS(685), 1,
       anon_sym_RBRACE,
-    STATE(59), 1,
+    STATE(395), 1,
       aux_sym__nls,
-    STATE(153), 1,
-      sym_object_entry,
-    STATE(197), 1,
-      sym_string_literal,
-    ACTIONS(176), 2,
-      sym_number_literal,
-      sym_identifier,
-  [1519] = 6,
+  [5718] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(166), 1,
-      anon_sym_POUND,
-    ACTIONS(170), 1,
-      anon_sym_LBRACE,
-    STATE(76), 1,
-      sym_plugin_block,
-    STATE(126), 1,
-      sym_entity_id,
-    ACTIONS(200), 4,
+    ACTIONS(687), 6,
       ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
+      anon_sym_LBRACE,
       anon_sym_DASH,
-      sym_identifier,
-  [1541] = 8,
+      anon_sym_POUND,
+  [5730] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(198), 1,
+    ACTIONS(689), 1,
+      sym_identifier,
+    ACTIONS(691), 1,
       anon_sym_RBRACE,
-    STATE(5), 1,
+    STATE(37), 1,
       aux_sym__nls,
-    STATE(142), 1,
-      sym_object_entry,
-    STATE(197), 1,
-      sym_string_literal,
-    ACTIONS(176), 2,
-      sym_number_literal,
-      sym_identifier,
-  [1567] = 8,
+  [5746] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(202), 1,
+    ACTIONS(693), 1,
+      anon_sym_COMMA,
+    ACTIONS(695), 1,
       anon_sym_RBRACE,
-    STATE(5), 1,
+    STATE(400), 1,
       aux_sym__nls,
-    STATE(142), 1,
-      sym_object_entry,
-    STATE(197), 1,
-      sym_string_literal,
-    ACTIONS(176), 2,
-      sym_number_literal,
-      sym_identifier,
-  [1593] = 8,
+    STATE(401), 1,
+      aux_sym_projection_fields_repeat1,
+  [5765] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(204), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(206), 1,
+    ACTIONS(697), 1,
+      anon_sym_COMMA,
+    ACTIONS(699), 1,
       anon_sym_RBRACE,
-    STATE(57), 1,
+    STATE(404), 1,
       aux_sym__nls,
-    STATE(153), 1,
-      sym_object_entry,
-    STATE(197), 1,
-      sym_string_literal,
-    ACTIONS(176), 2,
-      sym_number_literal,
-      sym_identifier,
-  [1619] = 8,
+    STATE(405), 1,
+      aux_sym_projection_fields_repeat1,
+  [5784] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(701), 6,
+      ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    ACTIONS(206), 1,
-      anon_sym_RBRACE,
-    STATE(5), 1,
-      aux_sym__nls,
-    STATE(142), 1,
-      sym_object_entry,
-    STATE(197), 1,
-      sym_string_literal,
-    ACTIONS(176), 2,
-      sym_number_literal,
+      anon_sym_LBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [5796] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(703), 4,
+      ts_builtin_sym_end,
       sym_identifier,
-  [1645] = 2,
+      aux_sym__nls_token1,
+      anon_sym_DASH,
+  [5806] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(208), 7,
+    ACTIONS(703), 4,
+      ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
-      anon_sym_AT,
-      anon_sym_POUND,
+      anon_sym_DASH,
+  [5816] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(705), 2,
       anon_sym_COMMA,
+      anon_sym_GT,
+  [5824] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(707), 12,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
       anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+  [5842] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(709), 1,
+      anon_sym_COMMA,
+    ACTIONS(712), 1,
+      anon_sym_GT,
+    STATE(350), 1,
+      aux_sym_generic_type_repeat1,
+  [5855] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(424), 2,
+      anon_sym_PIPE,
+      anon_sym_RBRACK,
+  [5863] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(424), 2,
+      anon_sym_PIPE,
+      anon_sym_RBRACK,
+  [5871] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(714), 2,
+      anon_sym_PIPE,
       anon_sym_RBRACK,
-  [1658] = 2,
+  [5879] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(210), 7,
+    ACTIONS(424), 2,
+      anon_sym_PIPE,
+      anon_sym_RBRACK,
+  [5887] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(714), 1,
+      anon_sym_RBRACK,
+    ACTIONS(716), 1,
+      anon_sym_PIPE,
+    STATE(355), 1,
+      aux_sym_key_union_type_repeat1,
+  [5900] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(719), 6,
       aux_sym__nls_token1,
-      anon_sym_AT,
-      anon_sym_POUND,
-      anon_sym_COMMA,
       anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
+      anon_sym_POUND,
       anon_sym_RBRACK,
-  [1671] = 7,
+  [5912] = 18,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(166), 1,
-      anon_sym_POUND,
-    ACTIONS(170), 1,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(73), 1,
+      anon_sym_true,
+    ACTIONS(75), 1,
+      anon_sym_false,
+    ACTIONS(89), 1,
       anon_sym_LBRACE,
-    ACTIONS(214), 1,
-      anon_sym_EQ,
-    STATE(120), 1,
-      sym_plugin_block,
-    STATE(178), 1,
-      sym_entity_id,
-    ACTIONS(212), 2,
+    ACTIONS(207), 1,
       aux_sym__nls_token1,
-      anon_sym_RBRACE,
-  [1694] = 2,
+    ACTIONS(445), 1,
+      sym_identifier,
+    ACTIONS(447), 1,
+      anon_sym_LBRACK,
+    ACTIONS(449), 1,
+      sym_number_literal,
+    ACTIONS(451), 1,
+      sym_null_literal,
+    ACTIONS(721), 1,
+      anon_sym_RBRACK,
+    STATE(204), 1,
+      aux_sym__nls,
+    STATE(273), 1,
+      sym_identifier_value,
+    STATE(274), 1,
+      sym_array_literal,
+    STATE(275), 1,
+      sym_object_literal,
+    STATE(276), 1,
+      sym_string_literal,
+    STATE(277), 1,
+      sym_boolean_literal,
+    STATE(407), 1,
+      sym__value,
+  [5967] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(216), 7,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-      anon_sym_AT,
-      anon_sym_POUND,
+    ACTIONS(723), 1,
       anon_sym_COMMA,
-      anon_sym_LBRACE,
-      anon_sym_RBRACE,
+    ACTIONS(725), 1,
       anon_sym_RBRACK,
-  [1707] = 7,
+    STATE(410), 1,
+      aux_sym__nls,
+    STATE(411), 1,
+      aux_sym_array_literal_repeat1,
+  [5986] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(166), 1,
-      anon_sym_POUND,
-    ACTIONS(170), 1,
-      anon_sym_LBRACE,
-    ACTIONS(220), 1,
-      anon_sym_EQ,
-    STATE(116), 1,
-      sym_plugin_block,
-    STATE(180), 1,
-      sym_entity_id,
-    ACTIONS(218), 2,
+    ACTIONS(727), 7,
       aux_sym__nls_token1,
+      anon_sym_AT,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-  [1730] = 7,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [5999] = 9,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(31), 1,
+    ACTIONS(37), 1,
       anon_sym_DQUOTE,
-    ACTIONS(222), 1,
+    ACTIONS(205), 1,
+      sym_identifier,
+    ACTIONS(207), 1,
       aux_sym__nls_token1,
-    STATE(68), 1,
+    ACTIONS(211), 1,
+      sym_number_literal,
+    ACTIONS(729), 1,
+      anon_sym_RBRACE,
+    STATE(136), 1,
+      sym_string_literal,
+    STATE(204), 1,
       aux_sym__nls,
-    STATE(153), 1,
+    STATE(366), 1,
       sym_object_entry,
-    STATE(197), 1,
-      sym_string_literal,
-    ACTIONS(176), 2,
-      sym_number_literal,
-      sym_identifier,
-  [1753] = 2,
+  [6027] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(224), 7,
+    ACTIONS(727), 7,
       aux_sym__nls_token1,
       anon_sym_AT,
-      anon_sym_POUND,
-      anon_sym_COMMA,
       anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
+      anon_sym_POUND,
       anon_sym_RBRACK,
-  [1766] = 6,
+  [6040] = 9,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(31), 1,
+    ACTIONS(37), 1,
       anon_sym_DQUOTE,
-    ACTIONS(84), 1,
+    ACTIONS(205), 1,
       sym_identifier,
-    ACTIONS(226), 1,
+    ACTIONS(207), 1,
+      aux_sym__nls_token1,
+    ACTIONS(211), 1,
       sym_number_literal,
-    STATE(32), 1,
-      sym_qualified_identifier,
-    STATE(173), 3,
-      sym_type_identifier,
-      sym__key_union_member,
+    ACTIONS(731), 1,
+      anon_sym_RBRACE,
+    STATE(136), 1,
       sym_string_literal,
-  [1787] = 7,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(21), 1,
-      aux_sym__nls_token1,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    STATE(5), 1,
-      aux_sym__nls,
-    STATE(142), 1,
+    STATE(285), 1,
       sym_object_entry,
-    STATE(197), 1,
-      sym_string_literal,
-    ACTIONS(176), 2,
-      sym_number_literal,
-      sym_identifier,
-  [1810] = 2,
+    STATE(414), 1,
+      aux_sym__nls,
+  [6068] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(228), 7,
+    ACTIONS(727), 7,
       aux_sym__nls_token1,
       anon_sym_AT,
-      anon_sym_POUND,
-      anon_sym_COMMA,
       anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
+      anon_sym_POUND,
       anon_sym_RBRACK,
-  [1823] = 2,
+  [6081] = 4,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(733), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+  [6094] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(230), 7,
+    ACTIONS(727), 7,
       aux_sym__nls_token1,
       anon_sym_AT,
-      anon_sym_POUND,
-      anon_sym_COMMA,
       anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
+      anon_sym_POUND,
       anon_sym_RBRACK,
-  [1836] = 4,
+  [6107] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(166), 1,
-      anon_sym_POUND,
-    STATE(117), 1,
-      sym_entity_id,
-    ACTIONS(232), 4,
-      ts_builtin_sym_end,
+    ACTIONS(735), 3,
       aux_sym__nls_token1,
-      anon_sym_DASH,
-      sym_identifier,
-  [1852] = 2,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [6116] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(234), 6,
+    ACTIONS(727), 7,
       aux_sym__nls_token1,
-      anon_sym_POUND,
-      anon_sym_COMMA,
+      anon_sym_AT,
       anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
+      anon_sym_POUND,
       anon_sym_RBRACK,
-  [1864] = 2,
+  [6129] = 9,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(236), 6,
-      ts_builtin_sym_end,
-      aux_sym__nls_token1,
-      anon_sym_DASH,
-      anon_sym_POUND,
-      anon_sym_RBRACE,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(205), 1,
       sym_identifier,
-  [1876] = 6,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(119), 1,
-      anon_sym_AT,
-    ACTIONS(238), 1,
+    ACTIONS(207), 1,
       aux_sym__nls_token1,
-    ACTIONS(240), 1,
+    ACTIONS(211), 1,
+      sym_number_literal,
+    ACTIONS(737), 1,
       anon_sym_RBRACE,
-    STATE(95), 1,
+    STATE(136), 1,
+      sym_string_literal,
+    STATE(204), 1,
       aux_sym__nls,
-    STATE(79), 2,
-      sym_plugin_config,
-      aux_sym_plugin_block_repeat1,
-  [1896] = 2,
+    STATE(366), 1,
+      sym_object_entry,
+  [6157] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(242), 6,
+    ACTIONS(727), 7,
       aux_sym__nls_token1,
-      anon_sym_POUND,
-      anon_sym_COMMA,
+      anon_sym_AT,
       anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
+      anon_sym_POUND,
       anon_sym_RBRACK,
-  [1908] = 4,
+  [6170] = 8,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(166), 1,
-      anon_sym_POUND,
-    STATE(137), 1,
-      sym_entity_id,
-    ACTIONS(244), 4,
-      ts_builtin_sym_end,
-      aux_sym__nls_token1,
-      anon_sym_DASH,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(205), 1,
       sym_identifier,
-  [1924] = 2,
+    ACTIONS(207), 1,
+      aux_sym__nls_token1,
+    ACTIONS(211), 1,
+      sym_number_literal,
+    STATE(136), 1,
+      sym_string_literal,
+    STATE(285), 1,
+      sym_object_entry,
+    STATE(417), 1,
+      aux_sym__nls,
+  [6195] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(246), 6,
-      ts_builtin_sym_end,
-      aux_sym__nls_token1,
-      anon_sym_DASH,
-      anon_sym_POUND,
-      anon_sym_RBRACE,
-      sym_identifier,
-  [1936] = 6,
+    ACTIONS(739), 1,
+      anon_sym_from,
+  [6202] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(119), 1,
-      anon_sym_AT,
-    ACTIONS(248), 1,
+    ACTIONS(741), 1,
+      anon_sym_from,
+  [6209] = 6,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(250), 1,
+    ACTIONS(93), 1,
+      sym_identifier,
+    ACTIONS(743), 1,
       anon_sym_RBRACE,
-    STATE(103), 1,
+    STATE(37), 1,
       aux_sym__nls,
-    STATE(82), 2,
-      sym_plugin_config,
-      aux_sym_plugin_block_repeat1,
-  [1956] = 6,
+    STATE(299), 1,
+      sym_import_name,
+  [6228] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(119), 1,
-      anon_sym_AT,
-    ACTIONS(248), 1,
+    ACTIONS(741), 1,
+      anon_sym_from,
+  [6235] = 2,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(745), 1,
+      anon_sym_from,
+  [6242] = 5,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(250), 1,
-      anon_sym_RBRACE,
-    STATE(103), 1,
+    ACTIONS(93), 1,
+      sym_identifier,
+    STATE(37), 1,
       aux_sym__nls,
-    STATE(83), 2,
-      sym_plugin_config,
-      aux_sym_plugin_block_repeat1,
-  [1976] = 2,
+    STATE(299), 1,
+      sym_import_name,
+  [6258] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(252), 6,
+    ACTIONS(747), 7,
+      ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
-      anon_sym_POUND,
       anon_sym_COMMA,
-      anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_RBRACK,
-  [1988] = 2,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [6271] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(254), 6,
+    ACTIONS(747), 7,
       ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
       anon_sym_DASH,
       anon_sym_POUND,
-      anon_sym_RBRACE,
-      sym_identifier,
-  [2000] = 6,
+  [6284] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(119), 1,
-      anon_sym_AT,
-    ACTIONS(256), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(258), 1,
+    ACTIONS(55), 1,
+      anon_sym_AT,
+    ACTIONS(749), 1,
       anon_sym_RBRACE,
-    STATE(111), 1,
+    STATE(37), 1,
       aux_sym__nls,
-    STATE(83), 2,
+    STATE(309), 1,
       sym_plugin_config,
-      aux_sym_plugin_block_repeat1,
-  [2020] = 6,
+  [6303] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(260), 1,
+    ACTIONS(747), 7,
+      ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
-    ACTIONS(263), 1,
-      anon_sym_AT,
-    ACTIONS(266), 1,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-    STATE(124), 1,
-      aux_sym__nls,
-    STATE(83), 2,
-      sym_plugin_config,
-      aux_sym_plugin_block_repeat1,
-  [2040] = 6,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [6316] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(166), 1,
-      anon_sym_POUND,
-    ACTIONS(170), 1,
-      anon_sym_LBRACE,
-    STATE(125), 1,
-      sym_plugin_block,
-    STATE(169), 1,
-      sym_entity_id,
-    ACTIONS(268), 2,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-      anon_sym_RBRACE,
-  [2060] = 2,
+    ACTIONS(55), 1,
+      anon_sym_AT,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(309), 1,
+      sym_plugin_config,
+  [6332] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(270), 6,
-      aux_sym__nls_token1,
-      anon_sym_POUND,
-      anon_sym_COMMA,
-      anon_sym_LBRACE,
-      anon_sym_RBRACE,
-      anon_sym_RBRACK,
-  [2072] = 4,
+    ACTIONS(751), 1,
+      anon_sym_RPAREN,
+  [6339] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(166), 1,
-      anon_sym_POUND,
-    STATE(121), 1,
-      sym_entity_id,
-    ACTIONS(272), 4,
-      ts_builtin_sym_end,
+    ACTIONS(753), 3,
       aux_sym__nls_token1,
-      anon_sym_DASH,
-      sym_identifier,
-  [2088] = 2,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+  [6348] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(274), 6,
-      ts_builtin_sym_end,
-      aux_sym__nls_token1,
-      anon_sym_DASH,
+    ACTIONS(83), 1,
       anon_sym_POUND,
+    STATE(421), 1,
+      sym_entity_id,
+    ACTIONS(755), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-      sym_identifier,
-  [2100] = 6,
+  [6363] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(166), 1,
+    ACTIONS(83), 1,
       anon_sym_POUND,
-    ACTIONS(170), 1,
+    ACTIONS(143), 1,
       anon_sym_LBRACE,
-    STATE(133), 1,
-      sym_plugin_block,
-    STATE(160), 1,
+    STATE(422), 1,
       sym_entity_id,
-    ACTIONS(276), 2,
+    STATE(423), 1,
+      sym_plugin_block,
+    ACTIONS(757), 3,
       aux_sym__nls_token1,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-  [2120] = 2,
+  [6384] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(278), 6,
+    ACTIONS(759), 3,
       aux_sym__nls_token1,
-      anon_sym_POUND,
       anon_sym_COMMA,
-      anon_sym_LBRACE,
       anon_sym_RBRACE,
-      anon_sym_RBRACK,
-  [2132] = 2,
+  [6393] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(280), 6,
+    ACTIONS(761), 12,
       ts_builtin_sym_end,
-      aux_sym__nls_token1,
-      anon_sym_DASH,
-      anon_sym_POUND,
-      anon_sym_RBRACE,
       sym_identifier,
-  [2144] = 2,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(282), 6,
       aux_sym__nls_token1,
-      anon_sym_POUND,
-      anon_sym_COMMA,
       anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-      anon_sym_RBRACK,
-  [2156] = 2,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+  [6411] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(284), 6,
+    ACTIONS(763), 12,
+      ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
-      anon_sym_POUND,
-      anon_sym_COMMA,
       anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-      anon_sym_RBRACK,
-  [2168] = 6,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+  [6429] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(29), 1,
-      anon_sym_RBRACK,
-    ACTIONS(286), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(288), 1,
-      anon_sym_COMMA,
-    STATE(108), 1,
-      aux_sym_array_literal_repeat1,
-    STATE(149), 1,
+    ACTIONS(169), 1,
+      sym_identifier,
+    ACTIONS(765), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
       aux_sym__nls,
-  [2187] = 2,
+    STATE(334), 1,
+      sym_field_definition,
+  [6448] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(290), 5,
+    ACTIONS(763), 12,
       ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
       anon_sym_DASH,
       anon_sym_POUND,
-      sym_identifier,
-  [2198] = 6,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+  [6466] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(767), 12,
+      ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
-    ACTIONS(119), 1,
-      anon_sym_AT,
-    ACTIONS(250), 1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-    STATE(5), 1,
-      aux_sym__nls,
-    STATE(144), 1,
-      sym_plugin_config,
-  [2217] = 4,
+      anon_sym_DASH,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+  [6484] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(294), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    STATE(112), 1,
-      aux_sym__nls,
-    ACTIONS(292), 3,
-      ts_builtin_sym_end,
-      anon_sym_DASH,
+    ACTIONS(169), 1,
       sym_identifier,
-  [2232] = 6,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(296), 1,
-      anon_sym_extends,
-    ACTIONS(298), 1,
-      anon_sym_COLON,
-    ACTIONS(300), 1,
-      anon_sym_LBRACE,
-    STATE(86), 1,
-      sym_model_body,
-    STATE(161), 1,
-      sym_extends_clause,
-  [2251] = 5,
+    STATE(37), 1,
+      aux_sym__nls,
+    STATE(334), 1,
+      sym_field_definition,
+  [6500] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(166), 1,
-      anon_sym_POUND,
-    ACTIONS(304), 1,
-      anon_sym_COLON,
-    STATE(164), 1,
-      sym_entity_id,
-    ACTIONS(302), 2,
+    ACTIONS(769), 3,
       aux_sym__nls_token1,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-  [2268] = 2,
+  [6509] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(306), 5,
+    ACTIONS(771), 6,
       ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
+      anon_sym_LBRACE,
       anon_sym_DASH,
       anon_sym_POUND,
-      sym_identifier,
-  [2279] = 6,
+  [6521] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(182), 1,
-      anon_sym_RBRACE,
-    ACTIONS(308), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(310), 1,
-      anon_sym_COMMA,
-    STATE(101), 1,
-      aux_sym_object_literal_repeat1,
-    STATE(148), 1,
+    ACTIONS(773), 1,
+      sym_identifier,
+    ACTIONS(775), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
       aux_sym__nls,
-  [2298] = 6,
+  [6537] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(180), 1,
-      anon_sym_RBRACE,
-    ACTIONS(312), 1,
+    ACTIONS(769), 3,
       aux_sym__nls_token1,
-    ACTIONS(314), 1,
       anon_sym_COMMA,
-    STATE(136), 1,
-      aux_sym_object_literal_repeat1,
-    STATE(151), 1,
-      aux_sym__nls,
-  [2317] = 2,
+      anon_sym_RBRACE,
+  [6546] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(316), 5,
+    ACTIONS(771), 6,
       ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
+      anon_sym_LBRACE,
       anon_sym_DASH,
       anon_sym_POUND,
-      sym_identifier,
-  [2328] = 6,
+  [6558] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(119), 1,
-      anon_sym_AT,
-    ACTIONS(258), 1,
+    ACTIONS(683), 1,
+      sym_identifier,
+    ACTIONS(777), 1,
       anon_sym_RBRACE,
-    STATE(5), 1,
+    STATE(428), 1,
       aux_sym__nls,
-    STATE(144), 1,
-      sym_plugin_config,
-  [2347] = 2,
+  [6574] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(318), 5,
+    ACTIONS(779), 6,
       ts_builtin_sym_end,
-      aux_sym__nls_token1,
-      anon_sym_DASH,
-      anon_sym_POUND,
       sym_identifier,
-  [2358] = 2,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(320), 5,
-      ts_builtin_sym_end,
       aux_sym__nls_token1,
+      anon_sym_LBRACE,
       anon_sym_DASH,
       anon_sym_POUND,
-      sym_identifier,
-  [2369] = 6,
+  [6586] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(119), 1,
-      anon_sym_AT,
-    ACTIONS(322), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(324), 1,
+    ACTIONS(689), 1,
+      sym_identifier,
+    ACTIONS(781), 1,
       anon_sym_RBRACE,
-    STATE(74), 1,
-      sym_plugin_config,
-    STATE(110), 1,
+    STATE(37), 1,
       aux_sym__nls,
-  [2388] = 6,
+  [6602] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(74), 1,
-      anon_sym_RBRACK,
-    ACTIONS(326), 1,
+    ACTIONS(783), 1,
       aux_sym__nls_token1,
-    ACTIONS(328), 1,
+    ACTIONS(786), 1,
       anon_sym_COMMA,
-    STATE(113), 1,
-      aux_sym_array_literal_repeat1,
-    STATE(154), 1,
+    ACTIONS(789), 1,
+      anon_sym_RBRACE,
+    STATE(401), 1,
+      aux_sym_projection_fields_repeat1,
+    STATE(431), 1,
       aux_sym__nls,
-  [2407] = 6,
+  [6621] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(74), 1,
-      anon_sym_RBRACK,
-    ACTIONS(326), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(328), 1,
-      anon_sym_COMMA,
-    STATE(130), 1,
-      aux_sym_array_literal_repeat1,
-    STATE(154), 1,
+    ACTIONS(683), 1,
+      sym_identifier,
+    ACTIONS(791), 1,
+      anon_sym_RBRACE,
+    STATE(433), 1,
       aux_sym__nls,
-  [2426] = 2,
+  [6637] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(330), 5,
+    ACTIONS(793), 6,
       ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
+      anon_sym_LBRACE,
       anon_sym_DASH,
-      anon_sym_RBRACE,
-      sym_identifier,
-  [2437] = 6,
+      anon_sym_POUND,
+  [6649] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(119), 1,
-      anon_sym_AT,
-    ACTIONS(240), 1,
+    ACTIONS(689), 1,
+      sym_identifier,
+    ACTIONS(795), 1,
       anon_sym_RBRACE,
-    STATE(5), 1,
+    STATE(37), 1,
       aux_sym__nls,
-    STATE(78), 1,
-      sym_plugin_config,
-  [2456] = 6,
+  [6665] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(119), 1,
-      anon_sym_AT,
-    ACTIONS(332), 1,
+    ACTIONS(797), 1,
+      anon_sym_COMMA,
+    ACTIONS(799), 1,
       anon_sym_RBRACE,
-    STATE(5), 1,
+    STATE(401), 1,
+      aux_sym_projection_fields_repeat1,
+    STATE(437), 1,
       aux_sym__nls,
-    STATE(144), 1,
-      sym_plugin_config,
-  [2475] = 4,
+  [6684] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(801), 6,
       aux_sym__nls_token1,
-    STATE(5), 1,
-      aux_sym__nls,
-    ACTIONS(184), 3,
-      ts_builtin_sym_end,
-      anon_sym_DASH,
-      sym_identifier,
-  [2490] = 6,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [6696] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(56), 1,
-      anon_sym_RBRACK,
-    ACTIONS(334), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(336), 1,
+    ACTIONS(803), 1,
       anon_sym_COMMA,
-    STATE(130), 1,
-      aux_sym_array_literal_repeat1,
-    STATE(138), 1,
+    ACTIONS(805), 1,
+      anon_sym_RBRACK,
+    STATE(440), 1,
       aux_sym__nls,
-  [2509] = 6,
+    STATE(441), 1,
+      aux_sym_array_literal_repeat1,
+  [6715] = 18,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(180), 1,
-      anon_sym_RBRACE,
-    ACTIONS(312), 1,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(73), 1,
+      anon_sym_true,
+    ACTIONS(75), 1,
+      anon_sym_false,
+    ACTIONS(89), 1,
+      anon_sym_LBRACE,
+    ACTIONS(207), 1,
       aux_sym__nls_token1,
-    ACTIONS(314), 1,
-      anon_sym_COMMA,
-    STATE(115), 1,
-      aux_sym_object_literal_repeat1,
-    STATE(151), 1,
+    ACTIONS(445), 1,
+      sym_identifier,
+    ACTIONS(447), 1,
+      anon_sym_LBRACK,
+    ACTIONS(449), 1,
+      sym_number_literal,
+    ACTIONS(451), 1,
+      sym_null_literal,
+    ACTIONS(807), 1,
+      anon_sym_RBRACK,
+    STATE(273), 1,
+      sym_identifier_value,
+    STATE(274), 1,
+      sym_array_literal,
+    STATE(275), 1,
+      sym_object_literal,
+    STATE(276), 1,
+      sym_string_literal,
+    STATE(277), 1,
+      sym_boolean_literal,
+    STATE(443), 1,
       aux_sym__nls,
-  [2528] = 6,
+    STATE(444), 1,
+      sym__value,
+  [6770] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(198), 1,
-      anon_sym_RBRACE,
-    ACTIONS(338), 1,
+    ACTIONS(801), 6,
       aux_sym__nls_token1,
-    ACTIONS(340), 1,
+      anon_sym_LBRACE,
       anon_sym_COMMA,
-    STATE(136), 1,
-      aux_sym_object_literal_repeat1,
-    STATE(141), 1,
-      aux_sym__nls,
-  [2547] = 4,
+      anon_sym_RBRACE,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [6782] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(166), 1,
-      anon_sym_POUND,
-    STATE(170), 1,
-      sym_entity_id,
-    ACTIONS(342), 2,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-      anon_sym_RBRACE,
-  [2561] = 2,
+    ACTIONS(809), 1,
+      anon_sym_RBRACK,
+    STATE(37), 1,
+      aux_sym__nls,
+  [6795] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(344), 4,
-      ts_builtin_sym_end,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-      anon_sym_DASH,
-      sym_identifier,
-  [2571] = 4,
-    ACTIONS(346), 1,
-      sym_comment,
-    ACTIONS(348), 1,
-      anon_sym_DQUOTE,
-    STATE(118), 1,
-      aux_sym_string_literal_repeat1,
-    ACTIONS(350), 2,
-      sym_string_content,
-      sym_escape_sequence,
-  [2585] = 4,
-    ACTIONS(346), 1,
-      sym_comment,
-    ACTIONS(353), 1,
-      anon_sym_DQUOTE,
-    STATE(118), 1,
-      aux_sym_string_literal_repeat1,
-    ACTIONS(355), 2,
-      sym_string_content,
-      sym_escape_sequence,
-  [2599] = 4,
+    ACTIONS(811), 1,
+      anon_sym_COMMA,
+    ACTIONS(813), 1,
+      anon_sym_RBRACK,
+    STATE(448), 1,
+      aux_sym__nls,
+    STATE(449), 1,
+      aux_sym_array_literal_repeat1,
+  [6814] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(166), 1,
-      anon_sym_POUND,
-    STATE(175), 1,
-      sym_entity_id,
-    ACTIONS(357), 2,
+    ACTIONS(815), 7,
       aux_sym__nls_token1,
+      anon_sym_AT,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-  [2613] = 2,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [6827] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(359), 4,
-      ts_builtin_sym_end,
+    ACTIONS(815), 7,
       aux_sym__nls_token1,
-      anon_sym_DASH,
+      anon_sym_AT,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [6840] = 9,
+    ACTIONS(3), 1,
+      sym_comment,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(205), 1,
       sym_identifier,
-  [2623] = 2,
+    ACTIONS(207), 1,
+      aux_sym__nls_token1,
+    ACTIONS(211), 1,
+      sym_number_literal,
+    ACTIONS(817), 1,
+      anon_sym_RBRACE,
+    STATE(136), 1,
+      sym_string_literal,
+    STATE(204), 1,
+      aux_sym__nls,
+    STATE(366), 1,
+      sym_object_entry,
+  [6868] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(361), 4,
+    ACTIONS(815), 7,
       aux_sym__nls_token1,
+      anon_sym_AT,
+      anon_sym_LBRACE,
       anon_sym_COMMA,
       anon_sym_RBRACE,
+      anon_sym_POUND,
       anon_sym_RBRACK,
-  [2633] = 5,
+  [6881] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(131), 1,
-      anon_sym_RBRACE,
-    ACTIONS(363), 1,
+    ACTIONS(815), 7,
       aux_sym__nls_token1,
-    STATE(31), 1,
-      aux_sym__nls,
-    STATE(127), 1,
-      aux_sym_model_body_repeat1,
-  [2649] = 5,
+      anon_sym_AT,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [6894] = 8,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(205), 1,
+      sym_identifier,
+    ACTIONS(207), 1,
       aux_sym__nls_token1,
-    ACTIONS(119), 1,
-      anon_sym_AT,
-    STATE(5), 1,
+    ACTIONS(211), 1,
+      sym_number_literal,
+    STATE(136), 1,
+      sym_string_literal,
+    STATE(204), 1,
       aux_sym__nls,
-    STATE(144), 1,
-      sym_plugin_config,
-  [2665] = 4,
+    STATE(366), 1,
+      sym_object_entry,
+  [6919] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(166), 1,
-      anon_sym_POUND,
-    STATE(176), 1,
-      sym_entity_id,
-    ACTIONS(365), 2,
-      aux_sym__nls_token1,
-      anon_sym_RBRACE,
-  [2679] = 2,
+    ACTIONS(819), 1,
+      anon_sym_from,
+  [6926] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(367), 4,
+    ACTIONS(821), 7,
       ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
       anon_sym_DASH,
-      sym_identifier,
-  [2689] = 5,
+      anon_sym_POUND,
+  [6939] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(369), 1,
+    ACTIONS(823), 5,
       aux_sym__nls_token1,
-    ACTIONS(372), 1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-    STATE(39), 1,
-      aux_sym__nls,
-    STATE(127), 1,
-      aux_sym_model_body_repeat1,
-  [2705] = 5,
+      anon_sym_POUND,
+  [6950] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(135), 1,
-      anon_sym_RBRACE,
-    ACTIONS(374), 1,
+    ACTIONS(825), 3,
       aux_sym__nls_token1,
-    STATE(27), 1,
-      aux_sym__nls,
-    STATE(132), 1,
-      aux_sym_model_body_repeat1,
-  [2721] = 5,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(123), 1,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-    ACTIONS(376), 1,
-      aux_sym__nls_token1,
-    STATE(30), 1,
-      aux_sym__nls,
-    STATE(123), 1,
-      aux_sym_model_body_repeat1,
-  [2737] = 4,
+  [6959] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(380), 1,
-      anon_sym_COMMA,
-    STATE(130), 1,
-      aux_sym_array_literal_repeat1,
-    ACTIONS(378), 2,
+    ACTIONS(827), 3,
       aux_sym__nls_token1,
-      anon_sym_RBRACK,
-  [2751] = 4,
-    ACTIONS(346), 1,
-      sym_comment,
-    ACTIONS(383), 1,
-      anon_sym_DQUOTE,
-    STATE(119), 1,
-      aux_sym_string_literal_repeat1,
-    ACTIONS(385), 2,
-      sym_string_content,
-      sym_escape_sequence,
-  [2765] = 5,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(123), 1,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-    ACTIONS(376), 1,
-      aux_sym__nls_token1,
-    STATE(30), 1,
-      aux_sym__nls,
-    STATE(127), 1,
-      aux_sym_model_body_repeat1,
-  [2781] = 4,
+  [6968] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(166), 1,
+    ACTIONS(83), 1,
       anon_sym_POUND,
-    STATE(181), 1,
+    STATE(451), 1,
       sym_entity_id,
-    ACTIONS(387), 2,
+    ACTIONS(829), 3,
       aux_sym__nls_token1,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-  [2795] = 2,
+  [6983] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(389), 4,
+    ACTIONS(831), 12,
       ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
       anon_sym_DASH,
-      sym_identifier,
-  [2805] = 5,
+      anon_sym_POUND,
+      anon_sym_GT,
+      anon_sym_EQ,
+      anon_sym_PIPE,
+      anon_sym_LBRACK,
+  [7001] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(25), 1,
-      anon_sym_LBRACE,
-    ACTIONS(391), 1,
+    ACTIONS(833), 3,
       aux_sym__nls_token1,
-    ACTIONS(393), 1,
-      anon_sym_from,
-    STATE(200), 1,
-      sym_object_literal,
-  [2821] = 4,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(397), 1,
       anon_sym_COMMA,
-    STATE(136), 1,
-      aux_sym_object_literal_repeat1,
-    ACTIONS(395), 2,
-      aux_sym__nls_token1,
       anon_sym_RBRACE,
-  [2835] = 2,
+  [7010] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(400), 4,
+    ACTIONS(835), 6,
       ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
+      anon_sym_LBRACE,
       anon_sym_DASH,
-      sym_identifier,
-  [2845] = 4,
+      anon_sym_POUND,
+  [7022] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(837), 6,
+      ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
-    ACTIONS(52), 1,
-      anon_sym_RBRACK,
-    STATE(5), 1,
-      aux_sym__nls,
-  [2858] = 4,
+      anon_sym_LBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [7034] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(25), 1,
-      anon_sym_LBRACE,
-    ACTIONS(402), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    STATE(191), 1,
-      sym_object_literal,
-  [2871] = 2,
+    ACTIONS(773), 1,
+      sym_identifier,
+    ACTIONS(839), 1,
+      anon_sym_RBRACE,
+    STATE(37), 1,
+      aux_sym__nls,
+  [7050] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(404), 3,
+    ACTIONS(837), 6,
+      ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
-      anon_sym_AT,
-      anon_sym_RBRACE,
-  [2880] = 4,
+      anon_sym_LBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [7062] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(206), 1,
-      anon_sym_RBRACE,
-    STATE(5), 1,
+    ACTIONS(683), 1,
+      sym_identifier,
+    STATE(453), 1,
       aux_sym__nls,
-  [2893] = 2,
+  [7075] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(406), 3,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-      anon_sym_COMMA,
-      anon_sym_RBRACE,
-  [2902] = 4,
+    ACTIONS(689), 1,
+      sym_identifier,
+    STATE(37), 1,
+      aux_sym__nls,
+  [7088] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(25), 1,
-      anon_sym_LBRACE,
-    ACTIONS(408), 1,
+    ACTIONS(841), 6,
+      ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
-    STATE(189), 1,
-      sym_object_literal,
-  [2915] = 2,
+      anon_sym_LBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [7100] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(266), 3,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-      anon_sym_AT,
+    ACTIONS(773), 1,
+      sym_identifier,
+    ACTIONS(843), 1,
       anon_sym_RBRACE,
-  [2924] = 4,
+    STATE(37), 1,
+      aux_sym__nls,
+  [7116] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(25), 1,
-      anon_sym_LBRACE,
-    ACTIONS(410), 1,
+    ACTIONS(841), 6,
+      ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
-    STATE(196), 1,
-      sym_object_literal,
-  [2937] = 4,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(412), 1,
-      anon_sym_PIPE,
-    ACTIONS(415), 1,
-      anon_sym_RBRACK,
-    STATE(146), 1,
-      aux_sym_key_union_type_repeat1,
-  [2950] = 4,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(417), 1,
-      anon_sym_COMMA,
-    ACTIONS(419), 1,
       anon_sym_LBRACE,
-    STATE(155), 1,
-      aux_sym_extends_clause_repeat1,
-  [2963] = 4,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [7128] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(180), 1,
+    ACTIONS(683), 1,
+      sym_identifier,
+    ACTIONS(845), 1,
       anon_sym_RBRACE,
-    STATE(5), 1,
-      aux_sym__nls,
-  [2976] = 4,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(21), 1,
-      aux_sym__nls_token1,
-    ACTIONS(74), 1,
-      anon_sym_RBRACK,
-    STATE(5), 1,
+    STATE(456), 1,
       aux_sym__nls,
-  [2989] = 2,
+  [7144] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(421), 3,
+    ACTIONS(847), 6,
+      ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
-      anon_sym_COMMA,
-      anon_sym_RBRACE,
-  [2998] = 4,
+      anon_sym_LBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [7156] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(198), 1,
+    ACTIONS(689), 1,
+      sym_identifier,
+    ACTIONS(849), 1,
       anon_sym_RBRACE,
-    STATE(5), 1,
+    STATE(37), 1,
       aux_sym__nls,
-  [3011] = 4,
+  [7172] = 18,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(423), 1,
-      anon_sym_COMMA,
-    ACTIONS(426), 1,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(73), 1,
+      anon_sym_true,
+    ACTIONS(75), 1,
+      anon_sym_false,
+    ACTIONS(89), 1,
       anon_sym_LBRACE,
-    STATE(152), 1,
-      aux_sym_extends_clause_repeat1,
-  [3024] = 2,
+    ACTIONS(207), 1,
+      aux_sym__nls_token1,
+    ACTIONS(445), 1,
+      sym_identifier,
+    ACTIONS(447), 1,
+      anon_sym_LBRACK,
+    ACTIONS(449), 1,
+      sym_number_literal,
+    ACTIONS(451), 1,
+      sym_null_literal,
+    ACTIONS(851), 1,
+      anon_sym_RBRACK,
+    STATE(273), 1,
+      sym_identifier_value,
+    STATE(274), 1,
+      sym_array_literal,
+    STATE(275), 1,
+      sym_object_literal,
+    STATE(276), 1,
+      sym_string_literal,
+    STATE(277), 1,
+      sym_boolean_literal,
+    STATE(444), 1,
+      sym__value,
+    STATE(459), 1,
+      aux_sym__nls,
+  [7227] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(395), 3,
+    ACTIONS(853), 6,
       aux_sym__nls_token1,
+      anon_sym_LBRACE,
       anon_sym_COMMA,
       anon_sym_RBRACE,
-  [3033] = 4,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [7239] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(21), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    ACTIONS(56), 1,
+    ACTIONS(855), 1,
       anon_sym_RBRACK,
-    STATE(5), 1,
+    STATE(37), 1,
       aux_sym__nls,
-  [3046] = 4,
+  [7252] = 6,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(417), 1,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(857), 1,
       anon_sym_COMMA,
-    ACTIONS(428), 1,
-      anon_sym_LBRACE,
-    STATE(152), 1,
-      aux_sym_extends_clause_repeat1,
-  [3059] = 2,
+    ACTIONS(859), 1,
+      anon_sym_RBRACK,
+    STATE(449), 1,
+      aux_sym_array_literal_repeat1,
+    STATE(463), 1,
+      aux_sym__nls,
+  [7271] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(378), 3,
+    ACTIONS(853), 6,
       aux_sym__nls_token1,
+      anon_sym_LBRACE,
       anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_POUND,
       anon_sym_RBRACK,
-  [3068] = 3,
+  [7283] = 18,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(430), 1,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(73), 1,
+      anon_sym_true,
+    ACTIONS(75), 1,
+      anon_sym_false,
+    ACTIONS(89), 1,
+      anon_sym_LBRACE,
+    ACTIONS(207), 1,
+      aux_sym__nls_token1,
+    ACTIONS(445), 1,
       sym_identifier,
-    STATE(29), 2,
-      sym_qualified_identifier,
-      sym__qualified_name_rest,
-  [3079] = 4,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(432), 1,
-      anon_sym_PIPE,
-    ACTIONS(434), 1,
+    ACTIONS(447), 1,
+      anon_sym_LBRACK,
+    ACTIONS(449), 1,
+      sym_number_literal,
+    ACTIONS(451), 1,
+      sym_null_literal,
+    ACTIONS(861), 1,
       anon_sym_RBRACK,
-    STATE(146), 1,
-      aux_sym_key_union_type_repeat1,
-  [3092] = 2,
+    STATE(204), 1,
+      aux_sym__nls,
+    STATE(273), 1,
+      sym_identifier_value,
+    STATE(274), 1,
+      sym_array_literal,
+    STATE(275), 1,
+      sym_object_literal,
+    STATE(276), 1,
+      sym_string_literal,
+    STATE(277), 1,
+      sym_boolean_literal,
+    STATE(465), 1,
+      sym__value,
+  [7338] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(436), 3,
+    ACTIONS(863), 3,
       aux_sym__nls_token1,
       anon_sym_COMMA,
       anon_sym_RBRACK,
-  [3101] = 2,
+  [7347] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(438), 2,
+    ACTIONS(853), 6,
       aux_sym__nls_token1,
-      anon_sym_RBRACE,
-  [3109] = 3,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(300), 1,
       anon_sym_LBRACE,
-    STATE(71), 1,
-      sym_model_body,
-  [3119] = 3,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [7359] = 18,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(25), 1,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(73), 1,
+      anon_sym_true,
+    ACTIONS(75), 1,
+      anon_sym_false,
+    ACTIONS(89), 1,
       anon_sym_LBRACE,
-    STATE(140), 1,
-      sym_object_literal,
-  [3129] = 3,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(432), 1,
-      anon_sym_PIPE,
-    STATE(158), 1,
-      aux_sym_key_union_type_repeat1,
-  [3139] = 2,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(440), 2,
+    ACTIONS(207), 1,
       aux_sym__nls_token1,
-      anon_sym_RBRACE,
-  [3147] = 2,
+    ACTIONS(445), 1,
+      sym_identifier,
+    ACTIONS(447), 1,
+      anon_sym_LBRACK,
+    ACTIONS(449), 1,
+      sym_number_literal,
+    ACTIONS(451), 1,
+      sym_null_literal,
+    ACTIONS(865), 1,
+      anon_sym_RBRACK,
+    STATE(273), 1,
+      sym_identifier_value,
+    STATE(274), 1,
+      sym_array_literal,
+    STATE(275), 1,
+      sym_object_literal,
+    STATE(276), 1,
+      sym_string_literal,
+    STATE(277), 1,
+      sym_boolean_literal,
+    STATE(444), 1,
+      sym__value,
+    STATE(467), 1,
+      aux_sym__nls,
+  [7414] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(442), 2,
+    ACTIONS(853), 6,
       aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-  [3155] = 3,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [7426] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    STATE(139), 1,
-      sym_string_literal,
-  [3165] = 2,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(867), 1,
+      anon_sym_RBRACK,
+    STATE(37), 1,
+      aux_sym__nls,
+  [7439] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(444), 2,
+    ACTIONS(869), 1,
       anon_sym_COMMA,
-      anon_sym_LBRACE,
-  [3173] = 3,
+    STATE(449), 1,
+      aux_sym_array_literal_repeat1,
+    ACTIONS(863), 2,
+      aux_sym__nls_token1,
+      anon_sym_RBRACK,
+  [7453] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(446), 1,
-      anon_sym_PIPE,
-    ACTIONS(448), 1,
+    ACTIONS(872), 7,
+      aux_sym__nls_token1,
+      anon_sym_AT,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_POUND,
       anon_sym_RBRACK,
-  [3183] = 2,
+  [7466] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(450), 2,
+    ACTIONS(874), 3,
       aux_sym__nls_token1,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-  [3191] = 2,
+  [7475] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(452), 2,
+    ACTIONS(876), 6,
+      ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
-      anon_sym_RBRACE,
-  [3199] = 3,
+      anon_sym_LBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [7487] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(31), 1,
-      anon_sym_DQUOTE,
-    STATE(145), 1,
-      sym_string_literal,
-  [3209] = 2,
+    ACTIONS(9), 1,
+      aux_sym__nls_token1,
+    ACTIONS(773), 1,
+      sym_identifier,
+    STATE(37), 1,
+      aux_sym__nls,
+  [7500] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(454), 2,
+    ACTIONS(878), 6,
+      ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
-      anon_sym_RBRACE,
-  [3217] = 2,
+      anon_sym_LBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [7512] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(415), 2,
-      anon_sym_PIPE,
-      anon_sym_RBRACK,
-  [3225] = 2,
+    ACTIONS(880), 6,
+      ts_builtin_sym_end,
+      sym_identifier,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [7524] = 5,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(372), 2,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
+    ACTIONS(773), 1,
+      sym_identifier,
+    ACTIONS(882), 1,
       anon_sym_RBRACE,
-  [3233] = 2,
+    STATE(37), 1,
+      aux_sym__nls,
+  [7540] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(456), 2,
+    ACTIONS(880), 6,
+      ts_builtin_sym_end,
+      sym_identifier,
       aux_sym__nls_token1,
-      anon_sym_RBRACE,
-  [3241] = 2,
+      anon_sym_LBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [7552] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(458), 2,
+    ACTIONS(884), 6,
       aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-  [3249] = 3,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [7564] = 18,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(31), 1,
+    ACTIONS(37), 1,
       anon_sym_DQUOTE,
-    STATE(143), 1,
+    ACTIONS(73), 1,
+      anon_sym_true,
+    ACTIONS(75), 1,
+      anon_sym_false,
+    ACTIONS(89), 1,
+      anon_sym_LBRACE,
+    ACTIONS(207), 1,
+      aux_sym__nls_token1,
+    ACTIONS(445), 1,
+      sym_identifier,
+    ACTIONS(447), 1,
+      anon_sym_LBRACK,
+    ACTIONS(449), 1,
+      sym_number_literal,
+    ACTIONS(451), 1,
+      sym_null_literal,
+    ACTIONS(886), 1,
+      anon_sym_RBRACK,
+    STATE(204), 1,
+      aux_sym__nls,
+    STATE(273), 1,
+      sym_identifier_value,
+    STATE(274), 1,
+      sym_array_literal,
+    STATE(275), 1,
+      sym_object_literal,
+    STATE(276), 1,
       sym_string_literal,
-  [3259] = 2,
+    STATE(277), 1,
+      sym_boolean_literal,
+    STATE(465), 1,
+      sym__value,
+  [7619] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(460), 2,
+    ACTIONS(884), 6,
       aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-  [3267] = 3,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(156), 1,
-      anon_sym_PIPE,
-    STATE(41), 1,
-      aux_sym_union_type_repeat1,
-  [3277] = 2,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [7631] = 18,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(462), 2,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(73), 1,
+      anon_sym_true,
+    ACTIONS(75), 1,
+      anon_sym_false,
+    ACTIONS(89), 1,
+      anon_sym_LBRACE,
+    ACTIONS(207), 1,
       aux_sym__nls_token1,
-      anon_sym_RBRACE,
-  [3285] = 2,
+    ACTIONS(445), 1,
+      sym_identifier,
+    ACTIONS(447), 1,
+      anon_sym_LBRACK,
+    ACTIONS(449), 1,
+      sym_number_literal,
+    ACTIONS(451), 1,
+      sym_null_literal,
+    ACTIONS(888), 1,
+      anon_sym_RBRACK,
+    STATE(273), 1,
+      sym_identifier_value,
+    STATE(274), 1,
+      sym_array_literal,
+    STATE(275), 1,
+      sym_object_literal,
+    STATE(276), 1,
+      sym_string_literal,
+    STATE(277), 1,
+      sym_boolean_literal,
+    STATE(444), 1,
+      sym__value,
+    STATE(473), 1,
+      aux_sym__nls,
+  [7686] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(464), 2,
+    ACTIONS(884), 6,
       aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-  [3293] = 3,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [7698] = 4,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(466), 1,
+    ACTIONS(9), 1,
       aux_sym__nls_token1,
-    STATE(50), 1,
+    ACTIONS(890), 1,
+      anon_sym_RBRACK,
+    STATE(37), 1,
       aux_sym__nls,
-  [3303] = 2,
+  [7711] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(468), 2,
+    ACTIONS(884), 6,
       aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
       anon_sym_RBRACE,
-  [3311] = 2,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(470), 1,
-      sym_identifier,
-  [3318] = 2,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(472), 1,
-      sym_identifier,
-  [3325] = 2,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [7723] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(474), 1,
+    ACTIONS(892), 3,
+      aux_sym__nls_token1,
+      anon_sym_COMMA,
       anon_sym_RBRACK,
-  [3332] = 2,
+  [7732] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(476), 1,
-      sym_identifier,
-  [3339] = 2,
+    ACTIONS(884), 6,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [7744] = 18,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(478), 1,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(73), 1,
+      anon_sym_true,
+    ACTIONS(75), 1,
+      anon_sym_false,
+    ACTIONS(89), 1,
+      anon_sym_LBRACE,
+    ACTIONS(207), 1,
+      aux_sym__nls_token1,
+    ACTIONS(445), 1,
       sym_identifier,
-  [3346] = 2,
+    ACTIONS(447), 1,
+      anon_sym_LBRACK,
+    ACTIONS(449), 1,
+      sym_number_literal,
+    ACTIONS(451), 1,
+      sym_null_literal,
+    ACTIONS(894), 1,
+      anon_sym_RBRACK,
+    STATE(204), 1,
+      aux_sym__nls,
+    STATE(273), 1,
+      sym_identifier_value,
+    STATE(274), 1,
+      sym_array_literal,
+    STATE(275), 1,
+      sym_object_literal,
+    STATE(276), 1,
+      sym_string_literal,
+    STATE(277), 1,
+      sym_boolean_literal,
+    STATE(465), 1,
+      sym__value,
+  [7799] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(480), 1,
+    ACTIONS(884), 6,
       aux_sym__nls_token1,
-  [3353] = 2,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(482), 1,
-      aux_sym_entity_id_token1,
-  [3360] = 2,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [7811] = 17,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(484), 1,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(73), 1,
+      anon_sym_true,
+    ACTIONS(75), 1,
+      anon_sym_false,
+    ACTIONS(89), 1,
+      anon_sym_LBRACE,
+    ACTIONS(207), 1,
       aux_sym__nls_token1,
-  [3367] = 2,
+    ACTIONS(445), 1,
+      sym_identifier,
+    ACTIONS(447), 1,
+      anon_sym_LBRACK,
+    ACTIONS(449), 1,
+      sym_number_literal,
+    ACTIONS(451), 1,
+      sym_null_literal,
+    STATE(273), 1,
+      sym_identifier_value,
+    STATE(274), 1,
+      sym_array_literal,
+    STATE(275), 1,
+      sym_object_literal,
+    STATE(276), 1,
+      sym_string_literal,
+    STATE(277), 1,
+      sym_boolean_literal,
+    STATE(444), 1,
+      sym__value,
+    STATE(476), 1,
+      aux_sym__nls,
+  [7863] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(486), 1,
+    ACTIONS(896), 6,
       ts_builtin_sym_end,
-  [3374] = 2,
-    ACTIONS(3), 1,
-      sym_comment,
-    ACTIONS(488), 1,
       sym_identifier,
-  [3381] = 2,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_DASH,
+      anon_sym_POUND,
+  [7875] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(490), 1,
-      sym_identifier,
-  [3388] = 2,
+    ACTIONS(898), 6,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [7887] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(492), 1,
-      anon_sym_from,
-  [3395] = 2,
+    ACTIONS(898), 6,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [7899] = 18,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(494), 1,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(73), 1,
+      anon_sym_true,
+    ACTIONS(75), 1,
+      anon_sym_false,
+    ACTIONS(89), 1,
+      anon_sym_LBRACE,
+    ACTIONS(207), 1,
       aux_sym__nls_token1,
-  [3402] = 2,
+    ACTIONS(445), 1,
+      sym_identifier,
+    ACTIONS(447), 1,
+      anon_sym_LBRACK,
+    ACTIONS(449), 1,
+      sym_number_literal,
+    ACTIONS(451), 1,
+      sym_null_literal,
+    ACTIONS(900), 1,
+      anon_sym_RBRACK,
+    STATE(204), 1,
+      aux_sym__nls,
+    STATE(273), 1,
+      sym_identifier_value,
+    STATE(274), 1,
+      sym_array_literal,
+    STATE(275), 1,
+      sym_object_literal,
+    STATE(276), 1,
+      sym_string_literal,
+    STATE(277), 1,
+      sym_boolean_literal,
+    STATE(465), 1,
+      sym__value,
+  [7954] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(496), 1,
-      anon_sym_COLON,
-  [3409] = 2,
+    ACTIONS(898), 6,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [7966] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(498), 1,
-      anon_sym_LBRACK,
-  [3416] = 2,
+    ACTIONS(898), 6,
+      aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
+  [7978] = 17,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(500), 1,
+    ACTIONS(37), 1,
+      anon_sym_DQUOTE,
+    ACTIONS(73), 1,
+      anon_sym_true,
+    ACTIONS(75), 1,
+      anon_sym_false,
+    ACTIONS(89), 1,
+      anon_sym_LBRACE,
+    ACTIONS(207), 1,
+      aux_sym__nls_token1,
+    ACTIONS(445), 1,
       sym_identifier,
-  [3423] = 2,
+    ACTIONS(447), 1,
+      anon_sym_LBRACK,
+    ACTIONS(449), 1,
+      sym_number_literal,
+    ACTIONS(451), 1,
+      sym_null_literal,
+    STATE(204), 1,
+      aux_sym__nls,
+    STATE(273), 1,
+      sym_identifier_value,
+    STATE(274), 1,
+      sym_array_literal,
+    STATE(275), 1,
+      sym_object_literal,
+    STATE(276), 1,
+      sym_string_literal,
+    STATE(277), 1,
+      sym_boolean_literal,
+    STATE(465), 1,
+      sym__value,
+  [8030] = 2,
     ACTIONS(3), 1,
       sym_comment,
-    ACTIONS(502), 1,
+    ACTIONS(902), 6,
       aux_sym__nls_token1,
+      anon_sym_LBRACE,
+      anon_sym_COMMA,
+      anon_sym_RBRACE,
+      anon_sym_POUND,
+      anon_sym_RBRACK,
 };
 
 static const uint32_t ts_small_parse_table_map[] = {
   [SMALL_STATE(2)] = 0,
-  [SMALL_STATE(3)] = 46,
-  [SMALL_STATE(4)] = 89,
-  [SMALL_STATE(5)] = 132,
-  [SMALL_STATE(6)] = 161,
-  [SMALL_STATE(7)] = 204,
-  [SMALL_STATE(8)] = 247,
-  [SMALL_STATE(9)] = 290,
-  [SMALL_STATE(10)] = 333,
-  [SMALL_STATE(11)] = 376,
-  [SMALL_STATE(12)] = 419,
-  [SMALL_STATE(13)] = 459,
-  [SMALL_STATE(14)] = 499,
-  [SMALL_STATE(15)] = 539,
-  [SMALL_STATE(16)] = 579,
-  [SMALL_STATE(17)] = 613,
-  [SMALL_STATE(18)] = 647,
-  [SMALL_STATE(19)] = 665,
-  [SMALL_STATE(20)] = 699,
-  [SMALL_STATE(21)] = 719,
-  [SMALL_STATE(22)] = 753,
-  [SMALL_STATE(23)] = 781,
-  [SMALL_STATE(24)] = 809,
-  [SMALL_STATE(25)] = 827,
-  [SMALL_STATE(26)] = 847,
-  [SMALL_STATE(27)] = 876,
-  [SMALL_STATE(28)] = 905,
-  [SMALL_STATE(29)] = 934,
-  [SMALL_STATE(30)] = 951,
-  [SMALL_STATE(31)] = 980,
-  [SMALL_STATE(32)] = 1009,
-  [SMALL_STATE(33)] = 1026,
-  [SMALL_STATE(34)] = 1055,
-  [SMALL_STATE(35)] = 1075,
-  [SMALL_STATE(36)] = 1091,
-  [SMALL_STATE(37)] = 1109,
-  [SMALL_STATE(38)] = 1125,
-  [SMALL_STATE(39)] = 1153,
-  [SMALL_STATE(40)] = 1179,
-  [SMALL_STATE(41)] = 1199,
-  [SMALL_STATE(42)] = 1219,
-  [SMALL_STATE(43)] = 1249,
-  [SMALL_STATE(44)] = 1264,
-  [SMALL_STATE(45)] = 1281,
-  [SMALL_STATE(46)] = 1303,
-  [SMALL_STATE(47)] = 1329,
-  [SMALL_STATE(48)] = 1351,
-  [SMALL_STATE(49)] = 1377,
-  [SMALL_STATE(50)] = 1403,
-  [SMALL_STATE(51)] = 1423,
-  [SMALL_STATE(52)] = 1445,
-  [SMALL_STATE(53)] = 1467,
-  [SMALL_STATE(54)] = 1493,
-  [SMALL_STATE(55)] = 1519,
-  [SMALL_STATE(56)] = 1541,
-  [SMALL_STATE(57)] = 1567,
-  [SMALL_STATE(58)] = 1593,
-  [SMALL_STATE(59)] = 1619,
-  [SMALL_STATE(60)] = 1645,
-  [SMALL_STATE(61)] = 1658,
-  [SMALL_STATE(62)] = 1671,
-  [SMALL_STATE(63)] = 1694,
-  [SMALL_STATE(64)] = 1707,
-  [SMALL_STATE(65)] = 1730,
-  [SMALL_STATE(66)] = 1753,
-  [SMALL_STATE(67)] = 1766,
-  [SMALL_STATE(68)] = 1787,
-  [SMALL_STATE(69)] = 1810,
-  [SMALL_STATE(70)] = 1823,
-  [SMALL_STATE(71)] = 1836,
-  [SMALL_STATE(72)] = 1852,
-  [SMALL_STATE(73)] = 1864,
-  [SMALL_STATE(74)] = 1876,
-  [SMALL_STATE(75)] = 1896,
-  [SMALL_STATE(76)] = 1908,
-  [SMALL_STATE(77)] = 1924,
-  [SMALL_STATE(78)] = 1936,
-  [SMALL_STATE(79)] = 1956,
-  [SMALL_STATE(80)] = 1976,
-  [SMALL_STATE(81)] = 1988,
-  [SMALL_STATE(82)] = 2000,
-  [SMALL_STATE(83)] = 2020,
-  [SMALL_STATE(84)] = 2040,
-  [SMALL_STATE(85)] = 2060,
-  [SMALL_STATE(86)] = 2072,
-  [SMALL_STATE(87)] = 2088,
-  [SMALL_STATE(88)] = 2100,
-  [SMALL_STATE(89)] = 2120,
-  [SMALL_STATE(90)] = 2132,
-  [SMALL_STATE(91)] = 2144,
-  [SMALL_STATE(92)] = 2156,
-  [SMALL_STATE(93)] = 2168,
-  [SMALL_STATE(94)] = 2187,
-  [SMALL_STATE(95)] = 2198,
-  [SMALL_STATE(96)] = 2217,
-  [SMALL_STATE(97)] = 2232,
-  [SMALL_STATE(98)] = 2251,
-  [SMALL_STATE(99)] = 2268,
-  [SMALL_STATE(100)] = 2279,
-  [SMALL_STATE(101)] = 2298,
-  [SMALL_STATE(102)] = 2317,
-  [SMALL_STATE(103)] = 2328,
-  [SMALL_STATE(104)] = 2347,
-  [SMALL_STATE(105)] = 2358,
-  [SMALL_STATE(106)] = 2369,
-  [SMALL_STATE(107)] = 2388,
-  [SMALL_STATE(108)] = 2407,
-  [SMALL_STATE(109)] = 2426,
-  [SMALL_STATE(110)] = 2437,
-  [SMALL_STATE(111)] = 2456,
-  [SMALL_STATE(112)] = 2475,
-  [SMALL_STATE(113)] = 2490,
-  [SMALL_STATE(114)] = 2509,
-  [SMALL_STATE(115)] = 2528,
-  [SMALL_STATE(116)] = 2547,
-  [SMALL_STATE(117)] = 2561,
-  [SMALL_STATE(118)] = 2571,
-  [SMALL_STATE(119)] = 2585,
-  [SMALL_STATE(120)] = 2599,
-  [SMALL_STATE(121)] = 2613,
-  [SMALL_STATE(122)] = 2623,
-  [SMALL_STATE(123)] = 2633,
-  [SMALL_STATE(124)] = 2649,
-  [SMALL_STATE(125)] = 2665,
-  [SMALL_STATE(126)] = 2679,
-  [SMALL_STATE(127)] = 2689,
-  [SMALL_STATE(128)] = 2705,
-  [SMALL_STATE(129)] = 2721,
-  [SMALL_STATE(130)] = 2737,
-  [SMALL_STATE(131)] = 2751,
-  [SMALL_STATE(132)] = 2765,
-  [SMALL_STATE(133)] = 2781,
-  [SMALL_STATE(134)] = 2795,
-  [SMALL_STATE(135)] = 2805,
-  [SMALL_STATE(136)] = 2821,
-  [SMALL_STATE(137)] = 2835,
-  [SMALL_STATE(138)] = 2845,
-  [SMALL_STATE(139)] = 2858,
-  [SMALL_STATE(140)] = 2871,
-  [SMALL_STATE(141)] = 2880,
-  [SMALL_STATE(142)] = 2893,
-  [SMALL_STATE(143)] = 2902,
-  [SMALL_STATE(144)] = 2915,
-  [SMALL_STATE(145)] = 2924,
-  [SMALL_STATE(146)] = 2937,
-  [SMALL_STATE(147)] = 2950,
-  [SMALL_STATE(148)] = 2963,
-  [SMALL_STATE(149)] = 2976,
-  [SMALL_STATE(150)] = 2989,
-  [SMALL_STATE(151)] = 2998,
-  [SMALL_STATE(152)] = 3011,
-  [SMALL_STATE(153)] = 3024,
-  [SMALL_STATE(154)] = 3033,
-  [SMALL_STATE(155)] = 3046,
-  [SMALL_STATE(156)] = 3059,
-  [SMALL_STATE(157)] = 3068,
-  [SMALL_STATE(158)] = 3079,
-  [SMALL_STATE(159)] = 3092,
-  [SMALL_STATE(160)] = 3101,
-  [SMALL_STATE(161)] = 3109,
-  [SMALL_STATE(162)] = 3119,
-  [SMALL_STATE(163)] = 3129,
-  [SMALL_STATE(164)] = 3139,
-  [SMALL_STATE(165)] = 3147,
-  [SMALL_STATE(166)] = 3155,
-  [SMALL_STATE(167)] = 3165,
-  [SMALL_STATE(168)] = 3173,
-  [SMALL_STATE(169)] = 3183,
-  [SMALL_STATE(170)] = 3191,
-  [SMALL_STATE(171)] = 3199,
-  [SMALL_STATE(172)] = 3209,
-  [SMALL_STATE(173)] = 3217,
-  [SMALL_STATE(174)] = 3225,
-  [SMALL_STATE(175)] = 3233,
-  [SMALL_STATE(176)] = 3241,
-  [SMALL_STATE(177)] = 3249,
-  [SMALL_STATE(178)] = 3259,
-  [SMALL_STATE(179)] = 3267,
-  [SMALL_STATE(180)] = 3277,
-  [SMALL_STATE(181)] = 3285,
-  [SMALL_STATE(182)] = 3293,
-  [SMALL_STATE(183)] = 3303,
-  [SMALL_STATE(184)] = 3311,
-  [SMALL_STATE(185)] = 3318,
-  [SMALL_STATE(186)] = 3325,
-  [SMALL_STATE(187)] = 3332,
-  [SMALL_STATE(188)] = 3339,
-  [SMALL_STATE(189)] = 3346,
-  [SMALL_STATE(190)] = 3353,
-  [SMALL_STATE(191)] = 3360,
-  [SMALL_STATE(192)] = 3367,
-  [SMALL_STATE(193)] = 3374,
-  [SMALL_STATE(194)] = 3381,
-  [SMALL_STATE(195)] = 3388,
-  [SMALL_STATE(196)] = 3395,
-  [SMALL_STATE(197)] = 3402,
-  [SMALL_STATE(198)] = 3409,
-  [SMALL_STATE(199)] = 3416,
-  [SMALL_STATE(200)] = 3423,
+  [SMALL_STATE(3)] = 25,
+  [SMALL_STATE(4)] = 42,
+  [SMALL_STATE(5)] = 49,
+  [SMALL_STATE(6)] = 62,
+  [SMALL_STATE(7)] = 72,
+  [SMALL_STATE(8)] = 79,
+  [SMALL_STATE(9)] = 86,
+  [SMALL_STATE(10)] = 96,
+  [SMALL_STATE(11)] = 111,
+  [SMALL_STATE(12)] = 172,
+  [SMALL_STATE(13)] = 179,
+  [SMALL_STATE(14)] = 186,
+  [SMALL_STATE(15)] = 193,
+  [SMALL_STATE(16)] = 203,
+  [SMALL_STATE(17)] = 213,
+  [SMALL_STATE(18)] = 223,
+  [SMALL_STATE(19)] = 233,
+  [SMALL_STATE(20)] = 288,
+  [SMALL_STATE(21)] = 319,
+  [SMALL_STATE(22)] = 356,
+  [SMALL_STATE(23)] = 363,
+  [SMALL_STATE(24)] = 430,
+  [SMALL_STATE(25)] = 437,
+  [SMALL_STATE(26)] = 447,
+  [SMALL_STATE(27)] = 463,
+  [SMALL_STATE(28)] = 479,
+  [SMALL_STATE(29)] = 495,
+  [SMALL_STATE(30)] = 502,
+  [SMALL_STATE(31)] = 521,
+  [SMALL_STATE(32)] = 528,
+  [SMALL_STATE(33)] = 544,
+  [SMALL_STATE(34)] = 557,
+  [SMALL_STATE(35)] = 567,
+  [SMALL_STATE(36)] = 587,
+  [SMALL_STATE(37)] = 602,
+  [SMALL_STATE(38)] = 624,
+  [SMALL_STATE(39)] = 679,
+  [SMALL_STATE(40)] = 710,
+  [SMALL_STATE(41)] = 745,
+  [SMALL_STATE(42)] = 776,
+  [SMALL_STATE(43)] = 807,
+  [SMALL_STATE(44)] = 833,
+  [SMALL_STATE(45)] = 840,
+  [SMALL_STATE(46)] = 851,
+  [SMALL_STATE(47)] = 858,
+  [SMALL_STATE(48)] = 895,
+  [SMALL_STATE(49)] = 911,
+  [SMALL_STATE(50)] = 919,
+  [SMALL_STATE(51)] = 927,
+  [SMALL_STATE(52)] = 935,
+  [SMALL_STATE(53)] = 943,
+  [SMALL_STATE(54)] = 956,
+  [SMALL_STATE(55)] = 979,
+  [SMALL_STATE(56)] = 998,
+  [SMALL_STATE(57)] = 1005,
+  [SMALL_STATE(58)] = 1012,
+  [SMALL_STATE(59)] = 1031,
+  [SMALL_STATE(60)] = 1049,
+  [SMALL_STATE(61)] = 1067,
+  [SMALL_STATE(62)] = 1084,
+  [SMALL_STATE(63)] = 1106,
+  [SMALL_STATE(64)] = 1122,
+  [SMALL_STATE(65)] = 1132,
+  [SMALL_STATE(66)] = 1154,
+  [SMALL_STATE(67)] = 1176,
+  [SMALL_STATE(68)] = 1201,
+  [SMALL_STATE(69)] = 1221,
+  [SMALL_STATE(70)] = 1228,
+  [SMALL_STATE(71)] = 1250,
+  [SMALL_STATE(72)] = 1272,
+  [SMALL_STATE(73)] = 1291,
+  [SMALL_STATE(74)] = 1310,
+  [SMALL_STATE(75)] = 1323,
+  [SMALL_STATE(76)] = 1339,
+  [SMALL_STATE(77)] = 1349,
+  [SMALL_STATE(78)] = 1365,
+  [SMALL_STATE(79)] = 1372,
+  [SMALL_STATE(80)] = 1382,
+  [SMALL_STATE(81)] = 1392,
+  [SMALL_STATE(82)] = 1420,
+  [SMALL_STATE(83)] = 1427,
+  [SMALL_STATE(84)] = 1437,
+  [SMALL_STATE(85)] = 1449,
+  [SMALL_STATE(86)] = 1456,
+  [SMALL_STATE(87)] = 1475,
+  [SMALL_STATE(88)] = 1494,
+  [SMALL_STATE(89)] = 1504,
+  [SMALL_STATE(90)] = 1523,
+  [SMALL_STATE(91)] = 1534,
+  [SMALL_STATE(92)] = 1545,
+  [SMALL_STATE(93)] = 1561,
+  [SMALL_STATE(94)] = 1568,
+  [SMALL_STATE(95)] = 1599,
+  [SMALL_STATE(96)] = 1618,
+  [SMALL_STATE(97)] = 1679,
+  [SMALL_STATE(98)] = 1697,
+  [SMALL_STATE(99)] = 1706,
+  [SMALL_STATE(100)] = 1714,
+  [SMALL_STATE(101)] = 1724,
+  [SMALL_STATE(102)] = 1732,
+  [SMALL_STATE(103)] = 1743,
+  [SMALL_STATE(104)] = 1759,
+  [SMALL_STATE(105)] = 1770,
+  [SMALL_STATE(106)] = 1807,
+  [SMALL_STATE(107)] = 1823,
+  [SMALL_STATE(108)] = 1830,
+  [SMALL_STATE(109)] = 1843,
+  [SMALL_STATE(110)] = 1856,
+  [SMALL_STATE(111)] = 1877,
+  [SMALL_STATE(112)] = 1895,
+  [SMALL_STATE(113)] = 1914,
+  [SMALL_STATE(114)] = 1933,
+  [SMALL_STATE(115)] = 1943,
+  [SMALL_STATE(116)] = 1953,
+  [SMALL_STATE(117)] = 1963,
+  [SMALL_STATE(118)] = 1979,
+  [SMALL_STATE(119)] = 2034,
+  [SMALL_STATE(120)] = 2056,
+  [SMALL_STATE(121)] = 2117,
+  [SMALL_STATE(122)] = 2151,
+  [SMALL_STATE(123)] = 2158,
+  [SMALL_STATE(124)] = 2166,
+  [SMALL_STATE(125)] = 2179,
+  [SMALL_STATE(126)] = 2189,
+  [SMALL_STATE(127)] = 2205,
+  [SMALL_STATE(128)] = 2215,
+  [SMALL_STATE(129)] = 2227,
+  [SMALL_STATE(130)] = 2240,
+  [SMALL_STATE(131)] = 2247,
+  [SMALL_STATE(132)] = 2266,
+  [SMALL_STATE(133)] = 2279,
+  [SMALL_STATE(134)] = 2286,
+  [SMALL_STATE(135)] = 2314,
+  [SMALL_STATE(136)] = 2333,
+  [SMALL_STATE(137)] = 2340,
+  [SMALL_STATE(138)] = 2353,
+  [SMALL_STATE(139)] = 2360,
+  [SMALL_STATE(140)] = 2367,
+  [SMALL_STATE(141)] = 2386,
+  [SMALL_STATE(142)] = 2405,
+  [SMALL_STATE(143)] = 2412,
+  [SMALL_STATE(144)] = 2431,
+  [SMALL_STATE(145)] = 2450,
+  [SMALL_STATE(146)] = 2463,
+  [SMALL_STATE(147)] = 2482,
+  [SMALL_STATE(148)] = 2498,
+  [SMALL_STATE(149)] = 2511,
+  [SMALL_STATE(150)] = 2530,
+  [SMALL_STATE(151)] = 2552,
+  [SMALL_STATE(152)] = 2576,
+  [SMALL_STATE(153)] = 2637,
+  [SMALL_STATE(154)] = 2646,
+  [SMALL_STATE(155)] = 2655,
+  [SMALL_STATE(156)] = 2666,
+  [SMALL_STATE(157)] = 2703,
+  [SMALL_STATE(158)] = 2719,
+  [SMALL_STATE(159)] = 2730,
+  [SMALL_STATE(160)] = 2738,
+  [SMALL_STATE(161)] = 2749,
+  [SMALL_STATE(162)] = 2786,
+  [SMALL_STATE(163)] = 2802,
+  [SMALL_STATE(164)] = 2810,
+  [SMALL_STATE(165)] = 2823,
+  [SMALL_STATE(166)] = 2846,
+  [SMALL_STATE(167)] = 2866,
+  [SMALL_STATE(168)] = 2886,
+  [SMALL_STATE(169)] = 2904,
+  [SMALL_STATE(170)] = 2923,
+  [SMALL_STATE(171)] = 2942,
+  [SMALL_STATE(172)] = 2960,
+  [SMALL_STATE(173)] = 2979,
+  [SMALL_STATE(174)] = 2998,
+  [SMALL_STATE(175)] = 3014,
+  [SMALL_STATE(176)] = 3036,
+  [SMALL_STATE(177)] = 3058,
+  [SMALL_STATE(178)] = 3068,
+  [SMALL_STATE(179)] = 3085,
+  [SMALL_STATE(180)] = 3102,
+  [SMALL_STATE(181)] = 3122,
+  [SMALL_STATE(182)] = 3142,
+  [SMALL_STATE(183)] = 3165,
+  [SMALL_STATE(184)] = 3185,
+  [SMALL_STATE(185)] = 3205,
+  [SMALL_STATE(186)] = 3222,
+  [SMALL_STATE(187)] = 3239,
+  [SMALL_STATE(188)] = 3261,
+  [SMALL_STATE(189)] = 3274,
+  [SMALL_STATE(190)] = 3292,
+  [SMALL_STATE(191)] = 3302,
+  [SMALL_STATE(192)] = 3312,
+  [SMALL_STATE(193)] = 3319,
+  [SMALL_STATE(194)] = 3326,
+  [SMALL_STATE(195)] = 3336,
+  [SMALL_STATE(196)] = 3346,
+  [SMALL_STATE(197)] = 3354,
+  [SMALL_STATE(198)] = 3362,
+  [SMALL_STATE(199)] = 3375,
+  [SMALL_STATE(200)] = 3385,
+  [SMALL_STATE(201)] = 3392,
+  [SMALL_STATE(202)] = 3438,
+  [SMALL_STATE(203)] = 3484,
+  [SMALL_STATE(204)] = 3497,
+  [SMALL_STATE(205)] = 3521,
+  [SMALL_STATE(206)] = 3540,
+  [SMALL_STATE(207)] = 3568,
+  [SMALL_STATE(208)] = 3581,
+  [SMALL_STATE(209)] = 3594,
+  [SMALL_STATE(210)] = 3613,
+  [SMALL_STATE(211)] = 3659,
+  [SMALL_STATE(212)] = 3666,
+  [SMALL_STATE(213)] = 3675,
+  [SMALL_STATE(214)] = 3694,
+  [SMALL_STATE(215)] = 3701,
+  [SMALL_STATE(216)] = 3720,
+  [SMALL_STATE(217)] = 3739,
+  [SMALL_STATE(218)] = 3746,
+  [SMALL_STATE(219)] = 3765,
+  [SMALL_STATE(220)] = 3774,
+  [SMALL_STATE(221)] = 3781,
+  [SMALL_STATE(222)] = 3790,
+  [SMALL_STATE(223)] = 3809,
+  [SMALL_STATE(224)] = 3816,
+  [SMALL_STATE(225)] = 3835,
+  [SMALL_STATE(226)] = 3854,
+  [SMALL_STATE(227)] = 3861,
+  [SMALL_STATE(228)] = 3874,
+  [SMALL_STATE(229)] = 3896,
+  [SMALL_STATE(230)] = 3909,
+  [SMALL_STATE(231)] = 3928,
+  [SMALL_STATE(232)] = 3937,
+  [SMALL_STATE(233)] = 3959,
+  [SMALL_STATE(234)] = 4005,
+  [SMALL_STATE(235)] = 4014,
+  [SMALL_STATE(236)] = 4029,
+  [SMALL_STATE(237)] = 4053,
+  [SMALL_STATE(238)] = 4064,
+  [SMALL_STATE(239)] = 4075,
+  [SMALL_STATE(240)] = 4112,
+  [SMALL_STATE(241)] = 4123,
+  [SMALL_STATE(242)] = 4157,
+  [SMALL_STATE(243)] = 4176,
+  [SMALL_STATE(244)] = 4194,
+  [SMALL_STATE(245)] = 4213,
+  [SMALL_STATE(246)] = 4232,
+  [SMALL_STATE(247)] = 4250,
+  [SMALL_STATE(248)] = 4269,
+  [SMALL_STATE(249)] = 4278,
+  [SMALL_STATE(250)] = 4296,
+  [SMALL_STATE(251)] = 4305,
+  [SMALL_STATE(252)] = 4324,
+  [SMALL_STATE(253)] = 4342,
+  [SMALL_STATE(254)] = 4361,
+  [SMALL_STATE(255)] = 4380,
+  [SMALL_STATE(256)] = 4399,
+  [SMALL_STATE(257)] = 4411,
+  [SMALL_STATE(258)] = 4427,
+  [SMALL_STATE(259)] = 4437,
+  [SMALL_STATE(260)] = 4453,
+  [SMALL_STATE(261)] = 4463,
+  [SMALL_STATE(262)] = 4479,
+  [SMALL_STATE(263)] = 4540,
+  [SMALL_STATE(264)] = 4558,
+  [SMALL_STATE(265)] = 4571,
+  [SMALL_STATE(266)] = 4589,
+  [SMALL_STATE(267)] = 4614,
+  [SMALL_STATE(268)] = 4627,
+  [SMALL_STATE(269)] = 4637,
+  [SMALL_STATE(270)] = 4692,
+  [SMALL_STATE(271)] = 4702,
+  [SMALL_STATE(272)] = 4712,
+  [SMALL_STATE(273)] = 4721,
+  [SMALL_STATE(274)] = 4731,
+  [SMALL_STATE(275)] = 4741,
+  [SMALL_STATE(276)] = 4751,
+  [SMALL_STATE(277)] = 4761,
+  [SMALL_STATE(278)] = 4771,
+  [SMALL_STATE(279)] = 4780,
+  [SMALL_STATE(280)] = 4808,
+  [SMALL_STATE(281)] = 4821,
+  [SMALL_STATE(282)] = 4834,
+  [SMALL_STATE(283)] = 4853,
+  [SMALL_STATE(284)] = 4866,
+  [SMALL_STATE(285)] = 4894,
+  [SMALL_STATE(286)] = 4903,
+  [SMALL_STATE(287)] = 4916,
+  [SMALL_STATE(288)] = 4944,
+  [SMALL_STATE(289)] = 4957,
+  [SMALL_STATE(290)] = 4970,
+  [SMALL_STATE(291)] = 4984,
+  [SMALL_STATE(292)] = 4993,
+  [SMALL_STATE(293)] = 5000,
+  [SMALL_STATE(294)] = 5019,
+  [SMALL_STATE(295)] = 5026,
+  [SMALL_STATE(296)] = 5045,
+  [SMALL_STATE(297)] = 5052,
+  [SMALL_STATE(298)] = 5071,
+  [SMALL_STATE(299)] = 5078,
+  [SMALL_STATE(300)] = 5087,
+  [SMALL_STATE(301)] = 5094,
+  [SMALL_STATE(302)] = 5113,
+  [SMALL_STATE(303)] = 5120,
+  [SMALL_STATE(304)] = 5136,
+  [SMALL_STATE(305)] = 5152,
+  [SMALL_STATE(306)] = 5165,
+  [SMALL_STATE(307)] = 5184,
+  [SMALL_STATE(308)] = 5206,
+  [SMALL_STATE(309)] = 5219,
+  [SMALL_STATE(310)] = 5228,
+  [SMALL_STATE(311)] = 5241,
+  [SMALL_STATE(312)] = 5260,
+  [SMALL_STATE(313)] = 5282,
+  [SMALL_STATE(314)] = 5289,
+  [SMALL_STATE(315)] = 5300,
+  [SMALL_STATE(316)] = 5311,
+  [SMALL_STATE(317)] = 5332,
+  [SMALL_STATE(318)] = 5343,
+  [SMALL_STATE(319)] = 5354,
+  [SMALL_STATE(320)] = 5365,
+  [SMALL_STATE(321)] = 5376,
+  [SMALL_STATE(322)] = 5387,
+  [SMALL_STATE(323)] = 5396,
+  [SMALL_STATE(324)] = 5442,
+  [SMALL_STATE(325)] = 5451,
+  [SMALL_STATE(326)] = 5466,
+  [SMALL_STATE(327)] = 5477,
+  [SMALL_STATE(328)] = 5495,
+  [SMALL_STATE(329)] = 5514,
+  [SMALL_STATE(330)] = 5532,
+  [SMALL_STATE(331)] = 5551,
+  [SMALL_STATE(332)] = 5569,
+  [SMALL_STATE(333)] = 5588,
+  [SMALL_STATE(334)] = 5606,
+  [SMALL_STATE(335)] = 5615,
+  [SMALL_STATE(336)] = 5633,
+  [SMALL_STATE(337)] = 5652,
+  [SMALL_STATE(338)] = 5670,
+  [SMALL_STATE(339)] = 5686,
+  [SMALL_STATE(340)] = 5702,
+  [SMALL_STATE(341)] = 5718,
+  [SMALL_STATE(342)] = 5730,
+  [SMALL_STATE(343)] = 5746,
+  [SMALL_STATE(344)] = 5765,
+  [SMALL_STATE(345)] = 5784,
+  [SMALL_STATE(346)] = 5796,
+  [SMALL_STATE(347)] = 5806,
+  [SMALL_STATE(348)] = 5816,
+  [SMALL_STATE(349)] = 5824,
+  [SMALL_STATE(350)] = 5842,
+  [SMALL_STATE(351)] = 5855,
+  [SMALL_STATE(352)] = 5863,
+  [SMALL_STATE(353)] = 5871,
+  [SMALL_STATE(354)] = 5879,
+  [SMALL_STATE(355)] = 5887,
+  [SMALL_STATE(356)] = 5900,
+  [SMALL_STATE(357)] = 5912,
+  [SMALL_STATE(358)] = 5967,
+  [SMALL_STATE(359)] = 5986,
+  [SMALL_STATE(360)] = 5999,
+  [SMALL_STATE(361)] = 6027,
+  [SMALL_STATE(362)] = 6040,
+  [SMALL_STATE(363)] = 6068,
+  [SMALL_STATE(364)] = 6081,
+  [SMALL_STATE(365)] = 6094,
+  [SMALL_STATE(366)] = 6107,
+  [SMALL_STATE(367)] = 6116,
+  [SMALL_STATE(368)] = 6129,
+  [SMALL_STATE(369)] = 6157,
+  [SMALL_STATE(370)] = 6170,
+  [SMALL_STATE(371)] = 6195,
+  [SMALL_STATE(372)] = 6202,
+  [SMALL_STATE(373)] = 6209,
+  [SMALL_STATE(374)] = 6228,
+  [SMALL_STATE(375)] = 6235,
+  [SMALL_STATE(376)] = 6242,
+  [SMALL_STATE(377)] = 6258,
+  [SMALL_STATE(378)] = 6271,
+  [SMALL_STATE(379)] = 6284,
+  [SMALL_STATE(380)] = 6303,
+  [SMALL_STATE(381)] = 6316,
+  [SMALL_STATE(382)] = 6332,
+  [SMALL_STATE(383)] = 6339,
+  [SMALL_STATE(384)] = 6348,
+  [SMALL_STATE(385)] = 6363,
+  [SMALL_STATE(386)] = 6384,
+  [SMALL_STATE(387)] = 6393,
+  [SMALL_STATE(388)] = 6411,
+  [SMALL_STATE(389)] = 6429,
+  [SMALL_STATE(390)] = 6448,
+  [SMALL_STATE(391)] = 6466,
+  [SMALL_STATE(392)] = 6484,
+  [SMALL_STATE(393)] = 6500,
+  [SMALL_STATE(394)] = 6509,
+  [SMALL_STATE(395)] = 6521,
+  [SMALL_STATE(396)] = 6537,
+  [SMALL_STATE(397)] = 6546,
+  [SMALL_STATE(398)] = 6558,
+  [SMALL_STATE(399)] = 6574,
+  [SMALL_STATE(400)] = 6586,
+  [SMALL_STATE(401)] = 6602,
+  [SMALL_STATE(402)] = 6621,
+  [SMALL_STATE(403)] = 6637,
+  [SMALL_STATE(404)] = 6649,
+  [SMALL_STATE(405)] = 6665,
+  [SMALL_STATE(406)] = 6684,
+  [SMALL_STATE(407)] = 6696,
+  [SMALL_STATE(408)] = 6715,
+  [SMALL_STATE(409)] = 6770,
+  [SMALL_STATE(410)] = 6782,
+  [SMALL_STATE(411)] = 6795,
+  [SMALL_STATE(412)] = 6814,
+  [SMALL_STATE(413)] = 6827,
+  [SMALL_STATE(414)] = 6840,
+  [SMALL_STATE(415)] = 6868,
+  [SMALL_STATE(416)] = 6881,
+  [SMALL_STATE(417)] = 6894,
+  [SMALL_STATE(418)] = 6919,
+  [SMALL_STATE(419)] = 6926,
+  [SMALL_STATE(420)] = 6939,
+  [SMALL_STATE(421)] = 6950,
+  [SMALL_STATE(422)] = 6959,
+  [SMALL_STATE(423)] = 6968,
+  [SMALL_STATE(424)] = 6983,
+  [SMALL_STATE(425)] = 7001,
+  [SMALL_STATE(426)] = 7010,
+  [SMALL_STATE(427)] = 7022,
+  [SMALL_STATE(428)] = 7034,
+  [SMALL_STATE(429)] = 7050,
+  [SMALL_STATE(430)] = 7062,
+  [SMALL_STATE(431)] = 7075,
+  [SMALL_STATE(432)] = 7088,
+  [SMALL_STATE(433)] = 7100,
+  [SMALL_STATE(434)] = 7116,
+  [SMALL_STATE(435)] = 7128,
+  [SMALL_STATE(436)] = 7144,
+  [SMALL_STATE(437)] = 7156,
+  [SMALL_STATE(438)] = 7172,
+  [SMALL_STATE(439)] = 7227,
+  [SMALL_STATE(440)] = 7239,
+  [SMALL_STATE(441)] = 7252,
+  [SMALL_STATE(442)] = 7271,
+  [SMALL_STATE(443)] = 7283,
+  [SMALL_STATE(444)] = 7338,
+  [SMALL_STATE(445)] = 7347,
+  [SMALL_STATE(446)] = 7359,
+  [SMALL_STATE(447)] = 7414,
+  [SMALL_STATE(448)] = 7426,
+  [SMALL_STATE(449)] = 7439,
+  [SMALL_STATE(450)] = 7453,
+  [SMALL_STATE(451)] = 7466,
+  [SMALL_STATE(452)] = 7475,
+  [SMALL_STATE(453)] = 7487,
+  [SMALL_STATE(454)] = 7500,
+  [SMALL_STATE(455)] = 7512,
+  [SMALL_STATE(456)] = 7524,
+  [SMALL_STATE(457)] = 7540,
+  [SMALL_STATE(458)] = 7552,
+  [SMALL_STATE(459)] = 7564,
+  [SMALL_STATE(460)] = 7619,
+  [SMALL_STATE(461)] = 7631,
+  [SMALL_STATE(462)] = 7686,
+  [SMALL_STATE(463)] = 7698,
+  [SMALL_STATE(464)] = 7711,
+  [SMALL_STATE(465)] = 7723,
+  [SMALL_STATE(466)] = 7732,
+  [SMALL_STATE(467)] = 7744,
+  [SMALL_STATE(468)] = 7799,
+  [SMALL_STATE(469)] = 7811,
+  [SMALL_STATE(470)] = 7863,
+  [SMALL_STATE(471)] = 7875,
+  [SMALL_STATE(472)] = 7887,
+  [SMALL_STATE(473)] = 7899,
+  [SMALL_STATE(474)] = 7954,
+  [SMALL_STATE(475)] = 7966,
+  [SMALL_STATE(476)] = 7978,
+  [SMALL_STATE(477)] = 8030,
 };
 
 static const TSParseActionEntry ts_parse_actions[] = {
@@ -4414,247 +9952,442 @@ static const TSParseActionEntry ts_parse_actions[] = {
   [1] = {.entry = {.count = 1, .reusable = false}}, RECOVER(),
   [3] = {.entry = {.count = 1, .reusable = true}}, SHIFT_EXTRA(),
   [5] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_source_file, 0, 0, 0),
-  [7] = {.entry = {.count = 1, .reusable = false}}, SHIFT(97),
-  [9] = {.entry = {.count = 1, .reusable = true}}, SHIFT(2),
-  [11] = {.entry = {.count = 1, .reusable = true}}, SHIFT(188),
-  [13] = {.entry = {.count = 1, .reusable = false}}, SHIFT(187),
-  [15] = {.entry = {.count = 1, .reusable = false}}, SHIFT(171),
-  [17] = {.entry = {.count = 1, .reusable = true}}, SHIFT(184),
-  [19] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_source_file, 1, 0, 0),
-  [21] = {.entry = {.count = 1, .reusable = true}}, SHIFT(5),
-  [23] = {.entry = {.count = 1, .reusable = false}}, SHIFT(122),
-  [25] = {.entry = {.count = 1, .reusable = true}}, SHIFT(53),
-  [27] = {.entry = {.count = 1, .reusable = true}}, SHIFT(8),
-  [29] = {.entry = {.count = 1, .reusable = true}}, SHIFT(85),
-  [31] = {.entry = {.count = 1, .reusable = true}}, SHIFT(131),
-  [33] = {.entry = {.count = 1, .reusable = true}}, SHIFT(107),
-  [35] = {.entry = {.count = 1, .reusable = false}}, SHIFT(75),
-  [37] = {.entry = {.count = 1, .reusable = false}}, SHIFT(107),
-  [39] = {.entry = {.count = 1, .reusable = true}}, SHIFT(72),
-  [41] = {.entry = {.count = 1, .reusable = true}}, SHIFT(159),
-  [43] = {.entry = {.count = 1, .reusable = false}}, SHIFT(159),
-  [45] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym__nls, 2, 0, 0),
-  [47] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym__nls, 2, 0, 0),
-  [49] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym__nls, 2, 0, 0), SHIFT_REPEAT(5),
-  [52] = {.entry = {.count = 1, .reusable = true}}, SHIFT(92),
-  [54] = {.entry = {.count = 1, .reusable = true}}, SHIFT(6),
-  [56] = {.entry = {.count = 1, .reusable = true}}, SHIFT(91),
-  [58] = {.entry = {.count = 1, .reusable = true}}, SHIFT(156),
-  [60] = {.entry = {.count = 1, .reusable = false}}, SHIFT(156),
-  [62] = {.entry = {.count = 1, .reusable = true}}, SHIFT(3),
-  [64] = {.entry = {.count = 1, .reusable = true}}, SHIFT(80),
-  [66] = {.entry = {.count = 1, .reusable = true}}, SHIFT(93),
-  [68] = {.entry = {.count = 1, .reusable = false}}, SHIFT(93),
-  [70] = {.entry = {.count = 1, .reusable = true}}, SHIFT(4),
-  [72] = {.entry = {.count = 1, .reusable = true}}, SHIFT(11),
-  [74] = {.entry = {.count = 1, .reusable = true}}, SHIFT(89),
-  [76] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_source_file, 2, 0, 0),
-  [78] = {.entry = {.count = 1, .reusable = true}}, SHIFT(14),
-  [80] = {.entry = {.count = 1, .reusable = true}}, SHIFT(150),
-  [82] = {.entry = {.count = 1, .reusable = false}}, SHIFT(150),
-  [84] = {.entry = {.count = 1, .reusable = true}}, SHIFT(25),
-  [86] = {.entry = {.count = 1, .reusable = true}}, SHIFT(44),
-  [88] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_string_literal, 2, 0, 0),
-  [90] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__qualified_name_rest, 1, 0, 0),
-  [92] = {.entry = {.count = 1, .reusable = true}}, SHIFT(157),
-  [94] = {.entry = {.count = 1, .reusable = true}}, SHIFT(88),
-  [96] = {.entry = {.count = 1, .reusable = true}}, SHIFT(75),
-  [98] = {.entry = {.count = 1, .reusable = true}}, SHIFT(84),
-  [100] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_string_literal, 3, 0, 0),
-  [102] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_identifier, 1, 0, 0),
-  [104] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0),
-  [106] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0),
-  [108] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(188),
-  [111] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(187),
-  [114] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(171),
-  [117] = {.entry = {.count = 1, .reusable = true}}, SHIFT(46),
-  [119] = {.entry = {.count = 1, .reusable = true}}, SHIFT(199),
-  [121] = {.entry = {.count = 1, .reusable = true}}, SHIFT(193),
-  [123] = {.entry = {.count = 1, .reusable = true}}, SHIFT(104),
-  [125] = {.entry = {.count = 1, .reusable = true}}, SHIFT(33),
-  [127] = {.entry = {.count = 1, .reusable = true}}, SHIFT(94),
-  [129] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_qualified_identifier, 3, 0, 23),
-  [131] = {.entry = {.count = 1, .reusable = true}}, SHIFT(99),
-  [133] = {.entry = {.count = 1, .reusable = true}}, SHIFT(105),
-  [135] = {.entry = {.count = 1, .reusable = true}}, SHIFT(102),
-  [137] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_union_type_repeat1, 2, 0, 0),
-  [139] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_union_type_repeat1, 2, 0, 0), SHIFT_REPEAT(38),
-  [142] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_array_type, 3, 0, 0),
-  [144] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__union_member, 1, 0, 0),
-  [146] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__base_type, 1, 0, 0),
-  [148] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_map_type, 4, 0, 27),
-  [150] = {.entry = {.count = 1, .reusable = true}}, SHIFT(43),
-  [152] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__type_expression, 1, 0, 0),
-  [154] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_union_type, 2, 0, 0),
-  [156] = {.entry = {.count = 1, .reusable = true}}, SHIFT(38),
-  [158] = {.entry = {.count = 1, .reusable = true}}, SHIFT(35),
-  [160] = {.entry = {.count = 1, .reusable = true}}, SHIFT(168),
-  [162] = {.entry = {.count = 1, .reusable = true}}, SHIFT(97),
-  [164] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 1, 0, 8),
-  [166] = {.entry = {.count = 1, .reusable = true}}, SHIFT(190),
-  [168] = {.entry = {.count = 1, .reusable = true}}, SHIFT(19),
-  [170] = {.entry = {.count = 1, .reusable = true}}, SHIFT(106),
-  [172] = {.entry = {.count = 1, .reusable = true}}, SHIFT(98),
-  [174] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_source_file, 3, 0, 0),
-  [176] = {.entry = {.count = 1, .reusable = true}}, SHIFT(197),
-  [178] = {.entry = {.count = 1, .reusable = true}}, SHIFT(56),
-  [180] = {.entry = {.count = 1, .reusable = true}}, SHIFT(63),
-  [182] = {.entry = {.count = 1, .reusable = true}}, SHIFT(61),
-  [184] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_source_file_repeat2, 2, 0, 0),
-  [186] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat2, 2, 0, 0), SHIFT_REPEAT(97),
-  [189] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat2, 2, 0, 0), SHIFT_REPEAT(184),
-  [192] = {.entry = {.count = 1, .reusable = true}}, SHIFT(49),
-  [194] = {.entry = {.count = 1, .reusable = true}}, SHIFT(60),
-  [196] = {.entry = {.count = 1, .reusable = true}}, SHIFT(59),
-  [198] = {.entry = {.count = 1, .reusable = true}}, SHIFT(69),
-  [200] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_alias, 3, 0, 7),
-  [202] = {.entry = {.count = 1, .reusable = true}}, SHIFT(70),
-  [204] = {.entry = {.count = 1, .reusable = true}}, SHIFT(57),
-  [206] = {.entry = {.count = 1, .reusable = true}}, SHIFT(66),
-  [208] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_literal, 2, 0, 0),
-  [210] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_literal, 3, 0, 0),
-  [212] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 3, 0, 7),
-  [214] = {.entry = {.count = 1, .reusable = true}}, SHIFT(23),
-  [216] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_literal, 4, 0, 0),
-  [218] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 4, 0, 28),
-  [220] = {.entry = {.count = 1, .reusable = true}}, SHIFT(22),
-  [222] = {.entry = {.count = 1, .reusable = true}}, SHIFT(68),
-  [224] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_literal, 6, 0, 0),
-  [226] = {.entry = {.count = 1, .reusable = true}}, SHIFT(173),
-  [228] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_literal, 5, 0, 0),
-  [230] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_literal, 7, 0, 0),
-  [232] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_definition, 3, 0, 9),
-  [234] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_array_literal, 7, 0, 0),
-  [236] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_block, 2, 0, 0),
-  [238] = {.entry = {.count = 1, .reusable = true}}, SHIFT(95),
-  [240] = {.entry = {.count = 1, .reusable = true}}, SHIFT(77),
-  [242] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_boolean_literal, 1, 0, 0),
-  [244] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_alias, 4, 0, 15),
-  [246] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_block, 3, 0, 0),
-  [248] = {.entry = {.count = 1, .reusable = true}}, SHIFT(103),
-  [250] = {.entry = {.count = 1, .reusable = true}}, SHIFT(81),
-  [252] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_array_literal, 2, 0, 0),
-  [254] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_block, 4, 0, 0),
-  [256] = {.entry = {.count = 1, .reusable = true}}, SHIFT(111),
-  [258] = {.entry = {.count = 1, .reusable = true}}, SHIFT(87),
-  [260] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_plugin_block_repeat1, 2, 0, 0), SHIFT_REPEAT(124),
-  [263] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_plugin_block_repeat1, 2, 0, 0), SHIFT_REPEAT(199),
-  [266] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_plugin_block_repeat1, 2, 0, 0),
-  [268] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 5, 0, 29),
-  [270] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_array_literal, 3, 0, 0),
-  [272] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_definition, 2, 0, 3),
-  [274] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_block, 5, 0, 0),
-  [276] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 6, 0, 34),
-  [278] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_array_literal, 4, 0, 0),
-  [280] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_block, 6, 0, 0),
-  [282] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_array_literal, 5, 0, 0),
-  [284] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_array_literal, 6, 0, 0),
-  [286] = {.entry = {.count = 1, .reusable = true}}, SHIFT(149),
-  [288] = {.entry = {.count = 1, .reusable = true}}, SHIFT(10),
-  [290] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_body, 2, 0, 0),
-  [292] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_source_file_repeat2, 1, 0, 0),
-  [294] = {.entry = {.count = 1, .reusable = true}}, SHIFT(112),
-  [296] = {.entry = {.count = 1, .reusable = true}}, SHIFT(194),
-  [298] = {.entry = {.count = 1, .reusable = true}}, SHIFT(17),
-  [300] = {.entry = {.count = 1, .reusable = true}}, SHIFT(28),
-  [302] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 2, 0, 16),
-  [304] = {.entry = {.count = 1, .reusable = true}}, SHIFT(21),
-  [306] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_body, 5, 0, 0),
-  [308] = {.entry = {.count = 1, .reusable = true}}, SHIFT(148),
-  [310] = {.entry = {.count = 1, .reusable = true}}, SHIFT(48),
-  [312] = {.entry = {.count = 1, .reusable = true}}, SHIFT(151),
-  [314] = {.entry = {.count = 1, .reusable = true}}, SHIFT(54),
-  [316] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_body, 3, 0, 0),
-  [318] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_body, 4, 0, 0),
-  [320] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_body, 6, 0, 0),
-  [322] = {.entry = {.count = 1, .reusable = true}}, SHIFT(110),
-  [324] = {.entry = {.count = 1, .reusable = true}}, SHIFT(73),
-  [326] = {.entry = {.count = 1, .reusable = true}}, SHIFT(154),
-  [328] = {.entry = {.count = 1, .reusable = true}}, SHIFT(7),
-  [330] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_entity_id, 2, 0, 0),
-  [332] = {.entry = {.count = 1, .reusable = true}}, SHIFT(90),
-  [334] = {.entry = {.count = 1, .reusable = true}}, SHIFT(138),
-  [336] = {.entry = {.count = 1, .reusable = true}}, SHIFT(9),
-  [338] = {.entry = {.count = 1, .reusable = true}}, SHIFT(141),
-  [340] = {.entry = {.count = 1, .reusable = true}}, SHIFT(58),
-  [342] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 5, 0, 31),
-  [344] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_definition, 4, 0, 19),
-  [346] = {.entry = {.count = 1, .reusable = false}}, SHIFT_EXTRA(),
-  [348] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_string_literal_repeat1, 2, 0, 0),
-  [350] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_string_literal_repeat1, 2, 0, 0), SHIFT_REPEAT(118),
-  [353] = {.entry = {.count = 1, .reusable = false}}, SHIFT(24),
-  [355] = {.entry = {.count = 1, .reusable = true}}, SHIFT(118),
-  [357] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 4, 0, 15),
-  [359] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_definition, 3, 0, 10),
-  [361] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_identifier_value, 1, 0, 0),
-  [363] = {.entry = {.count = 1, .reusable = true}}, SHIFT(31),
-  [365] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 6, 0, 33),
-  [367] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_alias, 4, 0, 14),
-  [369] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_model_body_repeat1, 2, 0, 0), SHIFT_REPEAT(39),
-  [372] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_model_body_repeat1, 2, 0, 0),
-  [374] = {.entry = {.count = 1, .reusable = true}}, SHIFT(27),
-  [376] = {.entry = {.count = 1, .reusable = true}}, SHIFT(30),
-  [378] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_array_literal_repeat1, 2, 0, 0),
-  [380] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_array_literal_repeat1, 2, 0, 0), SHIFT_REPEAT(15),
-  [383] = {.entry = {.count = 1, .reusable = false}}, SHIFT(18),
-  [385] = {.entry = {.count = 1, .reusable = true}}, SHIFT(119),
-  [387] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 7, 0, 38),
-  [389] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_removal, 2, 0, 1),
-  [391] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_import, 2, 0, 1),
-  [393] = {.entry = {.count = 1, .reusable = true}}, SHIFT(166),
-  [395] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_object_literal_repeat1, 2, 0, 0),
-  [397] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_object_literal_repeat1, 2, 0, 0), SHIFT_REPEAT(65),
-  [400] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_alias, 5, 0, 24),
-  [402] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_import, 4, 0, 11),
-  [404] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_config, 3, 0, 4),
-  [406] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_object_literal_repeat1, 3, 0, 0),
-  [408] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_template_import, 4, 0, 12),
-  [410] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_extends_template, 2, 0, 2),
-  [412] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_key_union_type_repeat1, 2, 0, 0), SHIFT_REPEAT(67),
-  [415] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_key_union_type_repeat1, 2, 0, 0),
-  [417] = {.entry = {.count = 1, .reusable = true}}, SHIFT(185),
-  [419] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_extends_clause, 2, 0, 6),
-  [421] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_entry, 3, 0, 26),
-  [423] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_extends_clause_repeat1, 2, 0, 22), SHIFT_REPEAT(185),
-  [426] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_extends_clause_repeat1, 2, 0, 22),
-  [428] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_extends_clause, 3, 0, 13),
-  [430] = {.entry = {.count = 1, .reusable = true}}, SHIFT(20),
-  [432] = {.entry = {.count = 1, .reusable = true}}, SHIFT(67),
-  [434] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_key_union_type, 2, 0, 0),
-  [436] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_array_literal_repeat1, 3, 0, 0),
-  [438] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 7, 0, 37),
-  [440] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 3, 0, 25),
-  [442] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_removal, 2, 0, 1),
-  [444] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_extends_clause_repeat1, 2, 0, 6),
-  [446] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__key_union_member, 1, 0, 0),
-  [448] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__key_type_expression, 1, 0, 0),
-  [450] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 6, 0, 32),
-  [452] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 6, 0, 35),
-  [454] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 2, 0, 17),
-  [456] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 5, 0, 24),
-  [458] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 7, 0, 36),
-  [460] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 4, 0, 14),
-  [462] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 5, 0, 30),
-  [464] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 8, 0, 39),
-  [466] = {.entry = {.count = 1, .reusable = true}}, SHIFT(50),
-  [468] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_override, 2, 0, 18),
-  [470] = {.entry = {.count = 1, .reusable = true}}, SHIFT(134),
-  [472] = {.entry = {.count = 1, .reusable = true}}, SHIFT(167),
-  [474] = {.entry = {.count = 1, .reusable = true}}, SHIFT(37),
-  [476] = {.entry = {.count = 1, .reusable = true}}, SHIFT(195),
-  [478] = {.entry = {.count = 1, .reusable = true}}, SHIFT(135),
-  [480] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_template_import, 5, 0, 21),
-  [482] = {.entry = {.count = 1, .reusable = true}}, SHIFT(109),
-  [484] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_import, 5, 0, 20),
-  [486] = {.entry = {.count = 1, .reusable = true}},  ACCEPT_INPUT(),
-  [488] = {.entry = {.count = 1, .reusable = true}}, SHIFT(165),
-  [490] = {.entry = {.count = 1, .reusable = true}}, SHIFT(147),
-  [492] = {.entry = {.count = 1, .reusable = true}}, SHIFT(177),
-  [494] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_extends_template, 3, 0, 5),
-  [496] = {.entry = {.count = 1, .reusable = true}}, SHIFT(16),
-  [498] = {.entry = {.count = 1, .reusable = true}}, SHIFT(42),
-  [500] = {.entry = {.count = 1, .reusable = true}}, SHIFT(162),
-  [502] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_import, 3, 0, 4),
+  [7] = {.entry = {.count = 1, .reusable = false}}, SHIFT(2),
+  [9] = {.entry = {.count = 1, .reusable = true}}, SHIFT(3),
+  [11] = {.entry = {.count = 1, .reusable = true}}, SHIFT(4),
+  [13] = {.entry = {.count = 1, .reusable = false}}, SHIFT(5),
+  [15] = {.entry = {.count = 1, .reusable = false}}, SHIFT(6),
+  [17] = {.entry = {.count = 1, .reusable = true}}, SHIFT(7),
+  [19] = {.entry = {.count = 1, .reusable = true}}, SHIFT(21),
+  [21] = {.entry = {.count = 1, .reusable = true}}, SHIFT(22),
+  [23] = {.entry = {.count = 1, .reusable = true}}, SHIFT(23),
+  [25] = {.entry = {.count = 1, .reusable = true}}, SHIFT(24),
+  [27] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym__nls, 1, 0, 0),
+  [29] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym__nls, 1, 0, 0),
+  [31] = {.entry = {.count = 1, .reusable = true}}, SHIFT(28),
+  [33] = {.entry = {.count = 1, .reusable = true}}, SHIFT(29),
+  [35] = {.entry = {.count = 1, .reusable = true}}, SHIFT(30),
+  [37] = {.entry = {.count = 1, .reusable = true}}, SHIFT(32),
+  [39] = {.entry = {.count = 1, .reusable = true}}, SHIFT(34),
+  [41] = {.entry = {.count = 1, .reusable = true}}, ACCEPT_INPUT(),
+  [43] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_source_file_repeat2, 1, 0, 0),
+  [45] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_source_file, 1, 0, 0),
+  [47] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__directive, 1, 0, 0),
+  [49] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__definition, 1, 0, 0),
+  [51] = {.entry = {.count = 1, .reusable = true}}, SHIFT(2),
+  [53] = {.entry = {.count = 1, .reusable = true}}, SHIFT(43),
+  [55] = {.entry = {.count = 1, .reusable = true}}, SHIFT(44),
+  [57] = {.entry = {.count = 1, .reusable = true}}, SHIFT(45),
+  [59] = {.entry = {.count = 1, .reusable = true}}, SHIFT(46),
+  [61] = {.entry = {.count = 1, .reusable = true}}, SHIFT(53),
+  [63] = {.entry = {.count = 1, .reusable = false}}, SHIFT(54),
+  [65] = {.entry = {.count = 1, .reusable = true}}, SHIFT(55),
+  [67] = {.entry = {.count = 1, .reusable = false}}, SHIFT(56),
+  [69] = {.entry = {.count = 1, .reusable = false}}, SHIFT(57),
+  [71] = {.entry = {.count = 1, .reusable = true}}, SHIFT(58),
+  [73] = {.entry = {.count = 1, .reusable = false}}, SHIFT(59),
+  [75] = {.entry = {.count = 1, .reusable = false}}, SHIFT(60),
+  [77] = {.entry = {.count = 1, .reusable = false}}, SHIFT(61),
+  [79] = {.entry = {.count = 1, .reusable = true}}, SHIFT(74),
+  [81] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_definition, 2, 0, 1),
+  [83] = {.entry = {.count = 1, .reusable = true}}, SHIFT(78),
+  [85] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_import, 2, 0, 2),
+  [87] = {.entry = {.count = 1, .reusable = true}}, SHIFT(80),
+  [89] = {.entry = {.count = 1, .reusable = true}}, SHIFT(81),
+  [91] = {.entry = {.count = 1, .reusable = true}}, SHIFT(83),
+  [93] = {.entry = {.count = 1, .reusable = true}}, SHIFT(84),
+  [95] = {.entry = {.count = 1, .reusable = true}}, SHIFT(85),
+  [97] = {.entry = {.count = 1, .reusable = true}}, SHIFT(88),
+  [99] = {.entry = {.count = 1, .reusable = false}}, SHIFT_EXTRA(),
+  [101] = {.entry = {.count = 1, .reusable = true}}, SHIFT(89),
+  [103] = {.entry = {.count = 1, .reusable = false}}, SHIFT(90),
+  [105] = {.entry = {.count = 1, .reusable = true}}, SHIFT(91),
+  [107] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_extends_template, 2, 0, 3),
+  [109] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_removal, 2, 0, 2),
+  [111] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0),
+  [113] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0),
+  [115] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_source_file_repeat2, 2, 0, 0),
+  [117] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym__nls, 2, 0, 0),
+  [119] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym__nls, 2, 0, 0),
+  [121] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym__nls, 2, 0, 0), SHIFT_REPEAT(3),
+  [124] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_source_file, 2, 0, 0),
+  [126] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(4),
+  [129] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(5),
+  [132] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_source_file_repeat1, 2, 0, 0), SHIFT_REPEAT(6),
+  [135] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat2, 2, 0, 0), SHIFT_REPEAT(2),
+  [138] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_source_file_repeat2, 2, 0, 0), SHIFT_REPEAT(7),
+  [141] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 1, 0, 4),
+  [143] = {.entry = {.count = 1, .reusable = true}}, SHIFT(95),
+  [145] = {.entry = {.count = 1, .reusable = true}}, SHIFT(96),
+  [147] = {.entry = {.count = 1, .reusable = true}}, SHIFT(97),
+  [149] = {.entry = {.count = 1, .reusable = true}}, SHIFT(100),
+  [151] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_body, 2, 0, 0),
+  [153] = {.entry = {.count = 1, .reusable = true}}, SHIFT(101),
+  [155] = {.entry = {.count = 1, .reusable = true}}, SHIFT(102),
+  [157] = {.entry = {.count = 1, .reusable = true}}, SHIFT(104),
+  [159] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__model_member, 1, 0, 0),
+  [161] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_extends_clause, 2, 0, 5),
+  [163] = {.entry = {.count = 1, .reusable = true}}, SHIFT(107),
+  [165] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_identifier, 1, 0, 0),
+  [167] = {.entry = {.count = 1, .reusable = true}}, SHIFT(109),
+  [169] = {.entry = {.count = 1, .reusable = true}}, SHIFT(110),
+  [171] = {.entry = {.count = 1, .reusable = true}}, SHIFT(111),
+  [173] = {.entry = {.count = 1, .reusable = true}}, SHIFT(114),
+  [175] = {.entry = {.count = 1, .reusable = true}}, SHIFT(115),
+  [177] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__type_expression, 1, 0, 0),
+  [179] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__union_member, 1, 0, 0),
+  [181] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_boolean_literal, 1, 0, 0),
+  [183] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_alias, 3, 0, 6),
+  [185] = {.entry = {.count = 1, .reusable = true}}, SHIFT(118),
+  [187] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__base_type, 1, 0, 0),
+  [189] = {.entry = {.count = 1, .reusable = true}}, SHIFT(120),
+  [191] = {.entry = {.count = 1, .reusable = true}}, SHIFT(121),
+  [193] = {.entry = {.count = 1, .reusable = true}}, SHIFT(122),
+  [195] = {.entry = {.count = 1, .reusable = true}}, SHIFT(123),
+  [197] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_definition, 3, 0, 7),
+  [199] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_definition, 3, 0, 8),
+  [201] = {.entry = {.count = 1, .reusable = true}}, SHIFT(128),
+  [203] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_definition, 3, 0, 9),
+  [205] = {.entry = {.count = 1, .reusable = true}}, SHIFT(130),
+  [207] = {.entry = {.count = 1, .reusable = true}}, SHIFT(131),
+  [209] = {.entry = {.count = 1, .reusable = true}}, SHIFT(132),
+  [211] = {.entry = {.count = 1, .reusable = true}}, SHIFT(133),
+  [213] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_import, 3, 0, 10),
+  [215] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_import_name, 1, 0, 4),
+  [217] = {.entry = {.count = 1, .reusable = true}}, SHIFT(138),
+  [219] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_import_names, 2, 0, 0),
+  [221] = {.entry = {.count = 1, .reusable = true}}, SHIFT(139),
+  [223] = {.entry = {.count = 1, .reusable = true}}, SHIFT(141),
+  [225] = {.entry = {.count = 1, .reusable = true}}, SHIFT(142),
+  [227] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_string_literal, 2, 0, 0),
+  [229] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_string_literal_repeat1, 1, 0, 0),
+  [231] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_string_literal_repeat1, 1, 0, 0),
+  [233] = {.entry = {.count = 1, .reusable = true}}, SHIFT(146),
+  [235] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_extends_template, 3, 0, 11),
+  [237] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_source_file, 3, 0, 0),
+  [239] = {.entry = {.count = 1, .reusable = true}}, SHIFT(148),
+  [241] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 2, 0, 12),
+  [243] = {.entry = {.count = 1, .reusable = true}}, SHIFT(152),
+  [245] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 2, 0, 13),
+  [247] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_override, 2, 0, 14),
+  [249] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_removal, 2, 0, 2),
+  [251] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_body, 3, 0, 0),
+  [253] = {.entry = {.count = 1, .reusable = true}}, SHIFT(155),
+  [255] = {.entry = {.count = 1, .reusable = true}}, SHIFT(158),
+  [257] = {.entry = {.count = 1, .reusable = true}}, SHIFT(160),
+  [259] = {.entry = {.count = 1, .reusable = true}}, SHIFT(163),
+  [261] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_extends_clause, 3, 0, 15),
+  [263] = {.entry = {.count = 1, .reusable = true}}, SHIFT(165),
+  [265] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_type, 2, 0, 0),
+  [267] = {.entry = {.count = 1, .reusable = true}}, SHIFT(168),
+  [269] = {.entry = {.count = 1, .reusable = true}}, SHIFT(170),
+  [271] = {.entry = {.count = 1, .reusable = true}}, SHIFT(171),
+  [273] = {.entry = {.count = 1, .reusable = true}}, SHIFT(174),
+  [275] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_alias, 4, 0, 16),
+  [277] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_alias, 4, 0, 17),
+  [279] = {.entry = {.count = 1, .reusable = true}}, SHIFT(178),
+  [281] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_union_type, 2, 0, 0),
+  [283] = {.entry = {.count = 1, .reusable = true}}, SHIFT(54),
+  [285] = {.entry = {.count = 1, .reusable = true}}, SHIFT(189),
+  [287] = {.entry = {.count = 1, .reusable = true}}, SHIFT(190),
+  [289] = {.entry = {.count = 1, .reusable = true}}, SHIFT(196),
+  [291] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_parameters, 3, 0, 18),
+  [293] = {.entry = {.count = 1, .reusable = true}}, SHIFT(197),
+  [295] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_definition, 4, 0, 19),
+  [297] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_definition, 4, 0, 20),
+  [299] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_definition, 4, 0, 21),
+  [301] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_entity_id, 2, 0, 0),
+  [303] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_import, 4, 0, 22),
+  [305] = {.entry = {.count = 1, .reusable = true}}, SHIFT(201),
+  [307] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_literal, 2, 0, 0),
+  [309] = {.entry = {.count = 1, .reusable = true}}, SHIFT(202),
+  [311] = {.entry = {.count = 1, .reusable = true}}, SHIFT(203),
+  [313] = {.entry = {.count = 1, .reusable = true}}, SHIFT(206),
+  [315] = {.entry = {.count = 1, .reusable = true}}, SHIFT(207),
+  [317] = {.entry = {.count = 1, .reusable = true}}, SHIFT(210),
+  [319] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_template_import, 4, 0, 23),
+  [321] = {.entry = {.count = 1, .reusable = true}}, SHIFT(212),
+  [323] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_import_names, 3, 0, 0),
+  [325] = {.entry = {.count = 1, .reusable = true}}, SHIFT(213),
+  [327] = {.entry = {.count = 1, .reusable = true}}, SHIFT(214),
+  [329] = {.entry = {.count = 1, .reusable = true}}, SHIFT(217),
+  [331] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_import_names, 3, 0, 2),
+  [333] = {.entry = {.count = 1, .reusable = true}}, SHIFT(220),
+  [335] = {.entry = {.count = 1, .reusable = true}}, SHIFT(222),
+  [337] = {.entry = {.count = 1, .reusable = true}}, SHIFT(223),
+  [339] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_template_import, 4, 0, 24),
+  [341] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_string_literal, 3, 0, 0),
+  [343] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_string_literal_repeat1, 2, 0, 0),
+  [345] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_string_literal_repeat1, 2, 0, 0), SHIFT_REPEAT(90),
+  [348] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_string_literal_repeat1, 2, 0, 0), SHIFT_REPEAT(91),
+  [351] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_block, 2, 0, 0),
+  [353] = {.entry = {.count = 1, .reusable = true}}, SHIFT(227),
+  [355] = {.entry = {.count = 1, .reusable = true}}, SHIFT(229),
+  [357] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 3, 0, 6),
+  [359] = {.entry = {.count = 1, .reusable = true}}, SHIFT(233),
+  [361] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 3, 0, 25),
+  [363] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_config, 3, 0, 10),
+  [365] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_body, 4, 0, 0),
+  [367] = {.entry = {.count = 1, .reusable = true}}, SHIFT(237),
+  [369] = {.entry = {.count = 1, .reusable = true}}, SHIFT(238),
+  [371] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_model_body_repeat1, 2, 0, 0),
+  [373] = {.entry = {.count = 1, .reusable = true}}, SHIFT(240),
+  [375] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_model_body_repeat1, 2, 0, 0), SHIFT_REPEAT(3),
+  [378] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_extends_clause_repeat1, 2, 0, 5),
+  [380] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_extends_clause_repeat1, 2, 0, 26),
+  [382] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_extends_clause_repeat1, 2, 0, 26), SHIFT_REPEAT(107),
+  [385] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__qualified_name_rest, 1, 0, 0),
+  [387] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_qualified_identifier, 3, 0, 27),
+  [389] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_type, 3, 0, 0),
+  [391] = {.entry = {.count = 1, .reusable = true}}, SHIFT(242),
+  [393] = {.entry = {.count = 1, .reusable = true}}, SHIFT(243),
+  [395] = {.entry = {.count = 1, .reusable = true}}, SHIFT(246),
+  [397] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_type, 3, 0, 28),
+  [399] = {.entry = {.count = 1, .reusable = true}}, SHIFT(249),
+  [401] = {.entry = {.count = 1, .reusable = true}}, SHIFT(251),
+  [403] = {.entry = {.count = 1, .reusable = true}}, SHIFT(252),
+  [405] = {.entry = {.count = 1, .reusable = true}}, SHIFT(255),
+  [407] = {.entry = {.count = 1, .reusable = true}}, SHIFT(256),
+  [409] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_projection, 5, 0, 29),
+  [411] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_alias, 5, 0, 30),
+  [413] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_union_type_repeat1, 2, 0, 0),
+  [415] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_union_type_repeat1, 2, 0, 0), SHIFT_REPEAT(118),
+  [418] = {.entry = {.count = 1, .reusable = true}}, SHIFT(262),
+  [420] = {.entry = {.count = 1, .reusable = true}}, SHIFT(263),
+  [422] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_array_type, 3, 0, 0),
+  [424] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__key_union_member, 1, 0, 0),
+  [426] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__key_type_expression, 1, 0, 0),
+  [428] = {.entry = {.count = 1, .reusable = true}}, SHIFT(265),
+  [430] = {.entry = {.count = 1, .reusable = true}}, SHIFT(266),
+  [432] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_type_parameters_repeat1, 2, 0, 18),
+  [434] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_parameters, 4, 0, 31),
+  [436] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_type_parameters_repeat1, 2, 0, 32), SHIFT_REPEAT(122),
+  [439] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_type_parameters_repeat1, 2, 0, 32),
+  [441] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_definition, 5, 0, 33),
+  [443] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_import, 5, 0, 34),
+  [445] = {.entry = {.count = 1, .reusable = false}}, SHIFT(268),
+  [447] = {.entry = {.count = 1, .reusable = true}}, SHIFT(269),
+  [449] = {.entry = {.count = 1, .reusable = true}}, SHIFT(270),
+  [451] = {.entry = {.count = 1, .reusable = false}}, SHIFT(271),
+  [453] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_literal, 3, 0, 0),
+  [455] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym__nls, 2, 0, 0), SHIFT_REPEAT(131),
+  [458] = {.entry = {.count = 1, .reusable = true}}, SHIFT(279),
+  [460] = {.entry = {.count = 1, .reusable = true}}, SHIFT(280),
+  [462] = {.entry = {.count = 1, .reusable = true}}, SHIFT(283),
+  [464] = {.entry = {.count = 1, .reusable = true}}, SHIFT(286),
+  [466] = {.entry = {.count = 1, .reusable = true}}, SHIFT(287),
+  [468] = {.entry = {.count = 1, .reusable = true}}, SHIFT(288),
+  [470] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_template_import, 5, 0, 35),
+  [472] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_import_name, 3, 0, 36),
+  [474] = {.entry = {.count = 1, .reusable = true}}, SHIFT(292),
+  [476] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_import_names, 4, 0, 37),
+  [478] = {.entry = {.count = 1, .reusable = true}}, SHIFT(294),
+  [480] = {.entry = {.count = 1, .reusable = true}}, SHIFT(295),
+  [482] = {.entry = {.count = 1, .reusable = true}}, SHIFT(296),
+  [484] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_import_names, 4, 0, 2),
+  [486] = {.entry = {.count = 1, .reusable = true}}, SHIFT(298),
+  [488] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_import_names_repeat1, 2, 0, 2),
+  [490] = {.entry = {.count = 1, .reusable = true}}, SHIFT(300),
+  [492] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_import_names, 4, 0, 38),
+  [494] = {.entry = {.count = 1, .reusable = true}}, SHIFT(302),
+  [496] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_import_names_repeat1, 2, 0, 39), SHIFT_REPEAT(3),
+  [499] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_import_names_repeat1, 2, 0, 39), SHIFT_REPEAT(303),
+  [502] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_import_names_repeat1, 2, 0, 39),
+  [504] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_template_import, 5, 0, 40),
+  [506] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_block, 3, 0, 0),
+  [508] = {.entry = {.count = 1, .reusable = true}}, SHIFT(305),
+  [510] = {.entry = {.count = 1, .reusable = true}}, SHIFT(308),
+  [512] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_plugin_block_repeat1, 1, 0, 0),
+  [514] = {.entry = {.count = 1, .reusable = true}}, SHIFT(310),
+  [516] = {.entry = {.count = 1, .reusable = false}}, SHIFT(313),
+  [518] = {.entry = {.count = 1, .reusable = true}}, SHIFT(314),
+  [520] = {.entry = {.count = 1, .reusable = false}}, SHIFT(315),
+  [522] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 4, 0, 16),
+  [524] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 4, 0, 17),
+  [526] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 4, 0, 41),
+  [528] = {.entry = {.count = 1, .reusable = true}}, SHIFT(323),
+  [530] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_body, 5, 0, 0),
+  [532] = {.entry = {.count = 1, .reusable = true}}, SHIFT(326),
+  [534] = {.entry = {.count = 1, .reusable = true}}, SHIFT(327),
+  [536] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_type, 4, 0, 42),
+  [538] = {.entry = {.count = 1, .reusable = true}}, SHIFT(329),
+  [540] = {.entry = {.count = 1, .reusable = true}}, SHIFT(330),
+  [542] = {.entry = {.count = 1, .reusable = true}}, SHIFT(331),
+  [544] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_type, 4, 0, 28),
+  [546] = {.entry = {.count = 1, .reusable = true}}, SHIFT(333),
+  [548] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_object_type_repeat1, 2, 0, 28),
+  [550] = {.entry = {.count = 1, .reusable = true}}, SHIFT(335),
+  [552] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_type, 4, 0, 43),
+  [554] = {.entry = {.count = 1, .reusable = true}}, SHIFT(337),
+  [556] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_object_type_repeat1, 2, 0, 44), SHIFT_REPEAT(3),
+  [559] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_object_type_repeat1, 2, 0, 44), SHIFT_REPEAT(338),
+  [562] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_object_type_repeat1, 2, 0, 44),
+  [564] = {.entry = {.count = 1, .reusable = true}}, SHIFT(340),
+  [566] = {.entry = {.count = 1, .reusable = true}}, SHIFT(341),
+  [568] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_projection_fields, 2, 0, 0),
+  [570] = {.entry = {.count = 1, .reusable = true}}, SHIFT(344),
+  [572] = {.entry = {.count = 1, .reusable = true}}, SHIFT(345),
+  [574] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_projection, 6, 0, 45),
+  [576] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_projection, 6, 0, 46),
+  [578] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_generic_type, 4, 0, 47),
+  [580] = {.entry = {.count = 1, .reusable = true}}, SHIFT(349),
+  [582] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_map_type, 4, 0, 48),
+  [584] = {.entry = {.count = 1, .reusable = true}}, SHIFT(351),
+  [586] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_key_union_type, 2, 0, 0),
+  [588] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_identifier_value, 1, 0, 0),
+  [590] = {.entry = {.count = 1, .reusable = true}}, SHIFT(356),
+  [592] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__value, 1, 0, 0),
+  [594] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_entry, 3, 0, 49),
+  [596] = {.entry = {.count = 1, .reusable = true}}, SHIFT(359),
+  [598] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_literal, 4, 0, 0),
+  [600] = {.entry = {.count = 1, .reusable = true}}, SHIFT(361),
+  [602] = {.entry = {.count = 1, .reusable = true}}, SHIFT(362),
+  [604] = {.entry = {.count = 1, .reusable = true}}, SHIFT(363),
+  [606] = {.entry = {.count = 1, .reusable = true}}, SHIFT(365),
+  [608] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_object_literal_repeat1, 2, 0, 0),
+  [610] = {.entry = {.count = 1, .reusable = true}}, SHIFT(367),
+  [612] = {.entry = {.count = 1, .reusable = true}}, SHIFT(369),
+  [614] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_object_literal_repeat1, 2, 0, 0), SHIFT_REPEAT(370),
+  [617] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_import_names, 5, 0, 37),
+  [619] = {.entry = {.count = 1, .reusable = true}}, SHIFT(371),
+  [621] = {.entry = {.count = 1, .reusable = true}}, SHIFT(372),
+  [623] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_import_names, 5, 0, 50),
+  [625] = {.entry = {.count = 1, .reusable = true}}, SHIFT(374),
+  [627] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_import_names, 5, 0, 2),
+  [629] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_import_names_repeat1, 3, 0, 37),
+  [631] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_import_names, 5, 0, 38),
+  [633] = {.entry = {.count = 1, .reusable = true}}, SHIFT(375),
+  [635] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_block, 4, 0, 0),
+  [637] = {.entry = {.count = 1, .reusable = true}}, SHIFT(377),
+  [639] = {.entry = {.count = 1, .reusable = true}}, SHIFT(378),
+  [641] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_plugin_block_repeat1, 2, 0, 0),
+  [643] = {.entry = {.count = 1, .reusable = true}}, SHIFT(380),
+  [645] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_plugin_block_repeat1, 2, 0, 0), SHIFT_REPEAT(3),
+  [648] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_plugin_block_repeat1, 2, 0, 0), SHIFT_REPEAT(44),
+  [651] = {.entry = {.count = 1, .reusable = true}}, SHIFT(382),
+  [653] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__default_value, 1, 0, 0),
+  [655] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 5, 0, 51),
+  [657] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 5, 0, 30),
+  [659] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 5, 0, 52),
+  [661] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 5, 0, 53),
+  [663] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_body, 6, 0, 0),
+  [665] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_type, 5, 0, 42),
+  [667] = {.entry = {.count = 1, .reusable = true}}, SHIFT(387),
+  [669] = {.entry = {.count = 1, .reusable = true}}, SHIFT(388),
+  [671] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_type, 5, 0, 54),
+  [673] = {.entry = {.count = 1, .reusable = true}}, SHIFT(390),
+  [675] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_type, 5, 0, 28),
+  [677] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_object_type_repeat1, 3, 0, 42),
+  [679] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_type, 5, 0, 43),
+  [681] = {.entry = {.count = 1, .reusable = true}}, SHIFT(391),
+  [683] = {.entry = {.count = 1, .reusable = true}}, SHIFT(393),
+  [685] = {.entry = {.count = 1, .reusable = true}}, SHIFT(394),
+  [687] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_projection_fields, 3, 0, 28),
+  [689] = {.entry = {.count = 1, .reusable = true}}, SHIFT(396),
+  [691] = {.entry = {.count = 1, .reusable = true}}, SHIFT(397),
+  [693] = {.entry = {.count = 1, .reusable = true}}, SHIFT(398),
+  [695] = {.entry = {.count = 1, .reusable = true}}, SHIFT(399),
+  [697] = {.entry = {.count = 1, .reusable = true}}, SHIFT(402),
+  [699] = {.entry = {.count = 1, .reusable = true}}, SHIFT(403),
+  [701] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_projection_fields, 3, 0, 0),
+  [703] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_model_projection, 7, 0, 55),
+  [705] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_generic_type_repeat1, 2, 0, 56),
+  [707] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_generic_type, 5, 0, 57),
+  [709] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_generic_type_repeat1, 2, 0, 58), SHIFT_REPEAT(262),
+  [712] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_generic_type_repeat1, 2, 0, 58),
+  [714] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_key_union_type_repeat1, 2, 0, 0),
+  [716] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_key_union_type_repeat1, 2, 0, 0), SHIFT_REPEAT(266),
+  [719] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_array_literal, 2, 0, 0),
+  [721] = {.entry = {.count = 1, .reusable = true}}, SHIFT(406),
+  [723] = {.entry = {.count = 1, .reusable = true}}, SHIFT(408),
+  [725] = {.entry = {.count = 1, .reusable = true}}, SHIFT(409),
+  [727] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_literal, 5, 0, 0),
+  [729] = {.entry = {.count = 1, .reusable = true}}, SHIFT(412),
+  [731] = {.entry = {.count = 1, .reusable = true}}, SHIFT(413),
+  [733] = {.entry = {.count = 1, .reusable = true}}, SHIFT(415),
+  [735] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_object_literal_repeat1, 3, 0, 0),
+  [737] = {.entry = {.count = 1, .reusable = true}}, SHIFT(416),
+  [739] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_import_names, 6, 0, 37),
+  [741] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_import_names, 6, 0, 50),
+  [743] = {.entry = {.count = 1, .reusable = true}}, SHIFT(418),
+  [745] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_import_names, 6, 0, 38),
+  [747] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_block, 5, 0, 0),
+  [749] = {.entry = {.count = 1, .reusable = true}}, SHIFT(419),
+  [751] = {.entry = {.count = 1, .reusable = true}}, SHIFT(420),
+  [753] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 6, 0, 59),
+  [755] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 6, 0, 60),
+  [757] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 6, 0, 61),
+  [759] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 6, 0, 62),
+  [761] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_type, 6, 0, 42),
+  [763] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_type, 6, 0, 54),
+  [765] = {.entry = {.count = 1, .reusable = true}}, SHIFT(424),
+  [767] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_type, 6, 0, 43),
+  [769] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_projection_fields_repeat1, 2, 0, 28),
+  [771] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_projection_fields, 4, 0, 28),
+  [773] = {.entry = {.count = 1, .reusable = true}}, SHIFT(425),
+  [775] = {.entry = {.count = 1, .reusable = true}}, SHIFT(426),
+  [777] = {.entry = {.count = 1, .reusable = true}}, SHIFT(427),
+  [779] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_projection_fields, 4, 0, 43),
+  [781] = {.entry = {.count = 1, .reusable = true}}, SHIFT(429),
+  [783] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_projection_fields_repeat1, 2, 0, 44), SHIFT_REPEAT(3),
+  [786] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_projection_fields_repeat1, 2, 0, 44), SHIFT_REPEAT(430),
+  [789] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_projection_fields_repeat1, 2, 0, 44),
+  [791] = {.entry = {.count = 1, .reusable = true}}, SHIFT(432),
+  [793] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_projection_fields, 4, 0, 42),
+  [795] = {.entry = {.count = 1, .reusable = true}}, SHIFT(434),
+  [797] = {.entry = {.count = 1, .reusable = true}}, SHIFT(435),
+  [799] = {.entry = {.count = 1, .reusable = true}}, SHIFT(436),
+  [801] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_array_literal, 3, 0, 0),
+  [803] = {.entry = {.count = 1, .reusable = true}}, SHIFT(438),
+  [805] = {.entry = {.count = 1, .reusable = true}}, SHIFT(439),
+  [807] = {.entry = {.count = 1, .reusable = true}}, SHIFT(442),
+  [809] = {.entry = {.count = 1, .reusable = true}}, SHIFT(445),
+  [811] = {.entry = {.count = 1, .reusable = true}}, SHIFT(446),
+  [813] = {.entry = {.count = 1, .reusable = true}}, SHIFT(447),
+  [815] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_literal, 6, 0, 0),
+  [817] = {.entry = {.count = 1, .reusable = true}}, SHIFT(450),
+  [819] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_import_names, 7, 0, 50),
+  [821] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_plugin_block, 6, 0, 0),
+  [823] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_function_call, 3, 0, 4),
+  [825] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 7, 0, 63),
+  [827] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 7, 0, 64),
+  [829] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 7, 0, 65),
+  [831] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_type, 7, 0, 54),
+  [833] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_projection_fields_repeat1, 3, 0, 42),
+  [835] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_projection_fields, 5, 0, 28),
+  [837] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_projection_fields, 5, 0, 43),
+  [839] = {.entry = {.count = 1, .reusable = true}}, SHIFT(452),
+  [841] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_projection_fields, 5, 0, 42),
+  [843] = {.entry = {.count = 1, .reusable = true}}, SHIFT(454),
+  [845] = {.entry = {.count = 1, .reusable = true}}, SHIFT(455),
+  [847] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_projection_fields, 5, 0, 54),
+  [849] = {.entry = {.count = 1, .reusable = true}}, SHIFT(457),
+  [851] = {.entry = {.count = 1, .reusable = true}}, SHIFT(458),
+  [853] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_array_literal, 4, 0, 0),
+  [855] = {.entry = {.count = 1, .reusable = true}}, SHIFT(460),
+  [857] = {.entry = {.count = 1, .reusable = true}}, SHIFT(461),
+  [859] = {.entry = {.count = 1, .reusable = true}}, SHIFT(462),
+  [861] = {.entry = {.count = 1, .reusable = true}}, SHIFT(464),
+  [863] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_array_literal_repeat1, 2, 0, 0),
+  [865] = {.entry = {.count = 1, .reusable = true}}, SHIFT(466),
+  [867] = {.entry = {.count = 1, .reusable = true}}, SHIFT(468),
+  [869] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_array_literal_repeat1, 2, 0, 0), SHIFT_REPEAT(469),
+  [872] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_object_literal, 7, 0, 0),
+  [874] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_field_definition, 8, 0, 66),
+  [876] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_projection_fields, 6, 0, 43),
+  [878] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_projection_fields, 6, 0, 42),
+  [880] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_projection_fields, 6, 0, 54),
+  [882] = {.entry = {.count = 1, .reusable = true}}, SHIFT(470),
+  [884] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_array_literal, 5, 0, 0),
+  [886] = {.entry = {.count = 1, .reusable = true}}, SHIFT(471),
+  [888] = {.entry = {.count = 1, .reusable = true}}, SHIFT(472),
+  [890] = {.entry = {.count = 1, .reusable = true}}, SHIFT(474),
+  [892] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_array_literal_repeat1, 3, 0, 0),
+  [894] = {.entry = {.count = 1, .reusable = true}}, SHIFT(475),
+  [896] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_projection_fields, 7, 0, 54),
+  [898] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_array_literal, 6, 0, 0),
+  [900] = {.entry = {.count = 1, .reusable = true}}, SHIFT(477),
+  [902] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_array_literal, 7, 0, 0),
 };
 
 #ifdef __cplusplus
@@ -4711,3 +10444,4 @@ TS_PUBLIC const TSLanguage *tree_sitter_cdm(void) {
 #ifdef __cplusplus
 }
 #endif
+